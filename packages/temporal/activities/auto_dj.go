@@ -0,0 +1,145 @@
+package activities
+
+import (
+	"context"
+	"errors"
+
+	shared_mpe "github.com/AdonisEnProvence/MusicRoom/mpe/shared"
+	shared_mtv "github.com/AdonisEnProvence/MusicRoom/mtv/shared"
+	"github.com/AdonisEnProvence/MusicRoom/shared"
+	"go.temporal.io/sdk/client"
+	"go.temporal.io/sdk/temporal"
+)
+
+// TemporalClient is used by the activities reading the state of other workflows.
+// It is wired up by the worker.
+var TemporalClient client.Client
+
+var ErrTemporalClientNotSet = errors.New("temporal client has not been set")
+
+var ErrMpeRoomAccessDenied = errors.New("the room creator is not a member of the private MPE room")
+
+type FetchAutoDJTracksArgs struct {
+	Options shared_mtv.MtvRoomAutoDJOptions
+	// Zero when the room has not played any track yet
+	CurrentTrack shared.TrackMetadata
+	// Tracks of the tracks list, they are never returned
+	QueuedTracksIDs []string
+	// Tracks already played by the room, they are only returned
+	// once every other candidate has been
+	PlayedTracksIDs []string
+	Policy          shared.TrackPlayabilityPolicy
+	// The MPE playlist is read on behalf of the room creator
+	CreatorUserID string
+}
+
+// FetchAutoDJTracksActivity returns at most Options.GetBatchSize() playable tracks
+// taken from the auto-DJ source, in the order the source gives them.
+func FetchAutoDJTracksActivity(ctx context.Context, args FetchAutoDJTracksArgs) ([]shared.TrackMetadata, error) {
+	candidates, err := fetchAutoDJCandidates(ctx, args)
+	if err != nil {
+		return nil, err
+	}
+
+	playableCandidates, _ := args.Policy.Filter(candidates)
+
+	return pickAutoDJTracks(playableCandidates, args), nil
+}
+
+func fetchAutoDJCandidates(ctx context.Context, args FetchAutoDJTracksArgs) ([]shared.TrackMetadata, error) {
+	switch args.Options.Source {
+	case shared_mtv.MtvRoomAutoDJSourceMpePlaylist:
+		return fetchMpePlaylistTracks(ctx, args.Options.MpeRoomID, args.CreatorUserID)
+
+	case shared_mtv.MtvRoomAutoDJSourceFallbackTracks:
+		metadata, _, err := fetchTracksInformation(ctx, args.Options.FallbackTracksIDs)
+
+		return metadata, err
+
+	case shared_mtv.MtvRoomAutoDJSourceRelatedTracks:
+		if args.CurrentTrack.ID == "" {
+			return make([]shared.TrackMetadata, 0), nil
+		}
+
+		metadata, err := getTrackProviders().FindRelatedTracks(ctx, args.CurrentTrack, MaxSearchTracksLimit)
+		if err != nil {
+			return nil, toFetchTracksActivityError(err)
+		}
+
+		if TracksMetadataCache != nil {
+			TracksMetadataCache.Set(metadata)
+		}
+
+		return metadata, nil
+
+	default:
+		return nil, errors.New("unknown auto-DJ source")
+	}
+}
+
+// The creator may have left the private MPE room since the creation of the MTV room,
+// it is then denied access as it would have been at the creation.
+func fetchMpePlaylistTracks(ctx context.Context, mpeRoomID string, creatorUserID string) ([]shared.TrackMetadata, error) {
+	if TemporalClient == nil {
+		return nil, ErrTemporalClientNotSet
+	}
+
+	response, err := TemporalClient.QueryWorkflow(ctx, mpeRoomID, "", shared_mpe.MpeGetStateQuery, creatorUserID)
+	if err != nil {
+		return nil, err
+	}
+
+	var state shared_mpe.MpeRoomExposedState
+	if err := response.Get(&state); err != nil {
+		return nil, err
+	}
+
+	if !state.UserCanReadTracks() {
+		return nil, temporal.NewApplicationError(ErrMpeRoomAccessDenied.Error(), MpeRoomAccessDeniedErrorType, ErrMpeRoomAccessDenied)
+	}
+
+	return state.Tracks, nil
+}
+
+// pickAutoDJTracks prefers the candidates that have not been played yet
+// and fills the batch with played ones when there are not enough of them.
+func pickAutoDJTracks(candidates []shared.TrackMetadata, args FetchAutoDJTracksArgs) []shared.TrackMetadata {
+	batchSize := args.Options.GetBatchSize()
+
+	excludedTracksIDs := make(map[string]bool, len(args.QueuedTracksIDs)+1)
+	for _, trackID := range args.QueuedTracksIDs {
+		excludedTracksIDs[trackID] = true
+	}
+	if args.CurrentTrack.ID != "" {
+		excludedTracksIDs[args.CurrentTrack.ID] = true
+	}
+
+	playedTracksIDs := make(map[string]bool, len(args.PlayedTracksIDs))
+	for _, trackID := range args.PlayedTracksIDs {
+		playedTracksIDs[trackID] = true
+	}
+
+	pickedTracks := make([]shared.TrackMetadata, 0, batchSize)
+	pick := func(acceptPlayedTracks bool) {
+		for _, candidate := range candidates {
+			if len(pickedTracks) >= batchSize {
+				return
+			}
+
+			if excludedTracksIDs[candidate.ID] {
+				continue
+			}
+			if playedTracksIDs[candidate.ID] && !acceptPlayedTracks {
+				continue
+			}
+
+			pickedTracks = append(pickedTracks, candidate)
+			excludedTracksIDs[candidate.ID] = true
+		}
+	}
+
+	pick(false)
+	pick(true)
+
+	return pickedTracks
+}
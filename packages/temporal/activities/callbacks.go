@@ -0,0 +1,35 @@
+package activities
+
+import (
+	"context"
+
+	"github.com/AdonisEnProvence/MusicRoom/callback"
+	"go.temporal.io/sdk/temporal"
+)
+
+const (
+	AdonisMtvCallbacksPath = "/temporal/mtv"
+	AdonisMpeCallbacksPath = "/temporal/mpe"
+)
+
+// Type of the callbacks errors that retrying would not fix.
+const CallbackRejectedErrorType = "CallbackRejectedError"
+
+// AdonisCallbacks sends the callbacks of the mtv and mpe activities.
+// It is configured from the environment and can be replaced by the worker.
+var AdonisCallbacks = callback.NewClientFromEnv()
+
+// PostAdonisCallback fails when the callback has not been delivered, so that the activity is retried.
+// Errors that retrying would not fix are returned as non retryable application errors.
+func PostAdonisCallback(ctx context.Context, path string, body interface{}) error {
+	err := AdonisCallbacks.Post(ctx, path, body)
+	if err == nil {
+		return nil
+	}
+
+	if !callback.IsRetryable(err) {
+		return temporal.NewNonRetryableApplicationError(err.Error(), CallbackRejectedErrorType, err)
+	}
+
+	return err
+}
@@ -0,0 +1,45 @@
+package activities_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+
+	"github.com/AdonisEnProvence/MusicRoom/activities"
+	"github.com/AdonisEnProvence/MusicRoom/callback"
+	"go.temporal.io/sdk/temporal"
+)
+
+func (s *UnitTestSuite) Test_PostAdonisCallbackOnlyRetriesRecoverableFailures() {
+	statusCode := int32(http.StatusNotFound)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(int(atomic.LoadInt32(&statusCode)))
+	}))
+	defer server.Close()
+
+	previousCallbacks := activities.AdonisCallbacks
+	activities.AdonisCallbacks = callback.NewClient(callback.ClientOptions{
+		BaseURL: server.URL,
+	})
+	defer func() {
+		activities.AdonisCallbacks = previousCallbacks
+	}()
+
+	err := activities.PostAdonisCallback(context.Background(), activities.AdonisMtvCallbacksPath+"/play", struct{}{})
+	var applicationError *temporal.ApplicationError
+	s.True(errors.As(err, &applicationError))
+	s.True(applicationError.NonRetryable())
+	s.Equal(activities.CallbackRejectedErrorType, applicationError.Type())
+
+	atomic.StoreInt32(&statusCode, http.StatusServiceUnavailable)
+	err = activities.PostAdonisCallback(context.Background(), activities.AdonisMtvCallbacksPath+"/play", struct{}{})
+	var statusError *callback.StatusError
+	s.True(errors.As(err, &statusError))
+	s.False(errors.As(err, &applicationError))
+
+	atomic.StoreInt32(&statusCode, http.StatusOK)
+	err = activities.PostAdonisCallback(context.Background(), activities.AdonisMtvCallbacksPath+"/play", struct{}{})
+	s.NoError(err)
+}
@@ -0,0 +1,79 @@
+package activities
+
+import (
+	"context"
+
+	"github.com/AdonisEnProvence/MusicRoom/shared"
+)
+
+const (
+	DefaultSearchTracksLimit = 10
+	MaxSearchTracksLimit     = 50
+	// Candidates among which the first playable one is picked when suggesting or adding by query
+	searchTopTrackCandidatesCount = 5
+)
+
+// SearchTracksActivity returns the playable tracks matching query, most relevant first.
+// Returned tracks are cached so that suggesting or adding one of them does not fetch it again.
+func SearchTracksActivity(ctx context.Context, query string, limit int, policy shared.TrackPlayabilityPolicy) ([]shared.TrackMetadata, error) {
+	metadata, err := searchTracks(ctx, query, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	playableMetadata, _ := policy.Filter(metadata)
+
+	return playableMetadata, nil
+}
+
+// SearchTopTrackActivityAndForwardInitiator resolves query to its most relevant playable track.
+// When there is none, the query is rejected with the reason of the most relevant result,
+// or as having no search result.
+func SearchTopTrackActivityAndForwardInitiator(ctx context.Context, query string, userID string, deviceID string, policy shared.TrackPlayabilityPolicy) (FetchedTracksInformationWithInitiator, error) {
+	metadata, err := searchTracks(ctx, query, searchTopTrackCandidatesCount)
+	if err != nil {
+		return FetchedTracksInformationWithInitiator{}, err
+	}
+
+	result := FetchedTracksInformationWithInitiator{
+		Metadata:       make([]shared.TrackMetadata, 0, 1),
+		RejectedTracks: make([]shared.RejectedTrack, 0),
+		UserID:         userID,
+		DeviceID:       deviceID,
+	}
+
+	playableMetadata, unplayableTracks := policy.Filter(metadata)
+	switch {
+	case len(playableMetadata) > 0:
+		result.Metadata = append(result.Metadata, playableMetadata[0])
+	case len(unplayableTracks) > 0:
+		result.RejectedTracks = append(result.RejectedTracks, unplayableTracks[0])
+	default:
+		result.RejectedTracks = append(result.RejectedTracks, shared.RejectedTrack{
+			ID:     query,
+			Reason: shared.RejectedTrackReasonNoSearchResult,
+		})
+	}
+
+	return result, nil
+}
+
+func searchTracks(ctx context.Context, query string, limit int) ([]shared.TrackMetadata, error) {
+	if limit <= 0 {
+		limit = DefaultSearchTracksLimit
+	}
+	if limit > MaxSearchTracksLimit {
+		limit = MaxSearchTracksLimit
+	}
+
+	metadata, err := getTrackProviders().SearchTracks(ctx, query, limit)
+	if err != nil {
+		return nil, toFetchTracksActivityError(err)
+	}
+
+	if TracksMetadataCache != nil {
+		TracksMetadataCache.Set(metadata)
+	}
+
+	return metadata, nil
+}
@@ -3,71 +3,218 @@ package activities
 import (
 	"context"
 	"errors"
-	"os"
 	"time"
 
+	"github.com/AdonisEnProvence/MusicRoom/providers"
 	"github.com/AdonisEnProvence/MusicRoom/shared"
-	"github.com/AdonisEnProvence/MusicRoom/youtube"
-	"github.com/senseyeio/duration"
+	"go.temporal.io/sdk/activity"
+	"go.temporal.io/sdk/temporal"
 )
 
-var ErrInvalidGoogleAPIKey = errors.New("invalid Google API key")
+var ErrInvalidGoogleAPIKey = providers.ErrInvalidGoogleAPIKey
 
-func FetchTracksInformationActivity(ctx context.Context, tracksIDs []string) ([]shared.TrackMetadata, error) {
-	metadata := make([]shared.TrackMetadata, 0, len(tracksIDs))
+// Types of the fetch activities errors that retrying would not fix.
+// Quota errors are not retried as the provider refuses every call until the quota is reset,
+// the time of the reset is given as the error details, see QuotaResetsAt.
+const (
+	QuotaExceededErrorType       = "QuotaExceededError"
+	InvalidAPIKeyErrorType       = "InvalidAPIKeyError"
+	MpeRoomAccessDeniedErrorType = "MpeRoomAccessDeniedError"
+)
 
-	if len(tracksIDs) == 0 {
-		return metadata, nil
+// FetchTracksRetryPolicy is the retry policy of the activities fetching tracks information.
+var FetchTracksRetryPolicy = &temporal.RetryPolicy{
+	NonRetryableErrorTypes: []string{
+		QuotaExceededErrorType,
+		InvalidAPIKeyErrorType,
+		MpeRoomAccessDeniedErrorType,
+	},
+}
+
+func toFetchTracksActivityError(err error) error {
+	switch {
+	case errors.Is(err, providers.ErrQuotaExceeded):
+		return temporal.NewApplicationError(err.Error(), QuotaExceededErrorType, providers.NextQuotaReset(time.Now()))
+	case errors.Is(err, ErrInvalidGoogleAPIKey):
+		return temporal.NewApplicationError(err.Error(), InvalidAPIKeyErrorType, err)
+	default:
+		return err
+	}
+}
+
+// QuotaResetsAt returns the time after which the fetch activity that failed with err
+// can be scheduled again, it is false when err is not a quota error.
+func QuotaResetsAt(err error) (time.Time, bool) {
+	var applicationError *temporal.ApplicationError
+	if !errors.As(err, &applicationError) || applicationError.Type() != QuotaExceededErrorType {
+		return time.Time{}, false
+	}
+
+	var resetsAt time.Time
+	if err := applicationError.Details(&resetsAt); err != nil {
+		return time.Time{}, false
+	}
+
+	return resetsAt, true
+}
+
+// RejectTracksOfFailedFetch rejects every requested track when the fetch activity failed with err,
+// so that the users are told about it instead of waiting for tracks that will never come.
+// The ID of the rejected track is the query when the top hit of a search was requested.
+func RejectTracksOfFailedFetch(tracksIDs []string, query string, err error) []shared.RejectedTrack {
+	reason := shared.RejectedTrackReasonFetchFailed
+	if _, isQuotaError := QuotaResetsAt(err); isQuotaError {
+		reason = shared.RejectedTrackReasonQuotaExceeded
+	}
+
+	if query != "" {
+		tracksIDs = []string{query}
 	}
 
-	apiKey := os.Getenv("GOOGLE_API_KEY")
-	if apiKey == "" {
-		return nil, ErrInvalidGoogleAPIKey
+	rejectedTracks := make([]shared.RejectedTrack, 0, len(tracksIDs))
+	for _, trackID := range tracksIDs {
+		rejectedTracks = append(rejectedTracks, shared.RejectedTrack{
+			ID:     trackID,
+			Reason: reason,
+		})
 	}
 
-	youtubeResponse, err := youtube.FetchYouTubeVideosInformation(ctx, apiKey, tracksIDs)
+	return rejectedTracks
+}
+
+// TrackProviders resolves the tracks IDs given to the activities below.
+// It is wired up by the worker, YouTube is used alone when it has not been.
+var TrackProviders *providers.Registry
+
+// TracksMetadataCache is optional, when set it is checked before calling TrackProviders.
+var TracksMetadataCache *providers.MetadataCache
+
+func getTrackProviders() *providers.Registry {
+	if TrackProviders == nil {
+		TrackProviders = providers.NewRegistry(providers.NewYouTubeProviderFromEnv())
+	}
+
+	return TrackProviders
+}
+
+// FetchTracksInformationActivity does not fail when only some tracks could not be fetched,
+// they are left out as unknown tracks would be.
+// Rooms fetch their initial tracks with FetchTracksInformationWithPolicyActivity,
+// it is kept registered for the activities scheduled before.
+func FetchTracksInformationActivity(ctx context.Context, tracksIDs []string) ([]shared.TrackMetadata, error) {
+	metadata, _, err := fetchTracksInformation(ctx, tracksIDs)
 	if err != nil {
 		return nil, err
 	}
 
-	for _, entry := range youtubeResponse.Items {
-		parsedDuration, _ := duration.ParseISO8601(entry.ContentDetails.Duration)
+	return metadata, nil
+}
+
+func fetchTracksInformation(ctx context.Context, tracksIDs []string) ([]shared.TrackMetadata, []string, error) {
+	if len(tracksIDs) == 0 {
+		return make([]shared.TrackMetadata, 0), nil, nil
+	}
+
+	var (
+		metadata []shared.TrackMetadata
+		err      error
+	)
+	if TracksMetadataCache == nil {
+		metadata, err = getTrackProviders().FetchTracks(ctx, tracksIDs)
+	} else {
+		metadata, err = TracksMetadataCache.FetchTracks(ctx, tracksIDs, getTrackProviders().FetchTracks)
+	}
 
-		trackMetadata := shared.TrackMetadata{
-			ID:         entry.ID,
-			Title:      entry.Snippet.Title,
-			ArtistName: entry.Snippet.ChannelTitle,
-			Duration:   isoDurationToDuration(parsedDuration),
-		}
+	var partialFetchError *providers.PartialFetchError
+	if errors.As(err, &partialFetchError) {
+		activity.GetLogger(ctx).Warn("some tracks could not be fetched", "FailedTracksIDs", partialFetchError.FailedTracksIDs, "Error", partialFetchError.Err)
 
-		metadata = append(metadata, trackMetadata)
+		return metadata, partialFetchError.FailedTracksIDs, nil
+	}
+	if err != nil {
+		return nil, nil, toFetchTracksActivityError(err)
 	}
 
-	return metadata, nil
+	return metadata, nil, nil
 }
 
 type FetchedTracksInformationWithInitiator struct {
 	Metadata []shared.TrackMetadata
+	// Requested tracks missing from Metadata
+	RejectedTracks []shared.RejectedTrack
+	UserID         string
+	DeviceID       string
+}
+
+type FetchTracksInformationArgs struct {
+	TracksIDs []string
+	// Forwarded with the fetched tracks
 	UserID   string
 	DeviceID string
+	// Tracks that can not be played according to it are rejected
+	Policy shared.TrackPlayabilityPolicy
 }
 
-func FetchTracksInformationActivityAndForwardInitiator(ctx context.Context, tracksIDs []string, userID string, deviceID string) (FetchedTracksInformationWithInitiator, error) {
-	metadata, err := FetchTracksInformationActivity(ctx, tracksIDs)
+// FetchTracksInformationWithPolicyActivity rejects the requested tracks that could not be fetched
+// or that can not be played according to the room policy.
+// Its arguments are grouped in a struct so that fields can be added
+// without breaking the activities already scheduled.
+func FetchTracksInformationWithPolicyActivity(ctx context.Context, args FetchTracksInformationArgs) (FetchedTracksInformationWithInitiator, error) {
+	metadata, failedTracksIDs, err := fetchTracksInformation(ctx, args.TracksIDs)
 	if err != nil {
 		return FetchedTracksInformationWithInitiator{}, err
 	}
 
+	playableMetadata, unplayableTracks := args.Policy.Filter(metadata)
+	rejectedTracks := append(computeRejectedTracks(args.TracksIDs, metadata, failedTracksIDs), unplayableTracks...)
+
 	return FetchedTracksInformationWithInitiator{
-		Metadata: metadata,
-		UserID:   userID,
-		DeviceID: deviceID,
+		Metadata:       playableMetadata,
+		RejectedTracks: rejectedTracks,
+		UserID:         args.UserID,
+		DeviceID:       args.DeviceID,
 	}, nil
 }
 
-func isoDurationToDuration(d duration.Duration) time.Duration {
-	now := time.Now()
-	appliedDuration := d.Shift(now)
+// FetchTracksInformationActivityAndForwardInitiator is only kept registered for the activities
+// scheduled before FetchTracksInformationWithPolicyActivity, which rooms now use.
+// The default playability policy is applied.
+func FetchTracksInformationActivityAndForwardInitiator(ctx context.Context, tracksIDs []string, userID string, deviceID string) (FetchedTracksInformationWithInitiator, error) {
+	return FetchTracksInformationWithPolicyActivity(ctx, FetchTracksInformationArgs{
+		TracksIDs: tracksIDs,
+		UserID:    userID,
+		DeviceID:  deviceID,
+	})
+}
+
+func computeRejectedTracks(tracksIDs []string, metadata []shared.TrackMetadata, failedTracksIDs []string) []shared.RejectedTrack {
+	handledTracksIDs := make(map[string]bool, len(tracksIDs))
+	for _, trackMetadata := range metadata {
+		handledTracksIDs[trackMetadata.ID] = true
+	}
+
+	failedTracks := make(map[string]bool, len(failedTracksIDs))
+	for _, trackID := range failedTracksIDs {
+		failedTracks[trackID] = true
+	}
+
+	rejectedTracks := make([]shared.RejectedTrack, 0)
+	for _, trackID := range tracksIDs {
+		if handledTracksIDs[trackID] {
+			continue
+		}
+		handledTracksIDs[trackID] = true
+
+		reason := shared.RejectedTrackReasonNotFound
+		if failedTracks[trackID] {
+			reason = shared.RejectedTrackReasonFetchFailed
+		}
+
+		rejectedTracks = append(rejectedTracks, shared.RejectedTrack{
+			ID:     trackID,
+			Reason: reason,
+		})
+	}
 
-	return appliedDuration.Sub(now)
+	return rejectedTracks
 }
@@ -0,0 +1,314 @@
+package activities_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/AdonisEnProvence/MusicRoom/activities"
+	shared_mpe "github.com/AdonisEnProvence/MusicRoom/mpe/shared"
+	shared_mtv "github.com/AdonisEnProvence/MusicRoom/mtv/shared"
+	"github.com/AdonisEnProvence/MusicRoom/providers"
+	"github.com/AdonisEnProvence/MusicRoom/shared"
+	"github.com/AdonisEnProvence/MusicRoom/youtube"
+	"github.com/AdonisEnProvence/MusicRoom/youtube/youtubetest"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	"go.temporal.io/sdk/client"
+	"go.temporal.io/sdk/converter"
+	"go.temporal.io/sdk/mocks"
+	"go.temporal.io/sdk/temporal"
+	"go.temporal.io/sdk/testsuite"
+)
+
+type UnitTestSuite struct {
+	suite.Suite
+	testsuite.WorkflowTestSuite
+
+	env           *testsuite.TestActivityEnvironment
+	youtubeServer *youtubetest.Server
+}
+
+func (s *UnitTestSuite) SetupTest() {
+	httpServer, youtubeServer, err := youtubetest.NewTestServer(youtubetest.Options{
+		FixturesDir: "../youtube/youtubetest/fixtures",
+		APIKey:      "api-key",
+	})
+	s.Require().NoError(err)
+	s.T().Cleanup(httpServer.Close)
+	s.youtubeServer = youtubeServer
+
+	activities.TrackProviders = providers.NewRegistry(providers.NewYouTubeProvider(youtube.ClientOptions{
+		APIKey:  "api-key",
+		BaseURL: httpServer.URL,
+	}))
+	activities.TracksMetadataCache = nil
+
+	s.env = s.NewTestActivityEnvironment()
+	s.env.RegisterActivity(activities.FetchTracksInformationActivity)
+	s.env.RegisterActivity(activities.FetchTracksInformationActivityAndForwardInitiator)
+	s.env.RegisterActivity(activities.FetchTracksInformationWithPolicyActivity)
+	s.env.RegisterActivity(activities.SearchTracksActivity)
+	s.env.RegisterActivity(activities.SearchTopTrackActivityAndForwardInitiator)
+	s.env.RegisterActivity(activities.FetchAutoDJTracksActivity)
+}
+
+func (s *UnitTestSuite) TearDownTest() {
+	activities.TrackProviders = nil
+	activities.TemporalClient = nil
+}
+
+func (s *UnitTestSuite) Test_FetchTracksInformation() {
+	value, err := s.env.ExecuteActivity(activities.FetchTracksInformationActivity, []string{"dQw4w9WgXcQ", "unknown"})
+	s.NoError(err)
+
+	var metadata []shared.TrackMetadata
+	s.NoError(value.Get(&metadata))
+	s.Len(metadata, 1)
+	s.Equal("dQw4w9WgXcQ", metadata[0].ID)
+	s.Equal("Rick Astley", metadata[0].ArtistName)
+}
+
+func (s *UnitTestSuite) Test_FetchTracksInformationRejectsMissingAndUnplayableTracks() {
+	value, err := s.env.ExecuteActivity(
+		activities.FetchTracksInformationWithPolicyActivity,
+		activities.FetchTracksInformationArgs{
+			TracksIDs: []string{"dQw4w9WgXcQ", "unknown", "55SwKPVMVM4"},
+			UserID:    "user-id",
+			DeviceID:  "device-id",
+		},
+	)
+	s.NoError(err)
+
+	var fetchedTracks activities.FetchedTracksInformationWithInitiator
+	s.NoError(value.Get(&fetchedTracks))
+	s.Len(fetchedTracks.Metadata, 1)
+	s.Equal("dQw4w9WgXcQ", fetchedTracks.Metadata[0].ID)
+	s.Equal([]shared.RejectedTrack{
+		{ID: "unknown", Reason: shared.RejectedTrackReasonNotFound},
+		{ID: "55SwKPVMVM4", Reason: shared.RejectedTrackReasonNotEmbeddable},
+	}, fetchedTracks.RejectedTracks)
+	s.Equal("user-id", fetchedTracks.UserID)
+}
+
+func (s *UnitTestSuite) Test_FetchTracksInformationAppliesTheGivenPolicy() {
+	value, err := s.env.ExecuteActivity(
+		activities.FetchTracksInformationWithPolicyActivity,
+		activities.FetchTracksInformationArgs{
+			TracksIDs: []string{"9Tfciw7QM3c", "55SwKPVMVM4"},
+			Policy: shared.TrackPlayabilityPolicy{
+				AllowNotEmbeddable: true,
+			},
+		},
+	)
+	s.NoError(err)
+
+	var fetchedTracks activities.FetchedTracksInformationWithInitiator
+	s.NoError(value.Get(&fetchedTracks))
+	s.Len(fetchedTracks.Metadata, 1)
+	s.Equal("55SwKPVMVM4", fetchedTracks.Metadata[0].ID)
+	s.Equal([]shared.RejectedTrack{
+		{ID: "9Tfciw7QM3c", Reason: shared.RejectedTrackReasonZeroDuration},
+	}, fetchedTracks.RejectedTracks)
+}
+
+func (s *UnitTestSuite) Test_FetchTracksInformationActivityAndForwardInitiatorAcceptsPreviousArguments() {
+	value, err := s.env.ExecuteActivity(
+		activities.FetchTracksInformationActivityAndForwardInitiator,
+		[]string{"dQw4w9WgXcQ"},
+		"user-id",
+		"device-id",
+	)
+	s.NoError(err)
+
+	var fetchedTracks activities.FetchedTracksInformationWithInitiator
+	s.NoError(value.Get(&fetchedTracks))
+	s.Len(fetchedTracks.Metadata, 1)
+	s.Equal("device-id", fetchedTracks.DeviceID)
+}
+
+func (s *UnitTestSuite) Test_FetchTracksInformationRejectsOnlyUnknownTracksAsNotFound() {
+	value, err := s.env.ExecuteActivity(
+		activities.FetchTracksInformationWithPolicyActivity,
+		activities.FetchTracksInformationArgs{
+			TracksIDs: []string{"doesNotExist"},
+			UserID:    "user-id",
+			DeviceID:  "device-id",
+		},
+	)
+	s.NoError(err)
+
+	var fetchedTracks activities.FetchedTracksInformationWithInitiator
+	s.NoError(value.Get(&fetchedTracks))
+	s.Empty(fetchedTracks.Metadata)
+	s.Equal([]shared.RejectedTrack{
+		{ID: "doesNotExist", Reason: shared.RejectedTrackReasonNotFound},
+	}, fetchedTracks.RejectedTracks)
+}
+
+func (s *UnitTestSuite) Test_FetchTracksInformationFailsWithNonRetryableQuotaError() {
+	s.youtubeServer.SetQuotaExceeded(true)
+
+	_, err := s.env.ExecuteActivity(activities.FetchTracksInformationActivity, []string{"dQw4w9WgXcQ"})
+
+	var applicationError *temporal.ApplicationError
+	s.True(errors.As(err, &applicationError))
+	s.Equal(activities.QuotaExceededErrorType, applicationError.Type())
+	s.Contains(activities.FetchTracksRetryPolicy.NonRetryableErrorTypes, applicationError.Type())
+
+	resetsAt, isQuotaError := activities.QuotaResetsAt(err)
+	s.True(isQuotaError)
+	s.True(resetsAt.After(time.Now()))
+	s.Equal([]shared.RejectedTrack{
+		{ID: "dQw4w9WgXcQ", Reason: shared.RejectedTrackReasonQuotaExceeded},
+	}, activities.RejectTracksOfFailedFetch([]string{"dQw4w9WgXcQ"}, "", err))
+}
+
+func (s *UnitTestSuite) Test_SearchTracksLeavesOutUnplayableTracks() {
+	value, err := s.env.ExecuteActivity(activities.SearchTracksActivity, "music", 0, shared.TrackPlayabilityPolicy{})
+	s.NoError(err)
+
+	var metadata []shared.TrackMetadata
+	s.NoError(value.Get(&metadata))
+	s.Len(metadata, 1)
+	s.Equal("dQw4w9WgXcQ", metadata[0].ID)
+}
+
+func (s *UnitTestSuite) Test_SearchTopTrack() {
+	value, err := s.env.ExecuteActivity(
+		activities.SearchTopTrackActivityAndForwardInitiator,
+		"never gonna give you up",
+		"user-id",
+		"device-id",
+		shared.TrackPlayabilityPolicy{},
+	)
+	s.NoError(err)
+
+	var fetchedTracks activities.FetchedTracksInformationWithInitiator
+	s.NoError(value.Get(&fetchedTracks))
+	s.Len(fetchedTracks.Metadata, 1)
+	s.Equal("dQw4w9WgXcQ", fetchedTracks.Metadata[0].ID)
+	s.Empty(fetchedTracks.RejectedTracks)
+
+	value, err = s.env.ExecuteActivity(
+		activities.SearchTopTrackActivityAndForwardInitiator,
+		"not embeddable",
+		"user-id",
+		"device-id",
+		shared.TrackPlayabilityPolicy{},
+	)
+	s.NoError(err)
+
+	s.NoError(value.Get(&fetchedTracks))
+	s.Empty(fetchedTracks.Metadata)
+	s.Equal([]shared.RejectedTrack{
+		{ID: "55SwKPVMVM4", Reason: shared.RejectedTrackReasonNotEmbeddable},
+	}, fetchedTracks.RejectedTracks)
+
+	value, err = s.env.ExecuteActivity(
+		activities.SearchTopTrackActivityAndForwardInitiator,
+		"nothing matches",
+		"user-id",
+		"device-id",
+		shared.TrackPlayabilityPolicy{},
+	)
+	s.NoError(err)
+
+	s.NoError(value.Get(&fetchedTracks))
+	s.Empty(fetchedTracks.Metadata)
+	s.Equal([]shared.RejectedTrack{
+		{ID: "nothing matches", Reason: shared.RejectedTrackReasonNoSearchResult},
+	}, fetchedTracks.RejectedTracks)
+}
+
+func (s *UnitTestSuite) Test_FetchAutoDJTracksPrefersTracksThatHaveNotBeenPlayed() {
+	args := activities.FetchAutoDJTracksArgs{
+		Options: shared_mtv.MtvRoomAutoDJOptions{
+			Source:            shared_mtv.MtvRoomAutoDJSourceFallbackTracks,
+			FallbackTracksIDs: []string{"dQw4w9WgXcQ", "55SwKPVMVM4", "Ks-_Mh1QhMc"},
+			BatchSize:         2,
+		},
+		QueuedTracksIDs: []string{},
+		PlayedTracksIDs: []string{"dQw4w9WgXcQ"},
+	}
+
+	value, err := s.env.ExecuteActivity(activities.FetchAutoDJTracksActivity, args)
+	s.NoError(err)
+
+	var metadata []shared.TrackMetadata
+	s.NoError(value.Get(&metadata))
+	// The not embeddable track is left out, the played one fills the batch
+	s.Len(metadata, 2)
+	s.Equal("Ks-_Mh1QhMc", metadata[0].ID)
+	s.Equal("dQw4w9WgXcQ", metadata[1].ID)
+
+	args.QueuedTracksIDs = []string{"Ks-_Mh1QhMc"}
+	value, err = s.env.ExecuteActivity(activities.FetchAutoDJTracksActivity, args)
+	s.NoError(err)
+
+	s.NoError(value.Get(&metadata))
+	s.Len(metadata, 1)
+	s.Equal("dQw4w9WgXcQ", metadata[0].ID)
+}
+
+func (s *UnitTestSuite) Test_FetchAutoDJTracksReadsPrivateMpePlaylistOnlyForMembers() {
+	var (
+		mpeRoomID     = "mpe-room-id"
+		creatorUserID = "creator-user-id"
+		mpeTrack      = shared.TrackMetadata{ID: "dQw4w9WgXcQ", Duration: time.Minute}
+	)
+
+	mockMpeRoomState := func(temporalClient *mocks.Client, state shared_mpe.MpeRoomExposedState) {
+		payloads, err := converter.GetDefaultDataConverter().ToPayloads(state)
+		s.Require().NoError(err)
+
+		temporalClient.On(
+			"QueryWorkflow",
+			mock.Anything,
+			mpeRoomID,
+			"",
+			shared_mpe.MpeGetStateQuery,
+			creatorUserID,
+		).Return(client.NewValue(payloads), nil).Once()
+	}
+	args := activities.FetchAutoDJTracksArgs{
+		Options: shared_mtv.MtvRoomAutoDJOptions{
+			Source:    shared_mtv.MtvRoomAutoDJSourceMpePlaylist,
+			MpeRoomID: mpeRoomID,
+		},
+		CreatorUserID: creatorUserID,
+	}
+
+	memberTemporalClient := &mocks.Client{}
+	activities.TemporalClient = memberTemporalClient
+	mockMpeRoomState(memberTemporalClient, shared_mpe.MpeRoomExposedState{
+		Tracks:                 []shared.TrackMetadata{mpeTrack},
+		UserRelatedInformation: &shared_mpe.InternalStateUser{UserID: creatorUserID},
+	})
+
+	value, err := s.env.ExecuteActivity(activities.FetchAutoDJTracksActivity, args)
+	s.NoError(err)
+
+	var metadata []shared.TrackMetadata
+	s.NoError(value.Get(&metadata))
+	s.Equal([]shared.TrackMetadata{mpeTrack}, metadata)
+	memberTemporalClient.AssertExpectations(s.T())
+
+	notMemberTemporalClient := &mocks.Client{}
+	activities.TemporalClient = notMemberTemporalClient
+	mockMpeRoomState(notMemberTemporalClient, shared_mpe.MpeRoomExposedState{
+		Tracks: []shared.TrackMetadata{mpeTrack},
+	})
+
+	_, err = s.env.ExecuteActivity(activities.FetchAutoDJTracksActivity, args)
+
+	var applicationError *temporal.ApplicationError
+	s.True(errors.As(err, &applicationError))
+	s.Equal(activities.MpeRoomAccessDeniedErrorType, applicationError.Type())
+	s.Contains(activities.FetchTracksRetryPolicy.NonRetryableErrorTypes, applicationError.Type())
+	notMemberTemporalClient.AssertExpectations(s.T())
+}
+
+func TestUnitTestSuite(t *testing.T) {
+	suite.Run(t, new(UnitTestSuite))
+}
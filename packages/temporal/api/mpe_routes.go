@@ -19,6 +19,7 @@ import (
 func AddMpeHandler(r *mux.Router) {
 	r.Handle("/mpe/create", http.HandlerFunc(createMpeRoomHandler)).Methods(http.MethodPut)
 	r.Handle("/mpe/add-tracks", http.HandlerFunc(MpeAddTracksHandler)).Methods(http.MethodPut)
+	r.Handle("/mpe/search-tracks", http.HandlerFunc(SearchTracksHandler)).Methods(http.MethodPut)
 	r.Handle("/mpe/change-track-order", http.HandlerFunc(MpeChangeTrackOrderHandler)).Methods(http.MethodPut)
 	r.Handle("/mpe/delete-tracks", http.HandlerFunc(MpeDeleteTracksHandler)).Methods(http.MethodPut)
 	r.Handle("/mpe/get-state", http.HandlerFunc(getStateQueryHandler)).Methods(http.MethodPut)
@@ -87,6 +88,9 @@ type MpeCreateRoomRequestBody struct {
 
 	IsOpen                        bool `json:"isOpen"`
 	IsOpenOnlyInvitedUsersCanEdit bool `json:"isOpenOnlyInvitedUsersCanEdit"`
+
+	PlayabilityPolicy shared.TrackPlayabilityPolicy `json:"playabilityPolicy"`
+	IdlePolicy        shared.RoomIdlePolicy         `json:"idlePolicy"`
 }
 
 type MpeCreateRoomResponse struct {
@@ -133,6 +137,8 @@ func createMpeRoomHandler(w http.ResponseWriter, r *http.Request) {
 		InitialTracksIDs:              []string{initialTrackID},
 		IsOpen:                        body.IsOpen,
 		IsOpenOnlyInvitedUsersCanEdit: body.IsOpenOnlyInvitedUsersCanEdit,
+		PlayabilityPolicy:             body.PlayabilityPolicy,
+		IdlePolicy:                    body.IdlePolicy,
 	}
 
 	we, err := temporal.ExecuteWorkflow(context.Background(), options, mpe.MpeRoomWorkflow, params)
@@ -165,7 +171,9 @@ func createMpeRoomHandler(w http.ResponseWriter, r *http.Request) {
 type MpeAddTracksRequestBody struct {
 	WorkflowID string `json:"workflowID" validate:"required,uuid"`
 
-	TracksIDs []string `json:"tracksIDs" validate:"required,dive,required"`
+	// Either tracksIDs or query, the top hit of the search of query is added
+	TracksIDs []string `json:"tracksIDs" validate:"required_without=Query,excluded_with=Query,dive,required"`
+	Query     string   `json:"query" validate:"max=200"`
 	UserID    string   `json:"userID" validate:"required"`
 	DeviceID  string   `json:"deviceID" validate:"required"`
 }
@@ -186,6 +194,7 @@ func MpeAddTracksHandler(w http.ResponseWriter, r *http.Request) {
 
 	signal := shared_mpe.NewAddTracksSignal(shared_mpe.NewAddTracksSignalArgs{
 		TracksIDs: body.TracksIDs,
+		Query:     body.Query,
 		UserID:    body.UserID,
 		DeviceID:  body.DeviceID,
 	})
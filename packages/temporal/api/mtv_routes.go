@@ -6,24 +6,39 @@ import (
 	"fmt"
 	"log"
 	"net/http"
+	"time"
 
+	"github.com/AdonisEnProvence/MusicRoom/activities"
 	shared_mtv "github.com/AdonisEnProvence/MusicRoom/mtv/shared"
 	mtv "github.com/AdonisEnProvence/MusicRoom/mtv/workflows"
+	"github.com/AdonisEnProvence/MusicRoom/shared"
 	"github.com/gorilla/mux"
 	"go.temporal.io/sdk/client"
 )
 
+// The room workflow regularly continues as new, which gives it a new run id.
+// The runID sent by Adonis is still required but signals and queries
+// always target the latest run of the workflow.
 func AddMtvHandler(r *mux.Router) {
 	r.Handle("/mtv/play", http.HandlerFunc(PlayHandler)).Methods(http.MethodPut)
 	r.Handle("/mtv/pause", http.HandlerFunc(PauseHandler)).Methods(http.MethodPut)
 	r.Handle("/mtv/create", http.HandlerFunc(CreateRoomHandler)).Methods(http.MethodPut)
 	r.Handle("/mtv/join", http.HandlerFunc(JoinRoomHandler)).Methods(http.MethodPut)
 	r.Handle("/mtv/vote-for-track", http.HandlerFunc(VoteForTrackHandler)).Methods(http.MethodPut)
+	r.Handle("/mtv/unvote-for-track", http.HandlerFunc(UnvoteForTrackHandler)).Methods(http.MethodPut)
+	r.Handle("/mtv/downvote-for-track", http.HandlerFunc(DownvoteForTrackHandler)).Methods(http.MethodPut)
+	r.Handle("/mtv/vote-to-skip-current-track", http.HandlerFunc(VoteToSkipCurrentTrackHandler)).Methods(http.MethodPut)
 	r.Handle("/mtv/leave", http.HandlerFunc(LeaveRoomHandler)).Methods(http.MethodPut)
 	r.Handle("/mtv/change-user-emitting-device", http.HandlerFunc(ChangeUserEmittingDeviceHandler)).Methods(http.MethodPut)
 	r.Handle("/mtv/update-user-fits-position-constraint", http.HandlerFunc(UpdateUserFitsPositionConstraintHandler)).Methods(http.MethodPut)
 	r.Handle("/mtv/go-to-next-track", http.HandlerFunc(GoToNextTrackHandler)).Methods(http.MethodPut)
+	r.Handle("/mtv/seek", http.HandlerFunc(SeekHandler)).Methods(http.MethodPut)
+	r.Handle("/mtv/kick-user", http.HandlerFunc(KickUserHandler)).Methods(http.MethodPut)
+	r.Handle("/mtv/ban-user", http.HandlerFunc(BanUserHandler)).Methods(http.MethodPut)
+	r.Handle("/mtv/update-room-settings", http.HandlerFunc(UpdateRoomSettingsHandler)).Methods(http.MethodPut)
+	r.Handle("/mtv/remove-tracks", http.HandlerFunc(RemoveTracksHandler)).Methods(http.MethodPut)
 	r.Handle("/mtv/suggest-tracks", http.HandlerFunc(SuggestTracksHandler)).Methods(http.MethodPut)
+	r.Handle("/mtv/search-tracks", http.HandlerFunc(SearchTracksHandler)).Methods(http.MethodPut)
 	r.Handle("/mtv/terminate", http.HandlerFunc(TerminateWorkflowHandler)).Methods(http.MethodPut)
 	r.Handle("/mtv/update-delegation-owner", http.HandlerFunc(UpdateDelegationOwnerHandler)).Methods(http.MethodPut)
 	r.Handle("/mtv/update-control-and-delegation-permission", http.HandlerFunc(UpdateControlAndDelegationPermissionHandler)).Methods(http.MethodPut)
@@ -31,11 +46,12 @@ func AddMtvHandler(r *mux.Router) {
 	r.Handle("/mtv/room-constraints-details", http.HandlerFunc(GetRoomConstraintsDetailsHandler)).Methods(http.MethodPut)
 	r.Handle("/mtv/state", http.HandlerFunc(GetStateHandler)).Methods(http.MethodPut)
 	r.Handle("/mtv/users-list", http.HandlerFunc(GetUsersListHandler)).Methods(http.MethodPut)
+	r.Handle("/mtv/played-tracks", http.HandlerFunc(GetPlayedTracksHandler)).Methods(http.MethodPut)
 }
 
 type PlayRequestBody struct {
 	WorkflowID string `json:"workflowID" validate:"required,uuid"`
-	RunID      string `json:"runID" validate:"required,uuid"`
+	RunID      string `json:"runID" validate:"omitempty,uuid"`
 	UserID     string `json:"userID" validate:"required,uuid"`
 }
 
@@ -59,7 +75,7 @@ func PlayHandler(w http.ResponseWriter, r *http.Request) {
 	if err := temporal.SignalWorkflow(
 		context.Background(),
 		body.WorkflowID,
-		body.RunID,
+		shared.NoWorkflowRunID,
 		shared_mtv.SignalChannelName,
 		signal,
 	); err != nil {
@@ -75,7 +91,7 @@ func PlayHandler(w http.ResponseWriter, r *http.Request) {
 
 type PauseRequestBody struct {
 	WorkflowID string `json:"workflowID" validate:"required,uuid"`
-	RunID      string `json:"runID" validate:"required,uuid"`
+	RunID      string `json:"runID" validate:"omitempty,uuid"`
 	UserID     string `json:"userID" validate:"required,uuid"`
 }
 
@@ -99,7 +115,7 @@ func PauseHandler(w http.ResponseWriter, r *http.Request) {
 	if err := temporal.SignalWorkflow(
 		context.Background(),
 		body.WorkflowID,
-		body.RunID,
+		shared.NoWorkflowRunID,
 		shared_mtv.SignalChannelName,
 		signal,
 	); err != nil {
@@ -115,7 +131,7 @@ func PauseHandler(w http.ResponseWriter, r *http.Request) {
 
 type GoToNextTrackRequestBody struct {
 	WorkflowID string `json:"workflowID" validate:"required,uuid"`
-	RunID      string `json:"runID" validate:"required,uuid"`
+	RunID      string `json:"runID" validate:"omitempty,uuid"`
 	UserID     string `json:"userID" validate:"required,uuid"`
 }
 
@@ -139,7 +155,7 @@ func GoToNextTrackHandler(w http.ResponseWriter, r *http.Request) {
 	if err := temporal.SignalWorkflow(
 		context.Background(),
 		body.WorkflowID,
-		body.RunID,
+		shared.NoWorkflowRunID,
 		shared_mtv.SignalChannelName,
 		goToNextTrackSignal,
 	); err != nil {
@@ -155,7 +171,7 @@ func GoToNextTrackHandler(w http.ResponseWriter, r *http.Request) {
 
 type VoteForTrackHandlerRequestBody struct {
 	WorkflowID string `json:"workflowID" validate:"required,uuid"`
-	RunID      string `json:"runID" validate:"required,uuid"`
+	RunID      string `json:"runID" validate:"omitempty,uuid"`
 	TrackID    string `json:"trackID" validate:"required"`
 	UserID     string `json:"userID" validate:"required,uuid"`
 }
@@ -182,7 +198,7 @@ func VoteForTrackHandler(w http.ResponseWriter, r *http.Request) {
 	if err := temporal.SignalWorkflow(
 		context.Background(),
 		body.WorkflowID,
-		body.RunID,
+		shared.NoWorkflowRunID,
 		shared_mtv.SignalChannelName,
 		voteForTrackSignal,
 	); err != nil {
@@ -196,9 +212,344 @@ func VoteForTrackHandler(w http.ResponseWriter, r *http.Request) {
 	json.NewEncoder(w).Encode(res)
 }
 
+type UnvoteForTrackHandlerRequestBody struct {
+	WorkflowID string `json:"workflowID" validate:"required,uuid"`
+	TrackID    string `json:"trackID" validate:"required"`
+	UserID     string `json:"userID" validate:"required,uuid"`
+}
+
+func UnvoteForTrackHandler(w http.ResponseWriter, r *http.Request) {
+	defer r.Body.Close()
+
+	var body UnvoteForTrackHandlerRequestBody
+
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		WriteError(w, err)
+		return
+	}
+	if err := validate.Struct(body); err != nil {
+		WriteError(w, err)
+		return
+	}
+
+	unvoteForTrackSignal := shared_mtv.NewUnvoteForTrackSignal(shared_mtv.NewUnvoteForTrackSignalArgs{
+		TrackID: body.TrackID,
+		UserID:  body.UserID,
+	})
+
+	if err := temporal.SignalWorkflow(
+		context.Background(),
+		body.WorkflowID,
+		shared.NoWorkflowRunID,
+		shared_mtv.SignalChannelName,
+		unvoteForTrackSignal,
+	); err != nil {
+		WriteError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	res := make(map[string]interface{})
+	res["ok"] = 1
+	json.NewEncoder(w).Encode(res)
+}
+
+type DownvoteForTrackHandlerRequestBody struct {
+	WorkflowID string `json:"workflowID" validate:"required,uuid"`
+	TrackID    string `json:"trackID" validate:"required"`
+	UserID     string `json:"userID" validate:"required,uuid"`
+}
+
+func DownvoteForTrackHandler(w http.ResponseWriter, r *http.Request) {
+	defer r.Body.Close()
+
+	var body DownvoteForTrackHandlerRequestBody
+
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		WriteError(w, err)
+		return
+	}
+	if err := validate.Struct(body); err != nil {
+		WriteError(w, err)
+		return
+	}
+
+	downvoteForTrackSignal := shared_mtv.NewDownvoteForTrackSignal(shared_mtv.NewDownvoteForTrackSignalArgs{
+		TrackID: body.TrackID,
+		UserID:  body.UserID,
+	})
+
+	if err := temporal.SignalWorkflow(
+		context.Background(),
+		body.WorkflowID,
+		shared.NoWorkflowRunID,
+		shared_mtv.SignalChannelName,
+		downvoteForTrackSignal,
+	); err != nil {
+		WriteError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	res := make(map[string]interface{})
+	res["ok"] = 1
+	json.NewEncoder(w).Encode(res)
+}
+
+type SeekHandlerRequestBody struct {
+	WorkflowID string `json:"workflowID" validate:"required,uuid"`
+	UserID     string `json:"userID" validate:"required,uuid"`
+	//Offset in milliseconds from the beginning of the current track
+	Offset int64 `json:"offset" validate:"min=0"`
+}
+
+func SeekHandler(w http.ResponseWriter, r *http.Request) {
+	defer r.Body.Close()
+
+	var body SeekHandlerRequestBody
+
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		WriteError(w, err)
+		return
+	}
+	if err := validate.Struct(body); err != nil {
+		WriteError(w, err)
+		return
+	}
+
+	seekSignal := shared_mtv.NewSeekSignal(shared_mtv.NewSeekSignalArgs{
+		UserID: body.UserID,
+		Offset: time.Duration(body.Offset) * time.Millisecond,
+	})
+
+	if err := temporal.SignalWorkflow(
+		context.Background(),
+		body.WorkflowID,
+		shared.NoWorkflowRunID,
+		shared_mtv.SignalChannelName,
+		seekSignal,
+	); err != nil {
+		WriteError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	res := make(map[string]interface{})
+	res["ok"] = 1
+	json.NewEncoder(w).Encode(res)
+}
+
+type KickUserHandlerRequestBody struct {
+	WorkflowID    string `json:"workflowID" validate:"required,uuid"`
+	EmitterUserID string `json:"emitterUserID" validate:"required,uuid"`
+	KickedUserID  string `json:"kickedUserID" validate:"required,uuid"`
+}
+
+func KickUserHandler(w http.ResponseWriter, r *http.Request) {
+	defer r.Body.Close()
+
+	var body KickUserHandlerRequestBody
+
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		WriteError(w, err)
+		return
+	}
+	if err := validate.Struct(body); err != nil {
+		WriteError(w, err)
+		return
+	}
+
+	kickUserSignal := shared_mtv.NewKickUserSignal(shared_mtv.NewKickUserSignalArgs{
+		KickedUserID:  body.KickedUserID,
+		EmitterUserID: body.EmitterUserID,
+	})
+
+	if err := temporal.SignalWorkflow(
+		context.Background(),
+		body.WorkflowID,
+		shared.NoWorkflowRunID,
+		shared_mtv.SignalChannelName,
+		kickUserSignal,
+	); err != nil {
+		WriteError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	res := make(map[string]interface{})
+	res["ok"] = 1
+	json.NewEncoder(w).Encode(res)
+}
+
+type BanUserHandlerRequestBody struct {
+	WorkflowID    string `json:"workflowID" validate:"required,uuid"`
+	EmitterUserID string `json:"emitterUserID" validate:"required,uuid"`
+	BannedUserID  string `json:"bannedUserID" validate:"required,uuid"`
+}
+
+func BanUserHandler(w http.ResponseWriter, r *http.Request) {
+	defer r.Body.Close()
+
+	var body BanUserHandlerRequestBody
+
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		WriteError(w, err)
+		return
+	}
+	if err := validate.Struct(body); err != nil {
+		WriteError(w, err)
+		return
+	}
+
+	banUserSignal := shared_mtv.NewBanUserSignal(shared_mtv.NewBanUserSignalArgs{
+		BannedUserID:  body.BannedUserID,
+		EmitterUserID: body.EmitterUserID,
+	})
+
+	if err := temporal.SignalWorkflow(
+		context.Background(),
+		body.WorkflowID,
+		shared.NoWorkflowRunID,
+		shared_mtv.SignalChannelName,
+		banUserSignal,
+	); err != nil {
+		WriteError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	res := make(map[string]interface{})
+	res["ok"] = 1
+	json.NewEncoder(w).Encode(res)
+}
+
+type UpdateRoomSettingsHandlerRequestBody struct {
+	WorkflowID string                           `json:"workflowID" validate:"required,uuid"`
+	UserID     string                           `json:"userID" validate:"required,uuid"`
+	Settings   shared_mtv.MtvRoomSettingsUpdate `json:"settings" validate:"required"`
+}
+
+func UpdateRoomSettingsHandler(w http.ResponseWriter, r *http.Request) {
+	defer r.Body.Close()
+
+	var body UpdateRoomSettingsHandlerRequestBody
+
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		WriteError(w, err)
+		return
+	}
+	if err := validate.Struct(body); err != nil {
+		WriteError(w, err)
+		return
+	}
+
+	signal := shared_mtv.NewUpdateRoomSettingsSignal(shared_mtv.NewUpdateRoomSettingsSignalArgs{
+		UserID:   body.UserID,
+		Settings: body.Settings,
+	})
+
+	if err := temporal.SignalWorkflow(
+		context.Background(),
+		body.WorkflowID,
+		shared.NoWorkflowRunID,
+		shared_mtv.SignalChannelName,
+		signal,
+	); err != nil {
+		WriteError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	res := make(map[string]interface{})
+	res["ok"] = 1
+	json.NewEncoder(w).Encode(res)
+}
+
+type RemoveTracksHandlerRequestBody struct {
+	WorkflowID string   `json:"workflowID" validate:"required,uuid"`
+	UserID     string   `json:"userID" validate:"required,uuid"`
+	TracksIDs  []string `json:"tracksIDs" validate:"required,min=1,dive,required"`
+}
+
+func RemoveTracksHandler(w http.ResponseWriter, r *http.Request) {
+	defer r.Body.Close()
+
+	var body RemoveTracksHandlerRequestBody
+
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		WriteError(w, err)
+		return
+	}
+	if err := validate.Struct(body); err != nil {
+		WriteError(w, err)
+		return
+	}
+
+	signal := shared_mtv.NewRemoveTracksSignal(shared_mtv.NewRemoveTracksSignalArgs{
+		UserID:    body.UserID,
+		TracksIDs: body.TracksIDs,
+	})
+
+	if err := temporal.SignalWorkflow(
+		context.Background(),
+		body.WorkflowID,
+		shared.NoWorkflowRunID,
+		shared_mtv.SignalChannelName,
+		signal,
+	); err != nil {
+		WriteError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	res := make(map[string]interface{})
+	res["ok"] = 1
+	json.NewEncoder(w).Encode(res)
+}
+
+type VoteToSkipCurrentTrackHandlerRequestBody struct {
+	WorkflowID string `json:"workflowID" validate:"required,uuid"`
+	UserID     string `json:"userID" validate:"required,uuid"`
+}
+
+func VoteToSkipCurrentTrackHandler(w http.ResponseWriter, r *http.Request) {
+	defer r.Body.Close()
+
+	var body VoteToSkipCurrentTrackHandlerRequestBody
+
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		WriteError(w, err)
+		return
+	}
+	if err := validate.Struct(body); err != nil {
+		WriteError(w, err)
+		return
+	}
+
+	voteToSkipCurrentTrackSignal := shared_mtv.NewVoteToSkipCurrentTrackSignal(shared_mtv.NewVoteToSkipCurrentTrackSignalArgs{
+		UserID: body.UserID,
+	})
+
+	if err := temporal.SignalWorkflow(
+		context.Background(),
+		body.WorkflowID,
+		shared.NoWorkflowRunID,
+		shared_mtv.SignalChannelName,
+		voteToSkipCurrentTrackSignal,
+	); err != nil {
+		WriteError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	res := make(map[string]interface{})
+	res["ok"] = 1
+	json.NewEncoder(w).Encode(res)
+}
+
 type ChangeUserEmittingDeviceRequestBody struct {
 	WorkflowID string `json:"workflowID" validate:"required,uuid"`
-	RunID      string `json:"runID" validate:"required,uuid"`
+	RunID      string `json:"runID" validate:"omitempty,uuid"`
 	UserID     string `json:"userID" validate:"required,uuid"`
 	DeviceID   string `json:"deviceID" validate:"required,uuid"`
 }
@@ -228,7 +579,7 @@ func ChangeUserEmittingDeviceHandler(w http.ResponseWriter, r *http.Request) {
 	if err := temporal.SignalWorkflow(
 		context.Background(),
 		body.WorkflowID,
-		body.RunID,
+		shared.NoWorkflowRunID,
 		shared_mtv.SignalChannelName,
 		changeUserEmittingDeviceSignal,
 	); err != nil {
@@ -244,9 +595,11 @@ func ChangeUserEmittingDeviceHandler(w http.ResponseWriter, r *http.Request) {
 
 type SuggestTracksRequestBody struct {
 	WorkflowID string `json:"workflowID" validate:"required,uuid"`
-	RunID      string `json:"runID" validate:"required,uuid"`
+	RunID      string `json:"runID" validate:"omitempty,uuid"`
 
-	TracksToSuggest []string `json:"tracksToSuggest" validate:"required,dive,required"`
+	// Either tracksToSuggest or query, the top hit of the search of query is suggested
+	TracksToSuggest []string `json:"tracksToSuggest" validate:"required_without=Query,excluded_with=Query,dive,required"`
+	Query           string   `json:"query" validate:"max=200"`
 	UserID          string   `json:"userID" validate:"required,uuid"`
 	DeviceID        string   `json:"deviceID" validate:"required,uuid"`
 }
@@ -267,13 +620,14 @@ func SuggestTracksHandler(w http.ResponseWriter, r *http.Request) {
 
 	suggestTracksSignal := shared_mtv.NewSuggestTracksSignal(shared_mtv.SuggestTracksSignalArgs{
 		TracksToSuggest: body.TracksToSuggest,
+		Query:           body.Query,
 		UserID:          body.UserID,
 		DeviceID:        body.DeviceID,
 	})
 	if err := temporal.SignalWorkflow(
 		context.Background(),
 		body.WorkflowID,
-		body.RunID,
+		shared.NoWorkflowRunID,
 		shared_mtv.SignalChannelName,
 		suggestTracksSignal,
 	); err != nil {
@@ -289,7 +643,7 @@ func SuggestTracksHandler(w http.ResponseWriter, r *http.Request) {
 
 type TerminateWorkflowRequestBody struct {
 	WorkflowID string `json:"workflowID" validate:"required,uuid"`
-	RunID      string `json:"runID" validate:"required,uuid"`
+	RunID      string `json:"runID" validate:"omitempty,uuid"`
 }
 
 func TerminateWorkflowHandler(w http.ResponseWriter, r *http.Request) {
@@ -310,7 +664,7 @@ func TerminateWorkflowHandler(w http.ResponseWriter, r *http.Request) {
 	if err := temporal.SignalWorkflow(
 		context.Background(),
 		body.WorkflowID,
-		body.RunID,
+		shared.NoWorkflowRunID,
 		shared_mtv.SignalChannelName,
 		terminateSignal,
 	); err != nil {
@@ -332,12 +686,21 @@ type CreateRoomRequestBody struct {
 	InitialTracksIDs              []string `json:"initialTracksIDs" validate:"required,dive,required"`
 	CreatorFitsPositionConstraint *bool    `json:"creatorFitsPositionConstraint"`
 
-	MinimumScoreToBePlayed        int                                           `json:"minimumScoreToBePlayed" validate:"required"`
-	IsOpen                        bool                                          `json:"isOpen"`
-	IsOpenOnlyInvitedUsersCanVote bool                                          `json:"isOpenOnlyInvitedUsersCanVote"`
-	HasPhysicalAndTimeConstraints bool                                          `json:"hasPhysicalAndTimeConstraints"`
-	PhysicalAndTimeConstraints    *shared_mtv.MtvRoomPhysicalAndTimeConstraints `json:"physicalAndTimeConstraints" validate:"required_if=HasPhysicalAndTimeConstraints true"`
-	PlayingMode                   shared_mtv.MtvPlayingModes                    `json:"playingMode" validate:"required"`
+	MinimumScoreToBePlayed             int                                           `json:"minimumScoreToBePlayed" validate:"required"`
+	IsOpen                             bool                                          `json:"isOpen"`
+	IsOpenOnlyInvitedUsersCanVote      bool                                          `json:"isOpenOnlyInvitedUsersCanVote"`
+	HasPhysicalAndTimeConstraints      bool                                          `json:"hasPhysicalAndTimeConstraints"`
+	PhysicalAndTimeConstraints         *shared_mtv.MtvRoomPhysicalAndTimeConstraints `json:"physicalAndTimeConstraints" validate:"required_if=HasPhysicalAndTimeConstraints true"`
+	PlayingMode                        shared_mtv.MtvPlayingModes                    `json:"playingMode" validate:"required"`
+	HasDownvotes                       bool                                          `json:"hasDownvotes"`
+	MinimumScoreToStayInQueue          int                                           `json:"minimumScoreToStayInQueue"`
+	SkipVotesRequiredShare             float64                                       `json:"skipVotesRequiredShare"`
+	MaximumTracksListLength            int                                           `json:"maximumTracksListLength" validate:"min=0"`
+	MaximumPendingSuggestionsPerUser   int                                           `json:"maximumPendingSuggestionsPerUser" validate:"min=0"`
+	MaximumSuggestionsPerUserPerMinute int                                           `json:"maximumSuggestionsPerUserPerMinute" validate:"min=0"`
+	PlayabilityPolicy                  shared.TrackPlayabilityPolicy                 `json:"playabilityPolicy"`
+	AutoDJ                             *shared_mtv.MtvRoomAutoDJOptions              `json:"autoDJ"`
+	IdlePolicy                         shared.RoomIdlePolicy                         `json:"idlePolicy"`
 }
 
 type CreateRoomResponse struct {
@@ -365,6 +728,12 @@ func CreateRoomHandler(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	if err := checkCreatorCanReadAutoDJSource(body.UserID, body.AutoDJ); err != nil {
+		log.Println("create room auto-DJ source error", err)
+		WriteError(w, err)
+		return
+	}
+
 	options := client.StartWorkflowOptions{
 		ID:        body.WorkflowID,
 		TaskQueue: shared_mtv.ControlTaskQueue,
@@ -391,13 +760,22 @@ func CreateRoomHandler(w http.ResponseWriter, r *http.Request) {
 		InitialTracksIDsList:          initialTracksIDsList,
 
 		MtvRoomCreationOptions: shared_mtv.MtvRoomCreationOptions{
-			RoomName:                      body.Name,
-			MinimumScoreToBePlayed:        body.MinimumScoreToBePlayed,
-			IsOpen:                        body.IsOpen,
-			IsOpenOnlyInvitedUsersCanVote: body.IsOpenOnlyInvitedUsersCanVote,
-			HasPhysicalAndTimeConstraints: body.HasPhysicalAndTimeConstraints,
-			PhysicalAndTimeConstraints:    nil,
-			PlayingMode:                   body.PlayingMode,
+			RoomName:                           body.Name,
+			MinimumScoreToBePlayed:             body.MinimumScoreToBePlayed,
+			IsOpen:                             body.IsOpen,
+			IsOpenOnlyInvitedUsersCanVote:      body.IsOpenOnlyInvitedUsersCanVote,
+			HasPhysicalAndTimeConstraints:      body.HasPhysicalAndTimeConstraints,
+			PhysicalAndTimeConstraints:         nil,
+			PlayingMode:                        body.PlayingMode,
+			HasDownvotes:                       body.HasDownvotes,
+			MinimumScoreToStayInQueue:          body.MinimumScoreToStayInQueue,
+			SkipVotesRequiredShare:             body.SkipVotesRequiredShare,
+			MaximumTracksListLength:            body.MaximumTracksListLength,
+			MaximumPendingSuggestionsPerUser:   body.MaximumPendingSuggestionsPerUser,
+			MaximumSuggestionsPerUserPerMinute: body.MaximumSuggestionsPerUserPerMinute,
+			PlayabilityPolicy:                  body.PlayabilityPolicy,
+			AutoDJ:                             body.AutoDJ,
+			IdlePolicy:                         body.IdlePolicy,
 		},
 	}
 
@@ -432,10 +810,33 @@ func CreateRoomHandler(w http.ResponseWriter, r *http.Request) {
 	json.NewEncoder(w).Encode(res)
 }
 
+// The auto-DJ reads the MPE playlist on behalf of the room creator,
+// who has to be a member of the MPE room when it is private.
+func checkCreatorCanReadAutoDJSource(creatorUserID string, autoDJ *shared_mtv.MtvRoomAutoDJOptions) error {
+	if autoDJ == nil || autoDJ.Source != shared_mtv.MtvRoomAutoDJSourceMpePlaylist {
+		return nil
+	}
+
+	mpeRoomState, err := PerformMpeGetStateQuery(PerformMpeGetStateQueryArgs{
+		WorkflowID: autoDJ.MpeRoomID,
+		RunID:      shared.NoWorkflowRunID,
+		UserID:     creatorUserID,
+	})
+	if err != nil {
+		return err
+	}
+
+	if !mpeRoomState.UserCanReadTracks() {
+		return activities.ErrMpeRoomAccessDenied
+	}
+
+	return nil
+}
+
 type LeaveRoomHandlerBody struct {
 	UserID     string `json:"userID" validate:"required,uuid"`
 	WorkflowID string `json:"workflowID" validate:"required,uuid"`
-	RunID      string `json:"runID" validate:"required,uuid"`
+	RunID      string `json:"runID" validate:"omitempty,uuid"`
 }
 
 func LeaveRoomHandler(w http.ResponseWriter, r *http.Request) {
@@ -461,7 +862,7 @@ func LeaveRoomHandler(w http.ResponseWriter, r *http.Request) {
 	if err := temporal.SignalWorkflow(
 		context.Background(),
 		body.WorkflowID,
-		body.RunID,
+		shared.NoWorkflowRunID,
 		shared_mtv.SignalChannelName,
 		signal,
 	); err != nil {
@@ -480,7 +881,7 @@ type JoinRoomHandlerBody struct {
 	UserID             string `json:"userID" validate:"required,uuid"`
 	DeviceID           string `json:"deviceID" validate:"required,uuid"`
 	WorkflowID         string `json:"workflowID" validate:"required,uuid"`
-	RunID              string `json:"runID" validate:"required,uuid"`
+	RunID              string `json:"runID" validate:"omitempty,uuid"`
 	UserHasBeenInvited bool   `json:"userHasBeenInvited"`
 }
 
@@ -507,7 +908,7 @@ func JoinRoomHandler(w http.ResponseWriter, r *http.Request) {
 	if err := temporal.SignalWorkflow(
 		context.Background(),
 		body.WorkflowID,
-		body.RunID,
+		shared.NoWorkflowRunID,
 		shared_mtv.SignalChannelName,
 		signal,
 	); err != nil {
@@ -525,7 +926,7 @@ func JoinRoomHandler(w http.ResponseWriter, r *http.Request) {
 type UpdateUserFitsPositionConstraintHandlerBody struct {
 	UserID                     string `json:"userID" validate:"required,uuid"`
 	WorkflowID                 string `json:"workflowID" validate:"required,uuid"`
-	RunID                      string `json:"runID" validate:"required,uuid"`
+	RunID                      string `json:"runID" validate:"omitempty,uuid"`
 	UserFitsPositionConstraint bool   `json:"userFitsPositionConstraint"`
 }
 
@@ -553,7 +954,7 @@ func UpdateUserFitsPositionConstraintHandler(w http.ResponseWriter, r *http.Requ
 	if err := temporal.SignalWorkflow(
 		context.Background(),
 		body.WorkflowID,
-		body.RunID,
+		shared.NoWorkflowRunID,
 		shared_mtv.SignalChannelName,
 		signal,
 	); err != nil {
@@ -572,7 +973,7 @@ func UpdateUserFitsPositionConstraintHandler(w http.ResponseWriter, r *http.Requ
 
 type UpdateDelegationOwnerHandlerBody struct {
 	WorkflowID               string `json:"workflowID" validate:"required,uuid"`
-	RunID                    string `json:"runID" validate:"required,uuid"`
+	RunID                    string `json:"runID" validate:"omitempty,uuid"`
 	NewDelegationOwnerUserID string `json:"newDelegationOwnerUserID" validate:"required,uuid"`
 	EmitterUserID            string `json:"emitterUserID" validate:"required,uuid"`
 }
@@ -601,7 +1002,7 @@ func UpdateDelegationOwnerHandler(w http.ResponseWriter, r *http.Request) {
 	if err := temporal.SignalWorkflow(
 		context.Background(),
 		body.WorkflowID,
-		body.RunID,
+		shared.NoWorkflowRunID,
 		shared_mtv.SignalChannelName,
 		signal,
 	); err != nil {
@@ -620,7 +1021,7 @@ func UpdateDelegationOwnerHandler(w http.ResponseWriter, r *http.Request) {
 
 type UpdateControlAndDelegationPermissionHandlerBody struct {
 	WorkflowID                        string `json:"workflowID" validate:"required,uuid"`
-	RunID                             string `json:"runID" validate:"required,uuid"`
+	RunID                             string `json:"runID" validate:"omitempty,uuid"`
 	ToUpdateUserID                    string `json:"toUpdateUserID" validate:"required,uuid"`
 	HasControlAndDelegationPermission bool   `json:"hasControlAndDelegationPermission"`
 }
@@ -649,7 +1050,7 @@ func UpdateControlAndDelegationPermissionHandler(w http.ResponseWriter, r *http.
 	if err := temporal.SignalWorkflow(
 		context.Background(),
 		body.WorkflowID,
-		body.RunID,
+		shared.NoWorkflowRunID,
 		shared_mtv.SignalChannelName,
 		signal,
 	); err != nil {
@@ -672,7 +1073,7 @@ type PerformMtvGetStateQueryArgs struct {
 }
 
 func PerformMtvGetStateQuery(params PerformMtvGetStateQueryArgs) (shared_mtv.MtvRoomExposedState, error) {
-	response, err := temporal.QueryWorkflow(context.Background(), params.WorkflowID, params.RunID, shared_mtv.MtvGetStateQuery, params.UserID)
+	response, err := temporal.QueryWorkflow(context.Background(), params.WorkflowID, shared.NoWorkflowRunID, shared_mtv.MtvGetStateQuery, params.UserID)
 	if err != nil {
 		return shared_mtv.MtvRoomExposedState{}, err
 	}
@@ -687,7 +1088,7 @@ func PerformMtvGetStateQuery(params PerformMtvGetStateQueryArgs) (shared_mtv.Mtv
 type GetStateBody struct {
 	WorkflowID string `json:"workflowID" validate:"required,uuid"`
 	UserID     string `json:"userID,omitempty" validate:"required,uuid"`
-	RunID      string `json:"runID" validate:"required,uuid"`
+	RunID      string `json:"runID" validate:"omitempty,uuid"`
 }
 
 func GetStateHandler(w http.ResponseWriter, r *http.Request) {
@@ -723,7 +1124,7 @@ func GetStateHandler(w http.ResponseWriter, r *http.Request) {
 
 type GetRoomConstraintsDetailsBody struct {
 	WorkflowID string `json:"workflowID" validate:"required,uuid"`
-	RunID      string `json:"runID" validate:"required,uuid"`
+	RunID      string `json:"runID" validate:"omitempty,uuid"`
 }
 
 func GetRoomConstraintsDetailsHandler(w http.ResponseWriter, r *http.Request) {
@@ -740,7 +1141,7 @@ func GetRoomConstraintsDetailsHandler(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	response, err := temporal.QueryWorkflow(context.Background(), body.WorkflowID, body.RunID, shared_mtv.MtvGetRoomConstraintsDetails)
+	response, err := temporal.QueryWorkflow(context.Background(), body.WorkflowID, shared.NoWorkflowRunID, shared_mtv.MtvGetRoomConstraintsDetails)
 	if err != nil {
 		WriteError(w, err)
 		return
@@ -757,7 +1158,7 @@ func GetRoomConstraintsDetailsHandler(w http.ResponseWriter, r *http.Request) {
 
 type GetUsersListBody struct {
 	WorkflowID string `json:"workflowID" validate:"required,uuid"`
-	RunID      string `json:"runID" validate:"required,uuid"`
+	RunID      string `json:"runID" validate:"omitempty,uuid"`
 }
 
 func GetUsersListHandler(w http.ResponseWriter, r *http.Request) {
@@ -774,7 +1175,7 @@ func GetUsersListHandler(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	response, err := temporal.QueryWorkflow(context.Background(), body.WorkflowID, body.RunID, shared_mtv.MtvGetUsersListQuery)
+	response, err := temporal.QueryWorkflow(context.Background(), body.WorkflowID, shared.NoWorkflowRunID, shared_mtv.MtvGetUsersListQuery)
 	if err != nil {
 		WriteError(w, err)
 		return
@@ -788,3 +1189,37 @@ func GetUsersListHandler(w http.ResponseWriter, r *http.Request) {
 	w.WriteHeader(http.StatusOK)
 	json.NewEncoder(w).Encode(res)
 }
+
+type GetPlayedTracksBody struct {
+	WorkflowID string `json:"workflowID" validate:"required,uuid"`
+	Page       int    `json:"page" validate:"required,min=1"`
+}
+
+func GetPlayedTracksHandler(w http.ResponseWriter, r *http.Request) {
+	defer r.Body.Close()
+
+	var body GetPlayedTracksBody
+
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		WriteError(w, err)
+		return
+	}
+	if err := validate.Struct(body); err != nil {
+		WriteError(w, err)
+		return
+	}
+
+	response, err := temporal.QueryWorkflow(context.Background(), body.WorkflowID, shared.NoWorkflowRunID, shared_mtv.MtvGetPlayedTracksQuery, body.Page)
+	if err != nil {
+		WriteError(w, err)
+		return
+	}
+	var res shared_mtv.MtvRoomPlayedTracksPage
+	if err := response.Get(&res); err != nil {
+		WriteError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(res)
+}
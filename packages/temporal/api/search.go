@@ -0,0 +1,79 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+
+	shared_mtv "github.com/AdonisEnProvence/MusicRoom/mtv/shared"
+	"github.com/AdonisEnProvence/MusicRoom/search"
+	"github.com/AdonisEnProvence/MusicRoom/shared"
+	"go.temporal.io/sdk/client"
+)
+
+type SearchTracksRequestBody struct {
+	Query string `json:"query" validate:"required,max=200"`
+	// Defaults to 10
+	Limit int `json:"limit" validate:"min=0,max=50"`
+	// The search does not know for which room it runs, callers must pass the
+	// playability policy of the room the tracks are searched for, as given at its creation.
+	// Otherwise tracks the room rejects once suggested or added are returned.
+	PlayabilityPolicy shared.TrackPlayabilityPolicy `json:"playabilityPolicy"`
+}
+
+type SearchTracksResponse struct {
+	Tracks []shared.TrackMetadata `json:"tracks"`
+}
+
+// SearchTracksHandler responds once the search is done, the same search is used
+// by mtv and mpe rooms. The given playability policy is trusted as is.
+func SearchTracksHandler(w http.ResponseWriter, r *http.Request) {
+	defer r.Body.Close()
+
+	var body SearchTracksRequestBody
+
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		WriteError(w, err)
+		return
+	}
+	if err := validate.Struct(body); err != nil {
+		WriteError(w, err)
+		return
+	}
+
+	tracks, err := PerformSearchTracks(search.SearchTracksWorkflowParams{
+		Query:             body.Query,
+		Limit:             body.Limit,
+		PlayabilityPolicy: body.PlayabilityPolicy,
+	})
+	if err != nil {
+		WriteError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(SearchTracksResponse{
+		Tracks: tracks,
+	})
+}
+
+func PerformSearchTracks(params search.SearchTracksWorkflowParams) ([]shared.TrackMetadata, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), search.WorkflowExecutionTimeout)
+	defer cancel()
+
+	options := client.StartWorkflowOptions{
+		TaskQueue:                shared_mtv.ControlTaskQueue,
+		WorkflowExecutionTimeout: search.WorkflowExecutionTimeout,
+	}
+	we, err := temporal.ExecuteWorkflow(ctx, options, search.SearchTracksWorkflow, params)
+	if err != nil {
+		return nil, err
+	}
+
+	tracks := make([]shared.TrackMetadata, 0)
+	if err := we.Get(ctx, &tracks); err != nil {
+		return nil, err
+	}
+
+	return tracks, nil
+}
@@ -0,0 +1,218 @@
+package callback
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+)
+
+const (
+	DefaultTimeout = 10 * time.Second
+	// Responses bodies are read up to this size so that connections can be reused,
+	// bigger bodies are not worth keeping the connection for.
+	maxDrainedBodySize = 64 << 10
+	// Part of the response body kept in a StatusError
+	maxErrorBodySize = 512
+)
+
+// ErrInvalidBody is returned when the callback body can not be encoded, sending it again would not help.
+var ErrInvalidBody = errors.New("invalid callback body")
+
+// ErrInvalidConfiguration is returned by every callback of a client that could not be configured,
+// sending them again would fail the same way until the worker is restarted with a valid configuration.
+var ErrInvalidConfiguration = errors.New("invalid callback client configuration")
+
+type ClientOptions struct {
+	// Adonis endpoint, callbacks paths are appended to it
+	BaseURL string
+	// Sent as the Authorization header
+	AuthorizationKey string
+	// Timeout of each request, DefaultTimeout is used when not positive
+	Timeout time.Duration
+	// Callbacks are signed with this key when set, see Verifier
+	SigningKey *SigningKey
+	// Time at which callbacks are signed, defaults to time.Now
+	Now func() time.Time
+}
+
+// Client sends the callbacks of the activities to Adonis.
+// It is safe for concurrent use and reuses its connections.
+type Client struct {
+	baseURL          string
+	authorizationKey string
+	signingKey       *SigningKey
+	now              func() time.Time
+	httpClient       *http.Client
+	// Set when the client could not be configured, every callback fails with it
+	configurationErr error
+}
+
+func NewClient(options ClientOptions) *Client {
+	client := &Client{
+		baseURL:          strings.TrimSuffix(options.BaseURL, "/"),
+		authorizationKey: options.AuthorizationKey,
+		signingKey:       options.SigningKey,
+		now:              options.Now,
+		httpClient: &http.Client{
+			Timeout: options.Timeout,
+		},
+	}
+
+	if client.httpClient.Timeout <= 0 {
+		client.httpClient.Timeout = DefaultTimeout
+	}
+	if client.now == nil {
+		client.now = time.Now
+	}
+
+	return client
+}
+
+// NewClientFromEnv reads ADONIS_ENDPOINT, TEMPORAL_ADONIS_KEY, and optionally
+// ADONIS_CALLBACK_TIMEOUT as a duration like 10s and ADONIS_CALLBACK_SIGNING_KEYS
+// as accepted by ParseSigningKeys, whose first key signs the callbacks.
+//
+// An invalid or not positive ADONIS_CALLBACK_TIMEOUT is replaced by the default timeout.
+// Invalid signing keys make every callback fail instead of sending them unsigned, see ConfigurationErr.
+func NewClientFromEnv() *Client {
+	options := ClientOptions{
+		BaseURL:          os.Getenv("ADONIS_ENDPOINT"),
+		AuthorizationKey: os.Getenv("TEMPORAL_ADONIS_KEY"),
+	}
+
+	if timeout := os.Getenv("ADONIS_CALLBACK_TIMEOUT"); timeout != "" {
+		parsedTimeout, err := time.ParseDuration(timeout)
+		switch {
+		case err != nil:
+			log.Println("invalid ADONIS_CALLBACK_TIMEOUT, default timeout is used", err)
+		case parsedTimeout <= 0:
+			log.Println("ADONIS_CALLBACK_TIMEOUT must be positive, default timeout is used", parsedTimeout)
+		default:
+			options.Timeout = parsedTimeout
+		}
+	}
+
+	var signingKeysErr error
+	if signingKeys := os.Getenv("ADONIS_CALLBACK_SIGNING_KEYS"); signingKeys != "" {
+		keys, err := ParseSigningKeys(signingKeys)
+		if err != nil {
+			log.Println("invalid ADONIS_CALLBACK_SIGNING_KEYS, callbacks will fail", err)
+			signingKeysErr = fmt.Errorf("%w: invalid ADONIS_CALLBACK_SIGNING_KEYS: %v", ErrInvalidConfiguration, err)
+		} else {
+			options.SigningKey = &keys[0]
+		}
+	}
+
+	client := NewClient(options)
+	client.configurationErr = signingKeysErr
+
+	return client
+}
+
+// ConfigurationErr is the error every callback fails with when the client could not be configured.
+// The worker checks it at startup so that it does not run with callbacks that can not be delivered.
+func (c *Client) ConfigurationErr() error {
+	return c.configurationErr
+}
+
+// StatusError is returned when Adonis answers with a non 2xx status code.
+type StatusError struct {
+	Method     string
+	URL        string
+	StatusCode int
+	// Beginning of the response body
+	Body string
+}
+
+func (e *StatusError) Error() string {
+	return fmt.Sprintf("callback %s %s failed with status %d: %s", e.Method, e.URL, e.StatusCode, e.Body)
+}
+
+// Retryable is true for server errors and for the statuses asking to try again later.
+// Other client errors are due to the callback itself, sending it again would fail the same way.
+func (e *StatusError) Retryable() bool {
+	switch e.StatusCode {
+	case http.StatusRequestTimeout, http.StatusTooEarly, http.StatusTooManyRequests:
+		return true
+	}
+
+	return e.StatusCode >= http.StatusInternalServerError
+}
+
+// IsRetryable is false for the errors sending the callback again would not fix.
+// Network errors and timeouts are retryable.
+func IsRetryable(err error) bool {
+	if err == nil {
+		return false
+	}
+
+	if errors.Is(err, ErrInvalidBody) || errors.Is(err, ErrInvalidConfiguration) {
+		return false
+	}
+
+	var statusError *StatusError
+	if errors.As(err, &statusError) {
+		return statusError.Retryable()
+	}
+
+	return true
+}
+
+// Post sends body encoded as JSON to path and succeeds only when Adonis answers with a 2xx status code.
+// Each attempt is signed again, with its own timestamp and nonce.
+func (c *Client) Post(ctx context.Context, path string, body interface{}) error {
+	if c.configurationErr != nil {
+		return c.configurationErr
+	}
+
+	marshaledBody, err := json.Marshal(body)
+	if err != nil {
+		return fmt.Errorf("%w: %v", ErrInvalidBody, err)
+	}
+
+	url := c.baseURL + path
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(marshaledBody))
+	if err != nil {
+		return err
+	}
+
+	req.Header.Set("Authorization", c.authorizationKey)
+	req.Header.Set("Content-Type", "application/json")
+	if c.signingKey != nil {
+		if err := signRequest(req, *c.signingKey, c.now(), marshaledBody); err != nil {
+			return err
+		}
+	}
+
+	res, err := c.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer drainAndClose(res.Body)
+
+	if res.StatusCode >= 200 && res.StatusCode < 300 {
+		return nil
+	}
+
+	errorBody, _ := io.ReadAll(io.LimitReader(res.Body, maxErrorBodySize))
+
+	return &StatusError{
+		Method:     req.Method,
+		URL:        url,
+		StatusCode: res.StatusCode,
+		Body:       string(errorBody),
+	}
+}
+
+func drainAndClose(body io.ReadCloser) {
+	io.Copy(io.Discard, io.LimitReader(body, maxDrainedBodySize))
+	body.Close()
+}
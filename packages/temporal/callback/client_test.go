@@ -0,0 +1,172 @@
+package callback
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type UnitTestSuite struct {
+	suite.Suite
+}
+
+type callbackBody struct {
+	RoomID string `json:"roomID"`
+}
+
+func (s *UnitTestSuite) Test_ClientPostsJSONBody() {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		s.Equal(http.MethodPost, r.Method)
+		s.Equal("/temporal/mtv/play", r.URL.Path)
+		s.Equal("adonis-key", r.Header.Get("Authorization"))
+		s.Equal("application/json", r.Header.Get("Content-Type"))
+
+		var body callbackBody
+		s.NoError(json.NewDecoder(r.Body).Decode(&body))
+		s.Equal("room-id", body.RoomID)
+
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	client := NewClient(ClientOptions{
+		BaseURL:          server.URL + "/",
+		AuthorizationKey: "adonis-key",
+	})
+
+	err := client.Post(context.Background(), "/temporal/mtv/play", callbackBody{RoomID: "room-id"})
+	s.NoError(err)
+}
+
+func (s *UnitTestSuite) Test_ClientReturnsStatusErrors() {
+	var statusCode int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(int(atomic.LoadInt32(&statusCode)))
+		w.Write([]byte(`{"errors":[{"message":"E_ROW_NOT_FOUND"}]}`))
+	}))
+	defer server.Close()
+
+	client := NewClient(ClientOptions{
+		BaseURL: server.URL,
+	})
+
+	testCases := []struct {
+		StatusCode int
+		Retryable  bool
+	}{
+		{StatusCode: http.StatusBadRequest, Retryable: false},
+		{StatusCode: http.StatusUnauthorized, Retryable: false},
+		{StatusCode: http.StatusNotFound, Retryable: false},
+		{StatusCode: http.StatusRequestTimeout, Retryable: true},
+		{StatusCode: http.StatusTooManyRequests, Retryable: true},
+		{StatusCode: http.StatusInternalServerError, Retryable: true},
+		{StatusCode: http.StatusBadGateway, Retryable: true},
+	}
+
+	for _, testCase := range testCases {
+		atomic.StoreInt32(&statusCode, int32(testCase.StatusCode))
+
+		err := client.Post(context.Background(), "/temporal/mpe/acknowledge-join", callbackBody{})
+
+		var statusError *StatusError
+		s.True(errors.As(err, &statusError))
+		s.Equal(testCase.StatusCode, statusError.StatusCode)
+		s.Equal(server.URL+"/temporal/mpe/acknowledge-join", statusError.URL)
+		s.Contains(statusError.Body, "E_ROW_NOT_FOUND")
+		s.Equal(testCase.Retryable, IsRetryable(err), "status %d", testCase.StatusCode)
+	}
+}
+
+func (s *UnitTestSuite) Test_ClientTimesOut() {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		time.Sleep(100 * time.Millisecond)
+	}))
+	defer server.Close()
+
+	client := NewClient(ClientOptions{
+		BaseURL: server.URL,
+		Timeout: 10 * time.Millisecond,
+	})
+
+	err := client.Post(context.Background(), "/temporal/mtv/pause", callbackBody{})
+	s.Error(err)
+	s.True(IsRetryable(err))
+}
+
+func (s *UnitTestSuite) Test_ClientRejectsInvalidBody() {
+	client := NewClient(ClientOptions{
+		BaseURL: "http://localhost",
+	})
+
+	err := client.Post(context.Background(), "/temporal/mtv/pause", make(chan int))
+	s.ErrorIs(err, ErrInvalidBody)
+	s.False(IsRetryable(err))
+}
+
+func (s *UnitTestSuite) Test_ClientFromEnvWithInvalidSigningKeysFailsWithoutRetry() {
+	os.Setenv("ADONIS_CALLBACK_SIGNING_KEYS", "invalid")
+	defer os.Unsetenv("ADONIS_CALLBACK_SIGNING_KEYS")
+
+	client := NewClientFromEnv()
+	s.ErrorIs(client.ConfigurationErr(), ErrInvalidConfiguration)
+
+	err := client.Post(context.Background(), "/temporal/mtv/pause", callbackBody{})
+	s.ErrorIs(err, ErrInvalidConfiguration)
+	s.False(IsRetryable(err))
+}
+
+func (s *UnitTestSuite) Test_ClientFromEnvUsesDefaultTimeoutWhenNotPositive() {
+	defer os.Unsetenv("ADONIS_CALLBACK_TIMEOUT")
+
+	for _, timeout := range []string{"0s", "-5s", "invalid"} {
+		os.Setenv("ADONIS_CALLBACK_TIMEOUT", timeout)
+
+		client := NewClientFromEnv()
+		s.NoError(client.ConfigurationErr())
+		s.Equal(DefaultTimeout, client.httpClient.Timeout, "timeout %s", timeout)
+	}
+
+	os.Setenv("ADONIS_CALLBACK_TIMEOUT", "3s")
+	s.Equal(3*time.Second, NewClientFromEnv().httpClient.Timeout)
+}
+
+func (s *UnitTestSuite) Test_ClientReusesConnections() {
+	var newConnectionsCount int32
+	server := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Write([]byte(strings.Repeat("error ", 1000)))
+	}))
+	server.Config.ConnState = func(conn net.Conn, state http.ConnState) {
+		if state == http.StateNew {
+			atomic.AddInt32(&newConnectionsCount, 1)
+		}
+	}
+	server.Start()
+	defer server.Close()
+
+	client := NewClient(ClientOptions{
+		BaseURL: server.URL,
+	})
+
+	for i := 0; i < 3; i++ {
+		err := client.Post(context.Background(), "/temporal/mtv/play", callbackBody{})
+		s.Error(err)
+	}
+
+	// Response bodies are drained, the connection is not dropped after each callback
+	s.Equal(int32(1), atomic.LoadInt32(&newConnectionsCount))
+}
+
+func TestUnitTestSuite(t *testing.T) {
+	suite.Run(t, new(UnitTestSuite))
+}
@@ -0,0 +1,242 @@
+package callback
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Headers set on signed callbacks.
+const (
+	KeyIDHeader     = "X-MusicRoom-Key-ID"
+	TimestampHeader = "X-MusicRoom-Timestamp"
+	NonceHeader     = "X-MusicRoom-Nonce"
+	SignatureHeader = "X-MusicRoom-Signature"
+)
+
+const (
+	// Prefixes the signature so that the scheme can evolve without breaking verifiers.
+	signatureVersion = "v1="
+	nonceSize        = 16
+	// Timestamps further than this from the time of the verification are rejected.
+	DefaultReplayWindow = 5 * time.Minute
+)
+
+var (
+	ErrMissingSignature     = errors.New("callback signature headers are missing")
+	ErrUnknownKeyID         = errors.New("callback signed with an unknown key")
+	ErrTimestampOutOfWindow = errors.New("callback timestamp is out of the replay window")
+	ErrInvalidSignature     = errors.New("callback signature is invalid")
+	ErrReplayedNonce        = errors.New("callback nonce has already been used")
+)
+
+type SigningKey struct {
+	ID     string
+	Secret []byte
+}
+
+// ParseSigningKeys parses comma separated id:secret pairs, as in "2021-10:secret,2021-07:old-secret".
+// The first key signs the callbacks, the following ones are only accepted by verifiers,
+// which lets the secret be rotated without rejecting callbacks in flight.
+func ParseSigningKeys(value string) ([]SigningKey, error) {
+	keys := make([]SigningKey, 0)
+	keysIDs := make(map[string]bool)
+
+	for _, pair := range strings.Split(value, ",") {
+		pair = strings.TrimSpace(pair)
+		if pair == "" {
+			continue
+		}
+
+		separatorIndex := strings.Index(pair, ":")
+		if separatorIndex <= 0 || separatorIndex == len(pair)-1 {
+			return nil, fmt.Errorf("invalid signing key %q, expected id:secret", pair)
+		}
+
+		id := pair[:separatorIndex]
+		if keysIDs[id] {
+			return nil, fmt.Errorf("duplicated signing key id %q", id)
+		}
+		keysIDs[id] = true
+
+		keys = append(keys, SigningKey{
+			ID:     id,
+			Secret: []byte(pair[separatorIndex+1:]),
+		})
+	}
+
+	if len(keys) == 0 {
+		return nil, errors.New("no signing key given")
+	}
+
+	return keys, nil
+}
+
+// Sign computes the signature of a callback, it covers the timestamp, the nonce and the body.
+func Sign(secret []byte, timestamp string, nonce string, body []byte) string {
+	mac := hmac.New(sha256.New, secret)
+	mac.Write([]byte(timestamp))
+	mac.Write([]byte("."))
+	mac.Write([]byte(nonce))
+	mac.Write([]byte("."))
+	mac.Write(body)
+
+	return signatureVersion + hex.EncodeToString(mac.Sum(nil))
+}
+
+func signRequest(req *http.Request, key SigningKey, now time.Time, body []byte) error {
+	nonce, err := generateNonce()
+	if err != nil {
+		return err
+	}
+
+	timestamp := strconv.FormatInt(now.Unix(), 10)
+
+	req.Header.Set(KeyIDHeader, key.ID)
+	req.Header.Set(TimestampHeader, timestamp)
+	req.Header.Set(NonceHeader, nonce)
+	req.Header.Set(SignatureHeader, Sign(key.Secret, timestamp, nonce, body))
+
+	return nil
+}
+
+func generateNonce() (string, error) {
+	nonce := make([]byte, nonceSize)
+	if _, err := rand.Read(nonce); err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(nonce), nil
+}
+
+type VerifierOptions struct {
+	Keys []SigningKey
+	// DefaultReplayWindow is used when zero
+	ReplayWindow time.Duration
+	// Defaults to time.Now
+	Now func() time.Time
+}
+
+// Verifier checks the signature of the callbacks and rejects the ones that are replayed.
+// Nonces are remembered in memory for the duration of the replay window,
+// a verifier is meant to be shared by every request a receiver handles.
+type Verifier struct {
+	keys         map[string][]byte
+	replayWindow time.Duration
+	now          func() time.Time
+
+	mu sync.Mutex
+	// Maps the seen nonces to the time after which their callback is rejected anyway
+	seenNonces map[string]time.Time
+}
+
+func NewVerifier(options VerifierOptions) *Verifier {
+	verifier := &Verifier{
+		keys:         make(map[string][]byte, len(options.Keys)),
+		replayWindow: options.ReplayWindow,
+		now:          options.Now,
+		seenNonces:   make(map[string]time.Time),
+	}
+
+	for _, key := range options.Keys {
+		verifier.keys[key.ID] = key.Secret
+	}
+	if verifier.replayWindow == 0 {
+		verifier.replayWindow = DefaultReplayWindow
+	}
+	if verifier.now == nil {
+		verifier.now = time.Now
+	}
+
+	return verifier
+}
+
+// Verify returns nil when header holds a valid signature of body, made with one of the keys
+// of the verifier within the replay window, and whose nonce has not been seen yet.
+func (v *Verifier) Verify(header http.Header, body []byte) error {
+	keyID := header.Get(KeyIDHeader)
+	timestamp := header.Get(TimestampHeader)
+	nonce := header.Get(NonceHeader)
+	signature := header.Get(SignatureHeader)
+	if keyID == "" || timestamp == "" || nonce == "" || signature == "" {
+		return ErrMissingSignature
+	}
+
+	secret, exists := v.keys[keyID]
+	if !exists {
+		return ErrUnknownKeyID
+	}
+
+	unixTimestamp, err := strconv.ParseInt(timestamp, 10, 64)
+	if err != nil {
+		return ErrTimestampOutOfWindow
+	}
+	signedAt := time.Unix(unixTimestamp, 0)
+	now := v.now()
+	if now.Sub(signedAt) > v.replayWindow || signedAt.Sub(now) > v.replayWindow {
+		return ErrTimestampOutOfWindow
+	}
+
+	expectedSignature := Sign(secret, timestamp, nonce, body)
+	if !hmac.Equal([]byte(signature), []byte(expectedSignature)) {
+		return ErrInvalidSignature
+	}
+
+	return v.rememberNonce(nonce, signedAt.Add(v.replayWindow), now)
+}
+
+func (v *Verifier) rememberNonce(nonce string, expiresAt time.Time, now time.Time) error {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+
+	for seenNonce, seenNonceExpiresAt := range v.seenNonces {
+		if now.After(seenNonceExpiresAt) {
+			delete(v.seenNonces, seenNonce)
+		}
+	}
+
+	if _, seen := v.seenNonces[nonce]; seen {
+		return ErrReplayedNonce
+	}
+	v.seenNonces[nonce] = expiresAt
+
+	return nil
+}
+
+// VerifyRequest reads and verifies the body of r, which can be read again afterwards.
+func (v *Verifier) VerifyRequest(r *http.Request) ([]byte, error) {
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		return nil, err
+	}
+	r.Body.Close()
+	r.Body = io.NopCloser(bytes.NewReader(body))
+
+	if err := v.Verify(r.Header, body); err != nil {
+		return nil, err
+	}
+
+	return body, nil
+}
+
+// Middleware answers 401 to the requests whose signature is not valid.
+func (v *Verifier) Middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if _, err := v.VerifyRequest(r); err != nil {
+			http.Error(w, err.Error(), http.StatusUnauthorized)
+			return
+		}
+
+		next.ServeHTTP(w, r)
+	})
+}
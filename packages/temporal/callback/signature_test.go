@@ -0,0 +1,161 @@
+package callback
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"time"
+)
+
+func (s *UnitTestSuite) newSignedRequest(key SigningKey, signedAt time.Time, body string) *http.Request {
+	req := httptest.NewRequest(http.MethodPost, "/temporal/mtv/play", nil)
+	s.NoError(signRequest(req, key, signedAt, []byte(body)))
+
+	return req
+}
+
+func (s *UnitTestSuite) Test_ParseSigningKeys() {
+	keys, err := ParseSigningKeys(" 2021-10:new-secret , 2021-07:old:secret")
+	s.NoError(err)
+	s.Equal([]SigningKey{
+		{ID: "2021-10", Secret: []byte("new-secret")},
+		{ID: "2021-07", Secret: []byte("old:secret")},
+	}, keys)
+
+	invalidValues := []string{
+		"",
+		"secret",
+		":secret",
+		"2021-10:",
+		"2021-10:secret,2021-10:other-secret",
+	}
+	for _, value := range invalidValues {
+		_, err := ParseSigningKeys(value)
+		s.Error(err, value)
+	}
+}
+
+func (s *UnitTestSuite) Test_VerifierAcceptsSignedCallbacksOnlyOnce() {
+	now := time.Now()
+	key := SigningKey{ID: "2021-10", Secret: []byte("secret")}
+	verifier := NewVerifier(VerifierOptions{
+		Keys: []SigningKey{key},
+		Now:  func() time.Time { return now },
+	})
+
+	req := s.newSignedRequest(key, now, `{"roomID":"room-id"}`)
+	s.NoError(verifier.Verify(req.Header, []byte(`{"roomID":"room-id"}`)))
+	s.ErrorIs(verifier.Verify(req.Header, []byte(`{"roomID":"room-id"}`)), ErrReplayedNonce)
+
+	otherReq := s.newSignedRequest(key, now, `{"roomID":"room-id"}`)
+	s.NotEqual(req.Header.Get(NonceHeader), otherReq.Header.Get(NonceHeader))
+	s.NoError(verifier.Verify(otherReq.Header, []byte(`{"roomID":"room-id"}`)))
+}
+
+func (s *UnitTestSuite) Test_VerifierRejectsInvalidCallbacks() {
+	now := time.Now()
+	key := SigningKey{ID: "2021-10", Secret: []byte("secret")}
+	verifier := NewVerifier(VerifierOptions{
+		Keys:         []SigningKey{key},
+		ReplayWindow: time.Minute,
+		Now:          func() time.Time { return now },
+	})
+	body := []byte(`{"roomID":"room-id"}`)
+
+	s.ErrorIs(verifier.Verify(http.Header{}, body), ErrMissingSignature)
+
+	tamperedBodyReq := s.newSignedRequest(key, now, `{"roomID":"other-room-id"}`)
+	s.ErrorIs(verifier.Verify(tamperedBodyReq.Header, body), ErrInvalidSignature)
+
+	wrongSecretReq := s.newSignedRequest(SigningKey{ID: key.ID, Secret: []byte("wrong-secret")}, now, string(body))
+	s.ErrorIs(verifier.Verify(wrongSecretReq.Header, body), ErrInvalidSignature)
+
+	tamperedTimestampReq := s.newSignedRequest(key, now, string(body))
+	tamperedTimestampReq.Header.Set(TimestampHeader, "1")
+	s.ErrorIs(verifier.Verify(tamperedTimestampReq.Header, body), ErrTimestampOutOfWindow)
+
+	unknownKeyReq := s.newSignedRequest(SigningKey{ID: "2021-07", Secret: key.Secret}, now, string(body))
+	s.ErrorIs(verifier.Verify(unknownKeyReq.Header, body), ErrUnknownKeyID)
+
+	expiredReq := s.newSignedRequest(key, now.Add(-2*time.Minute), string(body))
+	s.ErrorIs(verifier.Verify(expiredReq.Header, body), ErrTimestampOutOfWindow)
+
+	futureReq := s.newSignedRequest(key, now.Add(2*time.Minute), string(body))
+	s.ErrorIs(verifier.Verify(futureReq.Header, body), ErrTimestampOutOfWindow)
+}
+
+func (s *UnitTestSuite) Test_VerifierForgetsNoncesOnceTheirCallbackHasExpired() {
+	now := time.Now()
+	key := SigningKey{ID: "2021-10", Secret: []byte("secret")}
+	verifier := NewVerifier(VerifierOptions{
+		Keys:         []SigningKey{key},
+		ReplayWindow: time.Minute,
+		Now:          func() time.Time { return now },
+	})
+	body := []byte(`{}`)
+
+	s.NoError(verifier.Verify(s.newSignedRequest(key, now, string(body)).Header, body))
+	s.Len(verifier.seenNonces, 1)
+
+	now = now.Add(2 * time.Minute)
+	s.NoError(verifier.Verify(s.newSignedRequest(key, now, string(body)).Header, body))
+	s.Len(verifier.seenNonces, 1)
+}
+
+func (s *UnitTestSuite) Test_ClientSignsCallbacksDuringKeysRotation() {
+	oldKey := SigningKey{ID: "2021-07", Secret: []byte("old-secret")}
+	newKey := SigningKey{ID: "2021-10", Secret: []byte("new-secret")}
+	verifier := NewVerifier(VerifierOptions{
+		Keys: []SigningKey{newKey, oldKey},
+	})
+
+	var receivedKeysIDs []string
+	server := httptest.NewServer(verifier.Middleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		receivedKeysIDs = append(receivedKeysIDs, r.Header.Get(KeyIDHeader))
+		s.Equal("adonis-key", r.Header.Get("Authorization"))
+
+		w.WriteHeader(http.StatusNoContent)
+	})))
+	defer server.Close()
+
+	for _, key := range []SigningKey{oldKey, newKey} {
+		key := key
+		client := NewClient(ClientOptions{
+			BaseURL:          server.URL,
+			AuthorizationKey: "adonis-key",
+			SigningKey:       &key,
+		})
+
+		s.NoError(client.Post(context.Background(), "/temporal/mtv/play", callbackBody{RoomID: "room-id"}))
+	}
+	s.Equal([]string{oldKey.ID, newKey.ID}, receivedKeysIDs)
+
+	retiredKeyClient := NewClient(ClientOptions{
+		BaseURL:    server.URL,
+		SigningKey: &SigningKey{ID: "2021-01", Secret: []byte("retired-secret")},
+	})
+	err := retiredKeyClient.Post(context.Background(), "/temporal/mtv/play", callbackBody{RoomID: "room-id"})
+	s.Error(err)
+	s.False(IsRetryable(err))
+
+	unsignedClient := NewClient(ClientOptions{
+		BaseURL: server.URL,
+	})
+	err = unsignedClient.Post(context.Background(), "/temporal/mtv/play", callbackBody{RoomID: "room-id"})
+	s.Error(err)
+	s.False(IsRetryable(err))
+}
+
+func (s *UnitTestSuite) Test_ClientFromEnvFailsWithInvalidSigningKeys() {
+	os.Setenv("ADONIS_ENDPOINT", "http://localhost")
+	os.Setenv("ADONIS_CALLBACK_SIGNING_KEYS", "missing-secret")
+	defer os.Unsetenv("ADONIS_ENDPOINT")
+	defer os.Unsetenv("ADONIS_CALLBACK_SIGNING_KEYS")
+
+	client := NewClientFromEnv()
+
+	err := client.Post(context.Background(), "/temporal/mtv/play", callbackBody{})
+	s.Error(err)
+	s.Contains(err.Error(), "ADONIS_CALLBACK_SIGNING_KEYS")
+}
@@ -0,0 +1,37 @@
+package main
+
+import (
+	"flag"
+	"log"
+	"net/http"
+
+	"github.com/AdonisEnProvence/MusicRoom/youtube/youtubetest"
+)
+
+// Serves the YouTube videos.list and search.list endpoints from fixtures, the worker uses it
+// when YOUTUBE_API_BASE_URL is set to its address, as http://localhost:4000.
+func main() {
+	var (
+		addr          = flag.String("addr", "localhost:4000", "address to listen on")
+		fixturesDir   = flag.String("fixtures", "youtube/youtubetest/fixtures", "directory of <video ID>.json fixtures")
+		apiKey        = flag.String("api-key", "", "reject requests made with another API key")
+		quotaLimit    = flag.Int("quota-limit", 0, "number of requests answered before responding quotaExceeded, unlimited when zero")
+		quotaExceeded = flag.Bool("quota-exceeded", false, "respond quotaExceeded to every request")
+		latency       = flag.Duration("latency", 0, "delay before responding to each request, as 2s")
+	)
+	flag.Parse()
+
+	server, err := youtubetest.NewServer(youtubetest.Options{
+		FixturesDir: *fixturesDir,
+		APIKey:      *apiKey,
+		QuotaLimit:  *quotaLimit,
+		Latency:     *latency,
+	})
+	if err != nil {
+		log.Fatalln("unable to load YouTube fixtures", err)
+	}
+	server.SetQuotaExceeded(*quotaExceeded)
+
+	log.Printf("fake YouTube API listening on http://%s", *addr)
+	log.Fatalln(http.ListenAndServe(*addr, server))
+}
@@ -1,96 +1,47 @@
 package activities_mpe
 
 import (
-	"bytes"
 	"context"
-	"encoding/json"
-	"net/http"
-	"os"
 
+	"github.com/AdonisEnProvence/MusicRoom/activities"
 	shared_mpe "github.com/AdonisEnProvence/MusicRoom/mpe/shared"
 	shared_mtv "github.com/AdonisEnProvence/MusicRoom/mtv/shared"
-)
-
-var (
-	ADONIS_ENDPOINT     = os.Getenv("ADONIS_ENDPOINT")
-	ADONIS_MPE_ENDPOINT = ADONIS_ENDPOINT + "/temporal/mpe"
+	"github.com/AdonisEnProvence/MusicRoom/shared"
 )
 
 type RejectAddingTracksActivityArgs struct {
 	RoomID   string `json:"roomID"`
 	UserID   string `json:"userID"`
 	DeviceID string `json:"deviceID"`
+	// Tracks that could not be resolved, when none of the tracks could be added because of them
+	RejectedTracks []shared.RejectedTrack `json:"rejectedTracks,omitempty"`
 }
 
 type AcknowledgeAddingTracksActivityArgs struct {
 	State    shared_mpe.MpeRoomExposedState `json:"state"`
 	UserID   string                         `json:"userID"`
 	DeviceID string                         `json:"deviceID"`
+	// Added tracks that could not be resolved
+	RejectedTracks []shared.RejectedTrack `json:"rejectedTracks,omitempty"`
 }
 
-func (a *Activities) MpeCreationAcknowledgementActivity(_ context.Context, state shared_mpe.MpeRoomExposedState) error {
-	marshaledBody, err := json.Marshal(state)
-	if err != nil {
-		return err
-	}
-
-	url := ADONIS_MPE_ENDPOINT + "/mpe-creation-acknowledgement"
-
-	req, err := http.NewRequest(http.MethodPost, url, bytes.NewBuffer(marshaledBody))
-	if err != nil {
-		return err
-	}
-
-	req.Header.Set("Authorization", os.Getenv("TEMPORAL_ADONIS_KEY"))
-	req.Header.Set("Content-Type", "application/json")
-	client := &http.Client{}
-	_, err = client.Do(req)
+// MpeCreationAcknowledgementArgs is encoded as the room state with the rejected tracks next to its fields.
+type MpeCreationAcknowledgementArgs struct {
+	shared_mpe.MpeRoomExposedState
+	// Initial tracks that could not be fetched or that can not be played in the room
+	RejectedTracks []shared.RejectedTrack `json:"rejectedTracks,omitempty"`
+}
 
-	return err
+func (a *Activities) MpeCreationAcknowledgementActivity(ctx context.Context, args MpeCreationAcknowledgementArgs) error {
+	return activities.PostAdonisCallback(ctx, activities.AdonisMpeCallbacksPath+"/mpe-creation-acknowledgement", args)
 }
 
 func (a *Activities) RejectAddingTracksActivity(ctx context.Context, args RejectAddingTracksActivityArgs) error {
-	requestBody := args
-
-	marshaledBody, err := json.Marshal(requestBody)
-	if err != nil {
-		return err
-	}
-
-	url := ADONIS_MPE_ENDPOINT + "/reject-adding-tracks"
-	req, err := http.NewRequest(http.MethodPost, url, bytes.NewBuffer(marshaledBody))
-	if err != nil {
-		return err
-	}
-
-	req.Header.Set("Authorization", os.Getenv("TEMPORAL_ADONIS_KEY"))
-	req.Header.Set("Content-Type", "application/json")
-	client := &http.Client{}
-	_, err = client.Do(req)
-
-	return err
+	return activities.PostAdonisCallback(ctx, activities.AdonisMpeCallbacksPath+"/reject-adding-tracks", args)
 }
 
 func (a *Activities) AcknowledgeAddingTracksActivity(ctx context.Context, args AcknowledgeAddingTracksActivityArgs) error {
-	requestBody := args
-
-	marshaledBody, err := json.Marshal(requestBody)
-	if err != nil {
-		return err
-	}
-
-	url := ADONIS_MPE_ENDPOINT + "/acknowledge-adding-tracks"
-	req, err := http.NewRequest(http.MethodPost, url, bytes.NewBuffer(marshaledBody))
-	if err != nil {
-		return err
-	}
-
-	req.Header.Set("Content-Type", "application/json")
-	req.Header.Set("Authorization", os.Getenv("TEMPORAL_ADONIS_KEY"))
-	client := &http.Client{}
-	_, err = client.Do(req)
-
-	return err
+	return activities.PostAdonisCallback(ctx, activities.AdonisMpeCallbacksPath+"/acknowledge-adding-tracks", args)
 }
 
 type RejectChangeTrackOrderActivityArgs struct {
@@ -117,91 +68,19 @@ type AcknowledgeJoinActivityArgs struct {
 }
 
 func (a *Activities) AcknowledgeChangeTrackOrderActivity(ctx context.Context, args AcknowledgeChangeTrackOrderActivityArgs) error {
-	requestBody := args
-
-	marshaledBody, err := json.Marshal(requestBody)
-	if err != nil {
-		return err
-	}
-
-	url := ADONIS_MPE_ENDPOINT + "/acknowledge-change-track-order"
-	req, err := http.NewRequest(http.MethodPost, url, bytes.NewBuffer(marshaledBody))
-	if err != nil {
-		return err
-	}
-
-	req.Header.Set("Authorization", os.Getenv("TEMPORAL_ADONIS_KEY"))
-	req.Header.Set("Content-Type", "application/json")
-	client := &http.Client{}
-	_, err = client.Do(req)
-
-	return err
+	return activities.PostAdonisCallback(ctx, activities.AdonisMpeCallbacksPath+"/acknowledge-change-track-order", args)
 }
 
 func (a *Activities) RejectChangeTrackOrderActivity(ctx context.Context, args RejectChangeTrackOrderActivityArgs) error {
-	requestBody := args
-
-	marshaledBody, err := json.Marshal(requestBody)
-	if err != nil {
-		return err
-	}
-
-	url := ADONIS_MPE_ENDPOINT + "/reject-change-track-order"
-	req, err := http.NewRequest(http.MethodPost, url, bytes.NewBuffer(marshaledBody))
-	if err != nil {
-		return err
-	}
-
-	req.Header.Set("Authorization", os.Getenv("TEMPORAL_ADONIS_KEY"))
-	req.Header.Set("Content-Type", "application/json")
-	client := &http.Client{}
-	_, err = client.Do(req)
-
-	return err
+	return activities.PostAdonisCallback(ctx, activities.AdonisMpeCallbacksPath+"/reject-change-track-order", args)
 }
 
 func (a *Activities) AcknowledgeDeletingTracksActivity(ctx context.Context, args AcknowledgeDeletingTracksActivityArgs) error {
-	requestBody := args
-
-	marshaledBody, err := json.Marshal(requestBody)
-	if err != nil {
-		return err
-	}
-
-	url := ADONIS_MPE_ENDPOINT + "/acknowledge-deleting-tracks"
-	req, err := http.NewRequest(http.MethodPost, url, bytes.NewBuffer(marshaledBody))
-	if err != nil {
-		return err
-	}
-
-	req.Header.Set("Authorization", os.Getenv("TEMPORAL_ADONIS_KEY"))
-	req.Header.Set("Content-Type", "application/json")
-	client := &http.Client{}
-	_, err = client.Do(req)
-
-	return err
+	return activities.PostAdonisCallback(ctx, activities.AdonisMpeCallbacksPath+"/acknowledge-deleting-tracks", args)
 }
 
 func (a *Activities) AcknowledgeJoinActivity(ctx context.Context, args AcknowledgeJoinActivityArgs) error {
-	requestBody := args
-
-	marshaledBody, err := json.Marshal(requestBody)
-	if err != nil {
-		return err
-	}
-
-	url := ADONIS_MPE_ENDPOINT + "/acknowledge-join"
-	req, err := http.NewRequest(http.MethodPost, url, bytes.NewBuffer(marshaledBody))
-	if err != nil {
-		return err
-	}
-
-	req.Header.Set("Authorization", os.Getenv("TEMPORAL_ADONIS_KEY"))
-	req.Header.Set("Content-Type", "application/json")
-	client := &http.Client{}
-	_, err = client.Do(req)
-
-	return err
+	return activities.PostAdonisCallback(ctx, activities.AdonisMpeCallbacksPath+"/acknowledge-join", args)
 }
 
 type AcknowledgeLeaveActivityArgs struct {
@@ -210,25 +89,7 @@ type AcknowledgeLeaveActivityArgs struct {
 }
 
 func (a *Activities) AcknowledgeLeaveActivity(ctx context.Context, args AcknowledgeLeaveActivityArgs) error {
-	requestBody := args
-
-	marshaledBody, err := json.Marshal(requestBody)
-	if err != nil {
-		return err
-	}
-
-	url := ADONIS_MPE_ENDPOINT + "/acknowledge-leave"
-	req, err := http.NewRequest(http.MethodPost, url, bytes.NewBuffer(marshaledBody))
-	if err != nil {
-		return err
-	}
-
-	req.Header.Set("Authorization", os.Getenv("TEMPORAL_ADONIS_KEY"))
-	req.Header.Set("Content-Type", "application/json")
-	client := &http.Client{}
-	_, err = client.Do(req)
-
-	return err
+	return activities.PostAdonisCallback(ctx, activities.AdonisMpeCallbacksPath+"/acknowledge-leave", args)
 }
 
 type SendMtvRoomCreationRequestToServerActivityArgs struct {
@@ -239,23 +100,14 @@ type SendMtvRoomCreationRequestToServerActivityArgs struct {
 }
 
 func (a *Activities) SendMtvRoomCreationRequestToServerActivity(ctx context.Context, args SendMtvRoomCreationRequestToServerActivityArgs) error {
-	requestBody := args
-
-	marshaledBody, err := json.Marshal(requestBody)
-	if err != nil {
-		return err
-	}
-
-	url := ADONIS_MPE_ENDPOINT + "/request-mtv-room-creation"
-	req, err := http.NewRequest(http.MethodPost, url, bytes.NewBuffer(marshaledBody))
-	if err != nil {
-		return err
-	}
+	return activities.PostAdonisCallback(ctx, activities.AdonisMpeCallbacksPath+"/request-mtv-room-creation", args)
+}
 
-	req.Header.Set("Authorization", os.Getenv("TEMPORAL_ADONIS_KEY"))
-	req.Header.Set("Content-Type", "application/json")
-	client := &http.Client{}
-	_, err = client.Do(req)
+type RoomExpiredActivityArgs struct {
+	RoomID string                      `json:"roomID"`
+	Reason shared.RoomExpirationReason `json:"reason"`
+}
 
-	return err
+func (a *Activities) RoomExpiredActivity(ctx context.Context, args RoomExpiredActivityArgs) error {
+	return activities.PostAdonisCallback(ctx, activities.AdonisMpeCallbacksPath+"/room-expired", args)
 }
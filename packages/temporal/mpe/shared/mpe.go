@@ -46,10 +46,56 @@ type MpeRoomParameters struct {
 	CreatorUserRelatedInformation *InternalStateUser
 	IsOpen                        bool
 	IsOpenOnlyInvitedUsersCanEdit bool
+
+	// ContinueAsNewEventsThreshold is the number of events a workflow run
+	// handles before continuing as new. DefaultContinueAsNewEventsThreshold is used when zero.
+	ContinueAsNewEventsThreshold int
+	// Snapshot is set when the workflow run has been started by a continue-as-new.
+	// The playlist is then restored from it and initial tracks are not fetched again.
+	Snapshot *MpeRoomStateSnapshot
+	// IdlePolicy defines when the room terminates by itself once it has been abandoned.
+	IdlePolicy shared.RoomIdlePolicy
+	// PlayabilityPolicy defines which added tracks are accepted.
+	PlayabilityPolicy shared.TrackPlayabilityPolicy
+}
+
+func (p MpeRoomParameters) GetContinueAsNewEventsThreshold() int {
+	if p.ContinueAsNewEventsThreshold <= 0 {
+		return DefaultContinueAsNewEventsThreshold
+	}
+
+	return p.ContinueAsNewEventsThreshold
+}
+
+type MpeRoomPendingAddingTracks struct {
+	TracksIDs []string
+	// Set instead of TracksIDs when adding the top hit of a search
+	Query    string
+	UserID   string
+	DeviceID string
+}
+
+// MpeRoomStateSnapshot contains everything a new run of MpeRoomWorkflow
+// needs to resume a playlist where the previous run stopped.
+type MpeRoomStateSnapshot struct {
+	Users  map[string]*InternalStateUser
+	Tracks []shared.TrackMetadata
+
+	// Added tracks whose information was still being fetched during the handover.
+	PendingAddingTracks []MpeRoomPendingAddingTracks
+
+	IdleClocks shared.RoomIdleClocks
 }
 
 const ControlTaskQueue = "CONTROL_TASK_QUEUE"
 
+const (
+	DefaultContinueAsNewEventsThreshold = 1000
+	// Leaves time to the activities scheduled by the current run to be started
+	// before the run is closed by the continue-as-new.
+	ContinueAsNewGracePeriodDuration = 1000 * time.Millisecond
+)
+
 //This method will return an error if it determines that params are corrupted
 func (p MpeRoomParameters) CheckParamsValidity(now time.Time) error {
 
@@ -59,6 +105,10 @@ func (p MpeRoomParameters) CheckParamsValidity(now time.Time) error {
 		return errors.New("IsOpenOnlyInvitedUsersCanEdit true but IsOpen false")
 	}
 
+	if err := p.IdlePolicy.CheckValidity(); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -74,12 +124,23 @@ type MpeRoomExposedState struct {
 	UserRelatedInformation        *InternalStateUser     `json:"userRelatedInformation"`
 }
 
+// UserCanReadTracks is false when the state has been queried for a user
+// that is not a member of the room and the room is private.
+func (s MpeRoomExposedState) UserCanReadTracks() bool {
+	return s.IsOpen || s.UserRelatedInformation != nil
+}
+
+// TrackMetadataSet keeps the tracks in the order given by the users.
+// Positions are indexed by track ID so that lookups do not scan the tracks.
 type TrackMetadataSet struct {
 	tracks []shared.TrackMetadata
+	// Lazily built as a zero TrackMetadataSet is usable.
+	indexes map[string]int
 }
 
 func (s *TrackMetadataSet) Clear() {
 	s.tracks = []shared.TrackMetadata{}
+	s.indexes = make(map[string]int)
 }
 
 func (s *TrackMetadataSet) GetTotalTracksDuration() int64 {
@@ -93,13 +154,7 @@ func (s *TrackMetadataSet) GetTotalTracksDuration() int64 {
 }
 
 func (s *TrackMetadataSet) Has(trackID string) bool {
-	for _, track := range s.tracks {
-		if track.ID == trackID {
-			return true
-		}
-	}
-
-	return false
+	return s.IndexOf(trackID) != -1
 }
 
 func (s *TrackMetadataSet) Add(track shared.TrackMetadata) error {
@@ -108,11 +163,12 @@ func (s *TrackMetadataSet) Add(track shared.TrackMetadata) error {
 	}
 
 	s.tracks = append(s.tracks, track)
+	s.indexes[track.ID] = len(s.tracks) - 1
 	return nil
 }
 
 func (s *TrackMetadataSet) Init() {
-	s.tracks = []shared.TrackMetadata{}
+	s.Clear()
 }
 
 func (s *TrackMetadataSet) Values() []shared.TrackMetadata {
@@ -121,13 +177,30 @@ func (s *TrackMetadataSet) Values() []shared.TrackMetadata {
 
 //Returns -1 if element is not found
 func (s *TrackMetadataSet) IndexOf(trackID string) int {
-	for index, track := range s.tracks {
-		if track.ID == trackID {
-			return index
-		}
+	s.ensureIndexes()
+
+	index, exists := s.indexes[trackID]
+	if !exists {
+		return -1
 	}
 
-	return -1
+	return index
+}
+
+func (s *TrackMetadataSet) ensureIndexes() {
+	if s.indexes != nil {
+		return
+	}
+
+	s.indexes = make(map[string]int, len(s.tracks))
+	s.reindex(0)
+}
+
+// reindex updates the indexed position of the tracks starting from given index.
+func (s *TrackMetadataSet) reindex(from int) {
+	for index := from; index < len(s.tracks); index++ {
+		s.indexes[s.tracks[index].ID] = index
+	}
 }
 
 func inBetweenMinMaxIncluded(i, min, max int) bool {
@@ -158,15 +231,20 @@ func (s *TrackMetadataSet) Swap(srcIndex, destIndex int) error {
 	}
 
 	s.tracks[srcIndex], s.tracks[destIndex] = s.tracks[destIndex], s.tracks[srcIndex]
+	s.ensureIndexes()
+	s.indexes[s.tracks[srcIndex].ID] = srcIndex
+	s.indexes[s.tracks[destIndex].ID] = destIndex
 
 	return nil
 }
 
 func (s *TrackMetadataSet) Delete(trackID string) {
-	for index, track := range s.tracks {
-		if track.ID == trackID {
-			s.tracks = append(s.tracks[:index], s.tracks[index+1:]...)
-			return
-		}
+	index := s.IndexOf(trackID)
+	if index == -1 {
+		return
 	}
+
+	s.tracks = append(s.tracks[:index], s.tracks[index+1:]...)
+	delete(s.indexes, trackID)
+	s.reindex(index)
 }
@@ -0,0 +1,106 @@
+package shared_mpe_test
+
+import (
+	"fmt"
+	"testing"
+
+	shared_mpe "github.com/AdonisEnProvence/MusicRoom/mpe/shared"
+	"github.com/AdonisEnProvence/MusicRoom/random"
+	"github.com/AdonisEnProvence/MusicRoom/shared"
+	"github.com/bxcodec/faker/v3"
+	"github.com/stretchr/testify/suite"
+)
+
+type UnitTestSuite struct {
+	suite.Suite
+}
+
+func (s *UnitTestSuite) Test_TrackMetadataSetKeepsIndexesUpToDate() {
+	var set shared_mpe.TrackMetadataSet
+
+	tracks := generateTracksMetadata(4)
+	for _, track := range tracks {
+		s.NoError(set.Add(track))
+	}
+	s.Error(set.Add(tracks[0]))
+
+	s.NoError(set.Swap(0, 3))
+	s.Equal(3, set.IndexOf(tracks[0].ID))
+	s.Equal(0, set.IndexOf(tracks[3].ID))
+
+	set.Delete(tracks[1].ID)
+	s.False(set.Has(tracks[1].ID))
+	s.Equal(-1, set.IndexOf(tracks[1].ID))
+	s.Equal(
+		[]shared.TrackMetadata{tracks[3], tracks[2], tracks[0]},
+		set.Values(),
+	)
+
+	for expectedIndex, track := range set.Values() {
+		s.Equal(expectedIndex, set.IndexOf(track.ID))
+	}
+
+	set.Clear()
+	s.False(set.Has(tracks[0].ID))
+	s.Empty(set.Values())
+}
+
+func TestUnitTestSuite(t *testing.T) {
+	suite.Run(t, new(UnitTestSuite))
+}
+
+func generateTracksMetadata(count int) []shared.TrackMetadata {
+	tracks := make([]shared.TrackMetadata, 0, count)
+	for i := 0; i < count; i++ {
+		tracks = append(tracks, shared.TrackMetadata{
+			ID:         faker.UUIDHyphenated(),
+			Title:      faker.Word(),
+			ArtistName: faker.Name(),
+			Duration:   random.GenerateRandomDuration(),
+		})
+	}
+
+	return tracks
+}
+
+var benchmarkedTracksCounts = []int{100, 1000, 10000}
+
+func BenchmarkTrackMetadataSetHas(b *testing.B) {
+	for _, tracksCount := range benchmarkedTracksCounts {
+		b.Run(fmt.Sprintf("%d tracks", tracksCount), func(b *testing.B) {
+			var set shared_mpe.TrackMetadataSet
+
+			tracks := generateTracksMetadata(tracksCount)
+			for _, track := range tracks {
+				set.Add(track)
+			}
+
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				set.Has(tracks[i%tracksCount].ID)
+			}
+		})
+	}
+}
+
+func BenchmarkTrackMetadataSetIndexOfAndSwap(b *testing.B) {
+	for _, tracksCount := range benchmarkedTracksCounts {
+		b.Run(fmt.Sprintf("%d tracks", tracksCount), func(b *testing.B) {
+			var set shared_mpe.TrackMetadataSet
+
+			tracks := generateTracksMetadata(tracksCount)
+			for _, track := range tracks {
+				set.Add(track)
+			}
+
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				index := set.IndexOf(tracks[i%tracksCount].ID)
+
+				if index > 0 {
+					set.Swap(index, index-1)
+				}
+			}
+		})
+	}
+}
@@ -15,15 +15,18 @@ const (
 	SignalTerminateWorkflow shared.SignalRoute = "terminate-workflow"
 )
 
+// AddTracksSignal adds either TracksIDs or the top hit of the search of Query.
 type AddTracksSignal struct {
 	Route     shared.SignalRoute `validate:"required"`
-	TracksIDs []string           `validate:"required,dive,required"`
+	TracksIDs []string           `validate:"required_without=Query,excluded_with=Query,dive,required"`
+	Query     string             `validate:"max=200"`
 	UserID    string             `validate:"required"`
 	DeviceID  string             `validate:"required"`
 }
 
 type NewAddTracksSignalArgs struct {
 	TracksIDs []string
+	Query     string
 	UserID    string
 	DeviceID  string
 }
@@ -32,6 +35,7 @@ func NewAddTracksSignal(args NewAddTracksSignalArgs) AddTracksSignal {
 	return AddTracksSignal{
 		Route:     SignalAddTracks,
 		TracksIDs: args.TracksIDs,
+		Query:     args.Query,
 		UserID:    args.UserID,
 		DeviceID:  args.DeviceID,
 	}
@@ -65,6 +65,27 @@ func (s *MpeRoomInternalState) FillWith(params shared_mpe.MpeRoomParameters) {
 	s.AddUser(*params.CreatorUserRelatedInformation)
 }
 
+//This method will restore the internalState as it was at the end of the previous workflow run
+func (s *MpeRoomInternalState) RestoreFrom(snapshot shared_mpe.MpeRoomStateSnapshot) {
+	s.Users = make(map[string]*shared_mpe.InternalStateUser)
+	for _, user := range snapshot.Users {
+		s.AddUser(*user)
+	}
+
+	s.Tracks.Clear()
+	for _, track := range snapshot.Tracks {
+		s.Tracks.Add(track)
+	}
+}
+
+// Snapshot returns the part of the internalState that has to be carried over to the next workflow run.
+func (s *MpeRoomInternalState) Snapshot() shared_mpe.MpeRoomStateSnapshot {
+	return shared_mpe.MpeRoomStateSnapshot{
+		Users:  s.Users,
+		Tracks: s.Tracks.Values(),
+	}
+}
+
 // In the internalState.Export method we do not use workflow.sideEffect for at least two reasons:
 // 1- we cannot use workflow.sideEffect in the getState queryHandler
 // 2- we never update our internalState depending on internalState.Export() results this data aims to be sent to adonis.
@@ -130,6 +151,10 @@ func MpeRoomWorkflow(ctx workflow.Context, params shared_mpe.MpeRoomParameters)
 	}
 	///
 	internalState.FillWith(params)
+	isContinuedAsNew := params.Snapshot != nil
+	if isContinuedAsNew {
+		internalState.RestoreFrom(*params.Snapshot)
+	}
 
 	if err := workflow.SetQueryHandler(
 		ctx,
@@ -151,12 +176,31 @@ func MpeRoomWorkflow(ctx workflow.Context, params shared_mpe.MpeRoomParameters)
 		terminated                           = false
 		workflowFatalError                   error
 		fetchedInitialTracksFuture           workflow.Future
-		fetchedAddedTracksInformationFutures []workflow.Future
+		initialTracksQuotaResetTimer         workflow.Future
+		fetchedAddedTracksInformationFutures []addedTracksInformationFetching
+
+		handledEventsCount             = 0
+		continueAsNewGracePeriodTimer  workflow.Future
+		continueAsNewGracePeriodIsOver = false
 	)
 
+	fetchInitialTracks := func() {
+		fetchedInitialTracksFuture = sendFetchTracksInformationWithPolicyActivity(ctx, activities.FetchTracksInformationArgs{
+			TracksIDs: internalState.initialParams.InitialTracksIDs,
+			UserID:    internalState.initialParams.RoomCreatorUserID,
+			Policy:    internalState.initialParams.PlayabilityPolicy,
+		})
+	}
+
+	//A playlist restored from a snapshot already has its tracks
+	initialState := MpeRoomFetchInitialTrack
+	if isContinuedAsNew {
+		initialState = MpeRoomReady
+	}
+
 	//create machine here
 	internalState.Machine, err = brainy.NewMachine(brainy.StateNode{
-		Initial: MpeRoomFetchInitialTrack,
+		Initial: initialState,
 
 		States: brainy.StateNodes{
 
@@ -165,7 +209,7 @@ func MpeRoomWorkflow(ctx workflow.Context, params shared_mpe.MpeRoomParameters)
 					brainy.ActionFn(
 						func(c brainy.Context, e brainy.Event) error {
 
-							fetchedInitialTracksFuture = sendFetchTracksInformationActivity(ctx, internalState.initialParams.InitialTracksIDs)
+							fetchInitialTracks()
 
 							return nil
 						},
@@ -182,9 +226,14 @@ func MpeRoomWorkflow(ctx workflow.Context, params shared_mpe.MpeRoomParameters)
 							),
 							brainy.ActionFn(
 								func(c brainy.Context, e brainy.Event) error {
+									event := e.(MpeRoomInitialTrackFetchedEvent)
+
 									acknowledgeRoomCreation(
 										ctx,
-										internalState.Export(internalState.initialParams.RoomCreatorUserID),
+										activities_mpe.MpeCreationAcknowledgementArgs{
+											MpeRoomExposedState: internalState.Export(internalState.initialParams.RoomCreatorUserID),
+											RejectedTracks:      event.RejectedTracks,
+										},
 									)
 
 									return nil
@@ -218,7 +267,8 @@ func MpeRoomWorkflow(ctx workflow.Context, params shared_mpe.MpeRoomParameters)
 											acceptedTracksIDsToAdd = append(acceptedTracksIDsToAdd, trackToAdd)
 										}
 
-										noTracksHaveBeenAccepted := len(acceptedTracksIDsToAdd) == 0
+										// The track searched by a query is checked against the playlist once fetched
+										noTracksHaveBeenAccepted := len(acceptedTracksIDsToAdd) == 0 && event.Query == ""
 										if noTracksHaveBeenAccepted {
 											sendRejectAddingTracksActivity(ctx, activities_mpe.RejectAddingTracksActivityArgs{
 												RoomID:   params.RoomID,
@@ -229,13 +279,13 @@ func MpeRoomWorkflow(ctx workflow.Context, params shared_mpe.MpeRoomParameters)
 											return nil
 										}
 
-										fetchingFuture := sendFetchTracksInformationActivityAndForwardInitiator(
-											ctx,
-											acceptedTracksIDsToAdd,
-											event.UserID,
-											event.DeviceID,
-										)
-										fetchedAddedTracksInformationFutures = append(fetchedAddedTracksInformationFutures, fetchingFuture)
+										fetching := sendAddedTracksInformationFetching(ctx, shared_mpe.MpeRoomPendingAddingTracks{
+											TracksIDs: acceptedTracksIDsToAdd,
+											Query:     event.Query,
+											UserID:    event.UserID,
+											DeviceID:  event.DeviceID,
+										}, internalState.initialParams.PlayabilityPolicy)
+										fetchedAddedTracksInformationFutures = append(fetchedAddedTracksInformationFutures, fetching)
 
 										return nil
 									},
@@ -278,9 +328,10 @@ func MpeRoomWorkflow(ctx workflow.Context, params shared_mpe.MpeRoomParameters)
 
 									if allTracksAreDuplicated {
 										sendRejectAddingTracksActivity(ctx, activities_mpe.RejectAddingTracksActivityArgs{
-											RoomID:   params.RoomID,
-											UserID:   event.UserID,
-											DeviceID: event.DeviceID,
+											RoomID:         params.RoomID,
+											UserID:         event.UserID,
+											DeviceID:       event.DeviceID,
+											RejectedTracks: event.RejectedTracks,
 										})
 
 										return nil
@@ -291,9 +342,10 @@ func MpeRoomWorkflow(ctx workflow.Context, params shared_mpe.MpeRoomParameters)
 									}
 
 									sendAcknowledgeAddingTracksActivity(ctx, activities_mpe.AcknowledgeAddingTracksActivityArgs{
-										State:    internalState.Export(shared_mpe.NoRelatedUserID),
-										UserID:   event.UserID,
-										DeviceID: event.DeviceID,
+										State:          internalState.Export(shared_mpe.NoRelatedUserID),
+										UserID:         event.UserID,
+										DeviceID:       event.DeviceID,
+										RejectedTracks: event.RejectedTracks,
 									})
 
 									return nil
@@ -438,12 +490,30 @@ func MpeRoomWorkflow(ctx workflow.Context, params shared_mpe.MpeRoomParameters)
 		return err
 	}
 
+	if isContinuedAsNew {
+		for _, pendingAddingTracks := range params.Snapshot.PendingAddingTracks {
+			fetching := sendAddedTracksInformationFetching(ctx, pendingAddingTracks, params.PlayabilityPolicy)
+
+			fetchedAddedTracksInformationFutures = append(fetchedAddedTracksInformationFutures, fetching)
+		}
+	}
+
+	var idleTimers *shared.RoomIdleTimers
+	if isContinuedAsNew {
+		idleTimers = shared.RestoreRoomIdleTimers(ctx, params.IdlePolicy, params.Snapshot.IdleClocks)
+	} else {
+		idleTimers = shared.NewRoomIdleTimers(params.IdlePolicy)
+		idleTimers.RecordActivity(ctx)
+	}
+	var roomExpirationReason shared.RoomExpirationReason
+
 	for {
 		selector := workflow.NewSelector(ctx)
 
 		selector.AddReceive(channel, func(c workflow.ReceiveChannel, _ bool) {
 			var signal interface{}
 			c.Receive(ctx, &signal)
+			idleTimers.RecordActivity(ctx)
 
 			var routeSignal shared.GenericRouteSignal
 
@@ -468,6 +538,7 @@ func MpeRoomWorkflow(ctx workflow.Context, params shared_mpe.MpeRoomParameters)
 				internalState.Machine.Send(
 					NewMpeRoomAddTracksEvent(NewMpeRoomAddTracksEventArgs{
 						TracksIDs: message.TracksIDs,
+						Query:     message.Query,
 						UserID:    message.UserID,
 						DeviceID:  message.DeviceID,
 					}),
@@ -591,11 +662,26 @@ func MpeRoomWorkflow(ctx workflow.Context, params shared_mpe.MpeRoomParameters)
 			selector.AddFuture(fetchedInitialTracksFuture, func(f workflow.Future) {
 				fetchedInitialTracksFuture = nil
 
-				var initialTrackActivityResult []shared.TrackMetadata
+				var initialTrackActivityResult activities.FetchedTracksInformationWithInitiator
 
 				if err := f.Get(ctx, &initialTrackActivityResult); err != nil {
 					logger.Error("error occured initialTrackActivityResult", err)
 
+					//The room creation can not be acknowledged before the initial tracks are known,
+					//so the fetching is tried again once the provider quota is reset
+					if resetsAt, isQuotaError := activities.QuotaResetsAt(err); isQuotaError {
+						initialTracksQuotaResetTimer = workflow.NewTimer(ctx, resetsAt.Sub(getNowFromSideEffect(ctx)))
+
+						return
+					}
+
+					internalState.Machine.Send(
+						NewMpeRoomInitialTracksFetchedEvent(
+							nil,
+							activities.RejectTracksOfFailedFetch(internalState.initialParams.InitialTracksIDs, "", err),
+						),
+					)
+
 					return
 				}
 
@@ -603,19 +689,37 @@ func MpeRoomWorkflow(ctx workflow.Context, params shared_mpe.MpeRoomParameters)
 				fmt.Printf("\n%+v\n", initialTrackActivityResult)
 				fmt.Println("**********************************")
 				internalState.Machine.Send(
-					NewMpeRoomInitialTracksFetchedEvent(initialTrackActivityResult),
+					NewMpeRoomInitialTracksFetchedEvent(initialTrackActivityResult.Metadata, initialTrackActivityResult.RejectedTracks),
 				)
 			})
 		}
 
-		for index, fetchedAddedTracksInformationFuture := range fetchedAddedTracksInformationFutures {
-			selector.AddFuture(fetchedAddedTracksInformationFuture, func(f workflow.Future) {
-				fetchedAddedTracksInformationFutures = removeFutureFromSlice(fetchedAddedTracksInformationFutures, index)
+		if initialTracksQuotaResetTimer != nil {
+			selector.AddFuture(initialTracksQuotaResetTimer, func(f workflow.Future) {
+				initialTracksQuotaResetTimer = nil
+
+				fetchInitialTracks()
+			})
+		}
+
+		for index, fetchedAddedTracksInformation := range fetchedAddedTracksInformationFutures {
+			index, fetchedAddedTracksInformation := index, fetchedAddedTracksInformation
+			selector.AddFuture(fetchedAddedTracksInformation.Future, func(f workflow.Future) {
+				fetchedAddedTracksInformationFutures = removeFetchingFromSlice(fetchedAddedTracksInformationFutures, index)
 
 				var addedTracksInformationActivityResult activities.FetchedTracksInformationWithInitiator
 
 				if err := f.Get(ctx, &addedTracksInformationActivityResult); err != nil {
-					logger.Error("error occured initialTracksActivityResult", err)
+					logger.Error("error occured addedTracksInformationActivityResult", err)
+
+					request := fetchedAddedTracksInformation.Request
+					internalState.Machine.Send(
+						NewMpeRoomAddedTracksInformationFetchedEvent(NewMpeRoomAddedTracksInformationFetchedEventArgs{
+							RejectedTracks: activities.RejectTracksOfFailedFetch(request.TracksIDs, request.Query, err),
+							UserID:         request.UserID,
+							DeviceID:       request.DeviceID,
+						}),
+					)
 
 					return
 				}
@@ -623,6 +727,7 @@ func MpeRoomWorkflow(ctx workflow.Context, params shared_mpe.MpeRoomParameters)
 				internalState.Machine.Send(
 					NewMpeRoomAddedTracksInformationFetchedEvent(NewMpeRoomAddedTracksInformationFetchedEventArgs{
 						AddedTracksInformation: addedTracksInformationActivityResult.Metadata,
+						RejectedTracks:         addedTracksInformationActivityResult.RejectedTracks,
 						UserID:                 addedTracksInformationActivityResult.UserID,
 						DeviceID:               addedTracksInformationActivityResult.DeviceID,
 					}),
@@ -630,17 +735,112 @@ func MpeRoomWorkflow(ctx workflow.Context, params shared_mpe.MpeRoomParameters)
 			})
 		}
 
+		if continueAsNewGracePeriodTimer != nil {
+			selector.AddFuture(continueAsNewGracePeriodTimer, func(f workflow.Future) {
+				continueAsNewGracePeriodTimer = nil
+				continueAsNewGracePeriodIsOver = true
+			})
+		}
+
+		idleTimers.AddToSelector(ctx, selector, func(reason shared.RoomExpirationReason) {
+			roomExpirationReason = reason
+		})
+
+		//Once the grace period is over we wait for a select round
+		//without any signal or future to handle before continuing as new.
+		readyToContinueAsNew := false
+		waitingForIdleRound := continueAsNewGracePeriodIsOver
+		if waitingForIdleRound {
+			selector.AddDefault(func() {
+				readyToContinueAsNew = true
+			})
+		}
+
 		selector.Select(ctx)
 
 		if terminated || workflowFatalError != nil {
 			break
 		}
+
+		if roomExpirationReason != "" {
+			if err := sendRoomExpiredActivity(ctx, activities_mpe.RoomExpiredActivityArgs{
+				RoomID: params.RoomID,
+				Reason: roomExpirationReason,
+			}); err != nil {
+				logger.Error("room expired activity failed", err)
+			}
+
+			break
+		}
+
+		idleTimers.UpdateRoomIsEmpty(ctx, len(internalState.Users) == 0)
+
+		if readyToContinueAsNew {
+			return continueAsNew(ctx, params, &internalState, fetchedAddedTracksInformationFutures, idleTimers.Clocks())
+		}
+
+		if waitingForIdleRound {
+			continueAsNewGracePeriodIsOver = false
+			continueAsNewGracePeriodTimer = workflow.NewTimer(ctx, shared_mpe.ContinueAsNewGracePeriodDuration)
+		}
+
+		handledEventsCount++
+		roomIsReady := internalState.Machine.Current().Matches(MpeRoomReady)
+		shouldContinueAsNew := handledEventsCount >= params.GetContinueAsNewEventsThreshold() && roomIsReady
+		if shouldContinueAsNew && continueAsNewGracePeriodTimer == nil {
+			continueAsNewGracePeriodTimer = workflow.NewTimer(ctx, shared_mpe.ContinueAsNewGracePeriodDuration)
+		}
 	}
 
 	return workflowFatalError
 }
 
-func acknowledgeRoomCreation(ctx workflow.Context, state shared_mpe.MpeRoomExposedState) error {
+// continueAsNew ends the current workflow run and starts a new one seeded with a snapshot of the playlist.
+func continueAsNew(
+	ctx workflow.Context,
+	params shared_mpe.MpeRoomParameters,
+	internalState *MpeRoomInternalState,
+	pendingFetchings []addedTracksInformationFetching,
+	idleClocks shared.RoomIdleClocks,
+) error {
+	snapshot := internalState.Snapshot()
+
+	snapshot.PendingAddingTracks = make([]shared_mpe.MpeRoomPendingAddingTracks, 0, len(pendingFetchings))
+	for _, fetching := range pendingFetchings {
+		snapshot.PendingAddingTracks = append(snapshot.PendingAddingTracks, fetching.Request)
+	}
+	snapshot.IdleClocks = idleClocks
+
+	params.Snapshot = &snapshot
+
+	return workflow.NewContinueAsNewError(ctx, MpeRoomWorkflow, params)
+}
+
+type addedTracksInformationFetching struct {
+	Future  workflow.Future
+	Request shared_mpe.MpeRoomPendingAddingTracks
+}
+
+func sendAddedTracksInformationFetching(ctx workflow.Context, request shared_mpe.MpeRoomPendingAddingTracks, policy shared.TrackPlayabilityPolicy) addedTracksInformationFetching {
+	if request.Query != "" {
+		return addedTracksInformationFetching{
+			Future:  sendSearchTopTrackActivityAndForwardInitiator(ctx, request.Query, request.UserID, request.DeviceID, policy),
+			Request: request,
+		}
+	}
+
+	return addedTracksInformationFetching{
+		Future: sendFetchTracksInformationWithPolicyActivity(ctx, activities.FetchTracksInformationArgs{
+			TracksIDs: request.TracksIDs,
+			UserID:    request.UserID,
+			DeviceID:  request.DeviceID,
+			Policy:    policy,
+		}),
+		Request: request,
+	}
+}
+
+func acknowledgeRoomCreation(ctx workflow.Context, args activities_mpe.MpeCreationAcknowledgementArgs) error {
 	ao := workflow.ActivityOptions{
 		ScheduleToStartTimeout: time.Minute,
 		StartToCloseTimeout:    time.Minute,
@@ -651,7 +851,7 @@ func acknowledgeRoomCreation(ctx workflow.Context, state shared_mpe.MpeRoomExpos
 	if err := workflow.ExecuteActivity(
 		ctx,
 		a.MpeCreationAcknowledgementActivity,
-		state,
+		args,
 	).Get(ctx, nil); err != nil {
 		return err
 	}
@@ -663,7 +863,7 @@ type TimeWrapperType func() time.Time
 
 var TimeWrapper TimeWrapperType = time.Now
 
-func removeFutureFromSlice(slice []workflow.Future, index int) []workflow.Future {
+func removeFetchingFromSlice(slice []addedTracksInformationFetching, index int) []addedTracksInformationFetching {
 	slice[index] = slice[len(slice)-1]
 	return slice[:len(slice)-1]
 }
@@ -5,37 +5,40 @@ import (
 
 	"github.com/AdonisEnProvence/MusicRoom/activities"
 	activities_mpe "github.com/AdonisEnProvence/MusicRoom/mpe/activities"
+	"github.com/AdonisEnProvence/MusicRoom/shared"
 	"go.temporal.io/sdk/workflow"
 )
 
-func sendFetchTracksInformationActivity(ctx workflow.Context, tracksIDs []string) workflow.Future {
-
+func sendSearchTopTrackActivityAndForwardInitiator(ctx workflow.Context, query string, userID string, deviceID string, policy shared.TrackPlayabilityPolicy) workflow.Future {
 	ao := workflow.ActivityOptions{
 		ScheduleToStartTimeout: time.Minute,
 		StartToCloseTimeout:    time.Minute,
+		RetryPolicy:            activities.FetchTracksRetryPolicy,
 	}
 	ctx = workflow.WithActivityOptions(ctx, ao)
 
 	return workflow.ExecuteActivity(
 		ctx,
-		activities.FetchTracksInformationActivity,
-		tracksIDs,
+		activities.SearchTopTrackActivityAndForwardInitiator,
+		query,
+		userID,
+		deviceID,
+		policy,
 	)
 }
 
-func sendFetchTracksInformationActivityAndForwardInitiator(ctx workflow.Context, tracksIDs []string, userID string, deviceID string) workflow.Future {
+func sendFetchTracksInformationWithPolicyActivity(ctx workflow.Context, args activities.FetchTracksInformationArgs) workflow.Future {
 	ao := workflow.ActivityOptions{
 		ScheduleToStartTimeout: time.Minute,
 		StartToCloseTimeout:    time.Minute,
+		RetryPolicy:            activities.FetchTracksRetryPolicy,
 	}
 	ctx = workflow.WithActivityOptions(ctx, ao)
 
 	return workflow.ExecuteActivity(
 		ctx,
-		activities.FetchTracksInformationActivityAndForwardInitiator,
-		tracksIDs,
-		userID,
-		deviceID,
+		activities.FetchTracksInformationWithPolicyActivity,
+		args,
 	)
 }
 
@@ -158,3 +161,19 @@ func sendMtvRoomCreationRequestToServerActivity(ctx workflow.Context, args activ
 		args,
 	)
 }
+
+//Waits for Adonis to be notified as the workflow terminates right after
+func sendRoomExpiredActivity(ctx workflow.Context, args activities_mpe.RoomExpiredActivityArgs) error {
+	options := workflow.ActivityOptions{
+		ScheduleToStartTimeout: time.Minute,
+		StartToCloseTimeout:    time.Minute,
+	}
+	ctx = workflow.WithActivityOptions(ctx, options)
+
+	var a *activities_mpe.Activities
+	return workflow.ExecuteActivity(
+		ctx,
+		a.RoomExpiredActivity,
+		args,
+	).Get(ctx, nil)
+}
@@ -12,6 +12,7 @@ import (
 	"github.com/bxcodec/faker/v3"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/suite"
+	"go.temporal.io/sdk/temporal"
 	"go.temporal.io/sdk/workflow"
 )
 
@@ -69,18 +70,16 @@ func (s *AddTracksTestSuite) Test_AddTracks() {
 		mock.Anything,
 	).Return(nil).Once()
 	s.env.OnActivity(
-		activities.FetchTracksInformationActivity,
+		activities.FetchTracksInformationWithPolicyActivity,
 		mock.Anything,
-		initialTracksIDs,
-	).Return(initialTracksMetadata, nil).Once()
+		fetchTracksInformationArgs(initialTracksIDs),
+	).Return(activities.FetchedTracksInformationWithInitiator{Metadata: initialTracksMetadata}, nil).Once()
 
 	// Specific activities calls
 	s.env.OnActivity(
-		activities.FetchTracksInformationActivityAndForwardInitiator,
+		activities.FetchTracksInformationWithPolicyActivity,
 		mock.Anything,
-		tracksIDsToAdd,
-		params.RoomCreatorUserID,
-		roomCreatorDeviceID,
+		fetchTracksInformationArgsForInitiator(tracksIDsToAdd, params.RoomCreatorUserID, roomCreatorDeviceID),
 	).Return(activities.FetchedTracksInformationWithInitiator{
 		Metadata: tracksToAddMetadata,
 		UserID:   params.RoomCreatorUserID,
@@ -161,10 +160,10 @@ func (s *AddTracksTestSuite) Test_AddingTrackAlreadyInPlaylistBeforeFetchingInfo
 		mock.Anything,
 	).Return(nil).Once()
 	s.env.OnActivity(
-		activities.FetchTracksInformationActivity,
+		activities.FetchTracksInformationWithPolicyActivity,
 		mock.Anything,
-		initialTracksIDs,
-	).Return(initialTracksMetadata, nil).Once()
+		fetchTracksInformationArgs(initialTracksIDs),
+	).Return(activities.FetchedTracksInformationWithInitiator{Metadata: initialTracksMetadata}, nil).Once()
 
 	// Specific activities calls
 	s.env.OnActivity(
@@ -275,21 +274,19 @@ func (s *AddTracksTestSuite) Test_AddingTrackAlreadyInPlaylistAfterFetchingInfor
 		mock.Anything,
 	).Return(nil).Once()
 	s.env.OnActivity(
-		activities.FetchTracksInformationActivity,
+		activities.FetchTracksInformationWithPolicyActivity,
 		mock.Anything,
-		initialTracksIDs,
-	).Return(initialTracksMetadata, nil).Once()
+		fetchTracksInformationArgs(initialTracksIDs),
+	).Return(activities.FetchedTracksInformationWithInitiator{Metadata: initialTracksMetadata}, nil).Once()
 
 	// Specific activities calls
 	//
 	// Wait for 10 seconds before returning result of the activity.
 	const firstBatchTracksInformationFetchingDebouncingDelay = 10 * time.Second
 	s.env.OnActivity(
-		activities.FetchTracksInformationActivityAndForwardInitiator,
+		activities.FetchTracksInformationWithPolicyActivity,
 		mock.Anything,
-		tracksIDsToAddFirstBatch,
-		params.RoomCreatorUserID,
-		roomCreatorDeviceID,
+		fetchTracksInformationArgsForInitiator(tracksIDsToAddFirstBatch, params.RoomCreatorUserID, roomCreatorDeviceID),
 	).Return(activities.FetchedTracksInformationWithInitiator{
 		Metadata: tracksToAddMetadataFirstBatch,
 		UserID:   params.RoomCreatorUserID,
@@ -297,11 +294,9 @@ func (s *AddTracksTestSuite) Test_AddingTrackAlreadyInPlaylistAfterFetchingInfor
 	}, nil).Once().After(firstBatchTracksInformationFetchingDebouncingDelay)
 
 	s.env.OnActivity(
-		activities.FetchTracksInformationActivityAndForwardInitiator,
+		activities.FetchTracksInformationWithPolicyActivity,
 		mock.Anything,
-		tracksIDsToAddSecondBatch,
-		params.RoomCreatorUserID,
-		roomCreatorDeviceID,
+		fetchTracksInformationArgsForInitiator(tracksIDsToAddSecondBatch, params.RoomCreatorUserID, roomCreatorDeviceID),
 	).Return(activities.FetchedTracksInformationWithInitiator{
 		Metadata: tracksToAddMetadataSecondBatch,
 		UserID:   params.RoomCreatorUserID,
@@ -418,21 +413,19 @@ func (s *AddTracksTestSuite) Test_AddingTrackAlreadyInPlaylistAfterFetchingInfor
 		mock.Anything,
 	).Return(nil).Once()
 	s.env.OnActivity(
-		activities.FetchTracksInformationActivity,
+		activities.FetchTracksInformationWithPolicyActivity,
 		mock.Anything,
-		initialTracksIDs,
-	).Return(initialTracksMetadata, nil).Once()
+		fetchTracksInformationArgs(initialTracksIDs),
+	).Return(activities.FetchedTracksInformationWithInitiator{Metadata: initialTracksMetadata}, nil).Once()
 
 	// Specific activities calls
 	//
 	// Wait for 10 seconds before returning result of the activity.
 	const firstBatchTracksInformationFetchingDebouncingDelay = 10 * time.Second
 	s.env.OnActivity(
-		activities.FetchTracksInformationActivityAndForwardInitiator,
+		activities.FetchTracksInformationWithPolicyActivity,
 		mock.Anything,
-		tracksIDsToAddFirstBatch,
-		params.RoomCreatorUserID,
-		roomCreatorDeviceID,
+		fetchTracksInformationArgsForInitiator(tracksIDsToAddFirstBatch, params.RoomCreatorUserID, roomCreatorDeviceID),
 	).Return(activities.FetchedTracksInformationWithInitiator{
 		Metadata: tracksToAddMetadataFirstBatch,
 		UserID:   params.RoomCreatorUserID,
@@ -440,11 +433,9 @@ func (s *AddTracksTestSuite) Test_AddingTrackAlreadyInPlaylistAfterFetchingInfor
 	}, nil).Once().After(firstBatchTracksInformationFetchingDebouncingDelay)
 
 	s.env.OnActivity(
-		activities.FetchTracksInformationActivityAndForwardInitiator,
+		activities.FetchTracksInformationWithPolicyActivity,
 		mock.Anything,
-		tracksIDsToAddSecondBatch,
-		params.RoomCreatorUserID,
-		roomCreatorDeviceID,
+		fetchTracksInformationArgsForInitiator(tracksIDsToAddSecondBatch, params.RoomCreatorUserID, roomCreatorDeviceID),
 	).Return(activities.FetchedTracksInformationWithInitiator{
 		Metadata: tracksToAddMetadataSecondBatch,
 		UserID:   params.RoomCreatorUserID,
@@ -569,10 +560,10 @@ func (s *AddTracksTestSuite) Test_IsOpenAndIsOpenOnlyInvitedUsersCanEditAndCreat
 		mock.Anything,
 	).Return(nil).Once()
 	s.env.OnActivity(
-		activities.FetchTracksInformationActivity,
+		activities.FetchTracksInformationWithPolicyActivity,
 		mock.Anything,
-		initialTracksIDs,
-	).Return(initialTracksMetadata, nil).Once()
+		fetchTracksInformationArgs(initialTracksIDs),
+	).Return(activities.FetchedTracksInformationWithInitiator{Metadata: initialTracksMetadata}, nil).Once()
 	s.env.OnActivity(
 		a.AcknowledgeJoinActivity,
 		mock.Anything,
@@ -618,11 +609,9 @@ func (s *AddTracksTestSuite) Test_IsOpenAndIsOpenOnlyInvitedUsersCanEditAndCreat
 
 	//Creator add track activity
 	s.env.OnActivity(
-		activities.FetchTracksInformationActivityAndForwardInitiator,
+		activities.FetchTracksInformationWithPolicyActivity,
 		mock.Anything,
-		[]string{creatorTrackToAddMetadata.ID},
-		params.RoomCreatorUserID,
-		roomCreatorDeviceID,
+		fetchTracksInformationArgsForInitiator([]string{creatorTrackToAddMetadata.ID}, params.RoomCreatorUserID, roomCreatorDeviceID),
 	).Return(activities.FetchedTracksInformationWithInitiator{
 		Metadata: []shared.TrackMetadata{creatorTrackToAddMetadata},
 		UserID:   params.RoomCreatorUserID,
@@ -659,11 +648,9 @@ func (s *AddTracksTestSuite) Test_IsOpenAndIsOpenOnlyInvitedUsersCanEditAndCreat
 
 	//InvitedUser adds track activity
 	s.env.OnActivity(
-		activities.FetchTracksInformationActivityAndForwardInitiator,
+		activities.FetchTracksInformationWithPolicyActivity,
 		mock.Anything,
-		[]string{invitedUserTrackToAddMetadata.ID},
-		invitedUserID,
-		invitedUserDeviceID,
+		fetchTracksInformationArgsForInitiator([]string{invitedUserTrackToAddMetadata.ID}, invitedUserID, invitedUserDeviceID),
 	).Return(activities.FetchedTracksInformationWithInitiator{
 		Metadata: []shared.TrackMetadata{invitedUserTrackToAddMetadata},
 		UserID:   invitedUserID,
@@ -700,11 +687,9 @@ func (s *AddTracksTestSuite) Test_IsOpenAndIsOpenOnlyInvitedUsersCanEditAndCreat
 
 	//JoiningUser adds track activity
 	s.env.OnActivity(
-		activities.FetchTracksInformationActivityAndForwardInitiator,
+		activities.FetchTracksInformationWithPolicyActivity,
 		mock.Anything,
-		[]string{joiningUserTrackToAddMetadata.ID},
-		joiningUserID,
-		joiningUserDeviceID,
+		fetchTracksInformationArgsForInitiator([]string{joiningUserTrackToAddMetadata.ID}, joiningUserID, joiningUserDeviceID),
 	).Return(activities.FetchedTracksInformationWithInitiator{
 		Metadata: []shared.TrackMetadata{joiningUserTrackToAddMetadata},
 		UserID:   joiningUserID,
@@ -757,6 +742,271 @@ func (s *AddTracksTestSuite) Test_IsOpenAndIsOpenOnlyInvitedUsersCanEditAndCreat
 	s.ErrorIs(err, workflow.ErrDeadlineExceeded, "The workflow ran on an infinite loop")
 }
 
+func (s *AddTracksTestSuite) Test_AddingOnlyUnresolvedTracksForwardsRejectedTracks() {
+	initialTracksIDs := []string{
+		faker.UUIDHyphenated(),
+	}
+	params, roomCreatorDeviceID := s.getWorkflowInitParams(initialTracksIDs)
+
+	var a *activities_mpe.Activities
+
+	initialTracksMetadata := []shared.TrackMetadata{
+		{
+			ID:         initialTracksIDs[0],
+			Title:      faker.Word(),
+			ArtistName: faker.Name(),
+			Duration:   random.GenerateRandomDuration(),
+		},
+	}
+	rejectedTracks := []shared.RejectedTrack{
+		{
+			ID:     faker.UUIDHyphenated(),
+			Reason: shared.RejectedTrackReasonNotFound,
+		},
+		{
+			ID:     faker.UUIDHyphenated(),
+			Reason: shared.RejectedTrackReasonFetchFailed,
+		},
+	}
+	tracksIDsToAdd := []string{
+		rejectedTracks[0].ID,
+		rejectedTracks[1].ID,
+	}
+
+	tick := 1 * time.Millisecond
+	resetMock, registerDelayedCallbackWrapper := s.initTestEnv()
+
+	defer resetMock()
+
+	// Common activities calls
+	s.env.OnActivity(
+		a.MpeCreationAcknowledgementActivity,
+		mock.Anything,
+		mock.Anything,
+	).Return(nil).Once()
+	s.env.OnActivity(
+		activities.FetchTracksInformationWithPolicyActivity,
+		mock.Anything,
+		fetchTracksInformationArgs(initialTracksIDs),
+	).Return(activities.FetchedTracksInformationWithInitiator{Metadata: initialTracksMetadata}, nil).Once()
+
+	// Specific activities calls
+	s.env.OnActivity(
+		activities.FetchTracksInformationWithPolicyActivity,
+		mock.Anything,
+		fetchTracksInformationArgsForInitiator(tracksIDsToAdd, params.RoomCreatorUserID, roomCreatorDeviceID),
+	).Return(activities.FetchedTracksInformationWithInitiator{
+		Metadata:       []shared.TrackMetadata{},
+		RejectedTracks: rejectedTracks,
+		UserID:         params.RoomCreatorUserID,
+		DeviceID:       roomCreatorDeviceID,
+	}, nil).Once()
+
+	s.env.OnActivity(
+		a.RejectAddingTracksActivity,
+		mock.Anything,
+		activities_mpe.RejectAddingTracksActivityArgs{
+			RoomID:         params.RoomID,
+			UserID:         params.RoomCreatorUserID,
+			DeviceID:       roomCreatorDeviceID,
+			RejectedTracks: rejectedTracks,
+		},
+	).Return(nil).Once()
+
+	addTrack := tick * 200
+	registerDelayedCallbackWrapper(func() {
+		s.emitAddTrackSignal(shared_mpe.NewAddTracksSignalArgs{
+			TracksIDs: tracksIDsToAdd,
+			UserID:    params.RoomCreatorUserID,
+			DeviceID:  roomCreatorDeviceID,
+		})
+	}, addTrack)
+
+	checkAddingTracks := tick * 200
+	registerDelayedCallbackWrapper(func() {
+		mpeState := s.getMpeState(shared_mpe.NoRelatedUserID)
+
+		s.Equal(initialTracksMetadata, mpeState.Tracks)
+	}, checkAddingTracks)
+
+	s.env.ExecuteWorkflow(MpeRoomWorkflow, params)
+
+	s.True(s.env.IsWorkflowCompleted())
+	err := s.env.GetWorkflowError()
+	s.ErrorIs(err, workflow.ErrDeadlineExceeded, "The workflow ran on an infinite loop")
+}
+
+func (s *AddTracksTestSuite) Test_AddingTracksFetchingFailureRejectsTracks() {
+	initialTracksIDs := []string{
+		faker.UUIDHyphenated(),
+	}
+	params, roomCreatorDeviceID := s.getWorkflowInitParams(initialTracksIDs)
+
+	var a *activities_mpe.Activities
+
+	initialTracksMetadata := []shared.TrackMetadata{
+		{
+			ID:         initialTracksIDs[0],
+			Title:      faker.Word(),
+			ArtistName: faker.Name(),
+			Duration:   random.GenerateRandomDuration(),
+		},
+	}
+	tracksIDsToAdd := []string{
+		faker.UUIDHyphenated(),
+	}
+
+	tick := 1 * time.Millisecond
+	resetMock, registerDelayedCallbackWrapper := s.initTestEnv()
+
+	defer resetMock()
+
+	// Common activities calls
+	s.env.OnActivity(
+		a.MpeCreationAcknowledgementActivity,
+		mock.Anything,
+		mock.Anything,
+	).Return(nil).Once()
+	s.env.OnActivity(
+		activities.FetchTracksInformationWithPolicyActivity,
+		mock.Anything,
+		fetchTracksInformationArgs(initialTracksIDs),
+	).Return(activities.FetchedTracksInformationWithInitiator{Metadata: initialTracksMetadata}, nil).Once()
+
+	// Specific activities calls
+	s.env.OnActivity(
+		activities.FetchTracksInformationWithPolicyActivity,
+		mock.Anything,
+		fetchTracksInformationArgsForInitiator(tracksIDsToAdd, params.RoomCreatorUserID, roomCreatorDeviceID),
+	).Return(
+		activities.FetchedTracksInformationWithInitiator{},
+		temporal.NewApplicationError("quota exceeded", activities.QuotaExceededErrorType, time.Now().Add(time.Hour)),
+	).Once()
+
+	s.env.OnActivity(
+		a.RejectAddingTracksActivity,
+		mock.Anything,
+		activities_mpe.RejectAddingTracksActivityArgs{
+			RoomID:   params.RoomID,
+			UserID:   params.RoomCreatorUserID,
+			DeviceID: roomCreatorDeviceID,
+			RejectedTracks: []shared.RejectedTrack{
+				{
+					ID:     tracksIDsToAdd[0],
+					Reason: shared.RejectedTrackReasonQuotaExceeded,
+				},
+			},
+		},
+	).Return(nil).Once()
+
+	addTrack := tick * 200
+	registerDelayedCallbackWrapper(func() {
+		s.emitAddTrackSignal(shared_mpe.NewAddTracksSignalArgs{
+			TracksIDs: tracksIDsToAdd,
+			UserID:    params.RoomCreatorUserID,
+			DeviceID:  roomCreatorDeviceID,
+		})
+	}, addTrack)
+
+	checkAddingTracks := tick * 200
+	registerDelayedCallbackWrapper(func() {
+		mpeState := s.getMpeState(shared_mpe.NoRelatedUserID)
+
+		s.Equal(initialTracksMetadata, mpeState.Tracks)
+	}, checkAddingTracks)
+
+	s.env.ExecuteWorkflow(MpeRoomWorkflow, params)
+
+	s.True(s.env.IsWorkflowCompleted())
+	err := s.env.GetWorkflowError()
+	s.ErrorIs(err, workflow.ErrDeadlineExceeded, "The workflow ran on an infinite loop")
+}
+
+func (s *AddTracksTestSuite) Test_AddTrackByQuery() {
+	initialTracksIDs := []string{
+		faker.UUIDHyphenated(),
+	}
+	params, roomCreatorDeviceID := s.getWorkflowInitParams(initialTracksIDs)
+
+	var a *activities_mpe.Activities
+
+	initialTracksMetadata := []shared.TrackMetadata{
+		{
+			ID:         initialTracksIDs[0],
+			Title:      faker.Word(),
+			ArtistName: faker.Name(),
+			Duration:   random.GenerateRandomDuration(),
+		},
+	}
+	searchedTrack := shared.TrackMetadata{
+		ID:         faker.UUIDHyphenated(),
+		Title:      faker.Word(),
+		ArtistName: faker.Name(),
+		Duration:   random.GenerateRandomDuration(),
+	}
+	query := searchedTrack.ArtistName + " " + searchedTrack.Title
+
+	tick := 1 * time.Millisecond
+	resetMock, registerDelayedCallbackWrapper := s.initTestEnv()
+
+	defer resetMock()
+
+	// Common activities calls
+	s.env.OnActivity(
+		a.MpeCreationAcknowledgementActivity,
+		mock.Anything,
+		mock.Anything,
+	).Return(nil).Once()
+	s.env.OnActivity(
+		activities.FetchTracksInformationWithPolicyActivity,
+		mock.Anything,
+		fetchTracksInformationArgs(initialTracksIDs),
+	).Return(activities.FetchedTracksInformationWithInitiator{Metadata: initialTracksMetadata}, nil).Once()
+
+	// Specific activities calls
+	s.env.OnActivity(
+		activities.SearchTopTrackActivityAndForwardInitiator,
+		mock.Anything,
+		query,
+		params.RoomCreatorUserID,
+		roomCreatorDeviceID,
+		mock.Anything,
+	).Return(activities.FetchedTracksInformationWithInitiator{
+		Metadata:       []shared.TrackMetadata{searchedTrack},
+		RejectedTracks: []shared.RejectedTrack{},
+		UserID:         params.RoomCreatorUserID,
+		DeviceID:       roomCreatorDeviceID,
+	}, nil).Once()
+
+	s.env.OnActivity(
+		a.AcknowledgeAddingTracksActivity,
+		mock.Anything,
+		mock.Anything,
+	).Return(nil).Once()
+
+	addTrack := tick * 200
+	registerDelayedCallbackWrapper(func() {
+		s.emitAddTrackSignal(shared_mpe.NewAddTracksSignalArgs{
+			Query:    query,
+			UserID:   params.RoomCreatorUserID,
+			DeviceID: roomCreatorDeviceID,
+		})
+	}, addTrack)
+
+	checkAddingTracks := tick * 200
+	registerDelayedCallbackWrapper(func() {
+		mpeState := s.getMpeState(shared_mpe.NoRelatedUserID)
+
+		s.Equal(append(initialTracksMetadata, searchedTrack), mpeState.Tracks)
+	}, checkAddingTracks)
+
+	s.env.ExecuteWorkflow(MpeRoomWorkflow, params)
+
+	s.True(s.env.IsWorkflowCompleted())
+	err := s.env.GetWorkflowError()
+	s.ErrorIs(err, workflow.ErrDeadlineExceeded, "The workflow ran on an infinite loop")
+}
+
 func TestAddTracksTestSuite(t *testing.T) {
 	suite.Run(t, new(AddTracksTestSuite))
 }
@@ -65,10 +65,10 @@ func (s *ChangeTrackOrderPlaylistTestSuite) Test_ChangeTrackOrderDownAndUp() {
 		mock.Anything,
 	).Return(nil).Once()
 	s.env.OnActivity(
-		activities.FetchTracksInformationActivity,
+		activities.FetchTracksInformationWithPolicyActivity,
 		mock.Anything,
-		initialTracksIDs,
-	).Return(initialTracksMetadata, nil).Once()
+		fetchTracksInformationArgs(initialTracksIDs),
+	).Return(activities.FetchedTracksInformationWithInitiator{Metadata: initialTracksMetadata}, nil).Once()
 
 	// Specific activities calls
 	s.env.OnActivity(
@@ -194,10 +194,10 @@ func (s *ChangeTrackOrderPlaylistTestSuite) Test_ChangeTrackOrderDownFailWithInd
 		mock.Anything,
 	).Return(nil).Once()
 	s.env.OnActivity(
-		activities.FetchTracksInformationActivity,
+		activities.FetchTracksInformationWithPolicyActivity,
 		mock.Anything,
-		initialTracksIDs,
-	).Return(initialTracksMetadata, nil).Once()
+		fetchTracksInformationArgs(initialTracksIDs),
+	).Return(activities.FetchedTracksInformationWithInitiator{Metadata: initialTracksMetadata}, nil).Once()
 
 	// Specific activities calls
 	s.env.OnActivity(
@@ -312,10 +312,10 @@ func (s *ChangeTrackOrderPlaylistTestSuite) Test_ChangeTrackOrderUnkownOperation
 		mock.Anything,
 	).Return(nil).Once()
 	s.env.OnActivity(
-		activities.FetchTracksInformationActivity,
+		activities.FetchTracksInformationWithPolicyActivity,
 		mock.Anything,
-		initialTracksIDs,
-	).Return(initialTracksMetadata, nil).Once()
+		fetchTracksInformationArgs(initialTracksIDs),
+	).Return(activities.FetchedTracksInformationWithInitiator{Metadata: initialTracksMetadata}, nil).Once()
 
 	// Specific activities calls
 	s.env.OnActivity(
@@ -411,10 +411,10 @@ func (s *ChangeTrackOrderPlaylistTestSuite) Test_ChangeTrackOrderUnkownTrack() {
 		mock.Anything,
 	).Return(nil).Once()
 	s.env.OnActivity(
-		activities.FetchTracksInformationActivity,
+		activities.FetchTracksInformationWithPolicyActivity,
 		mock.Anything,
-		initialTracksIDs,
-	).Return(initialTracksMetadata, nil).Once()
+		fetchTracksInformationArgs(initialTracksIDs),
+	).Return(activities.FetchedTracksInformationWithInitiator{Metadata: initialTracksMetadata}, nil).Once()
 
 	// Specific activities calls
 	s.env.OnActivity(
@@ -508,10 +508,10 @@ func (s *ChangeTrackOrderPlaylistTestSuite) Test_ChangeTrackOrderTrackIndexIsOut
 		mock.Anything,
 	).Return(nil).Once()
 	s.env.OnActivity(
-		activities.FetchTracksInformationActivity,
+		activities.FetchTracksInformationWithPolicyActivity,
 		mock.Anything,
-		initialTracksIDs,
-	).Return(initialTracksMetadata, nil).Once()
+		fetchTracksInformationArgs(initialTracksIDs),
+	).Return(activities.FetchedTracksInformationWithInitiator{Metadata: initialTracksMetadata}, nil).Once()
 
 	// Specific activities calls
 	s.env.OnActivity(
@@ -614,10 +614,10 @@ func (s *ChangeTrackOrderPlaylistTestSuite) Test_ChangeTrackOrderTrackIndexOnlyI
 		mock.Anything,
 	).Return(nil).Once()
 	s.env.OnActivity(
-		activities.FetchTracksInformationActivity,
+		activities.FetchTracksInformationWithPolicyActivity,
 		mock.Anything,
-		initialTracksIDs,
-	).Return(initialTracksMetadata, nil).Once()
+		fetchTracksInformationArgs(initialTracksIDs),
+	).Return(activities.FetchedTracksInformationWithInitiator{Metadata: initialTracksMetadata}, nil).Once()
 
 	// Specific activities calls
 	s.env.OnActivity(
@@ -796,10 +796,10 @@ func (s *ChangeTrackOrderPlaylistTestSuite) Test_ChangeTrackOrderTrackUnknownUse
 		mock.Anything,
 	).Return(nil).Once()
 	s.env.OnActivity(
-		activities.FetchTracksInformationActivity,
+		activities.FetchTracksInformationWithPolicyActivity,
 		mock.Anything,
-		initialTracksIDs,
-	).Return(initialTracksMetadata, nil).Once()
+		fetchTracksInformationArgs(initialTracksIDs),
+	).Return(activities.FetchedTracksInformationWithInitiator{Metadata: initialTracksMetadata}, nil).Once()
 
 	// Specific activities calls
 	s.env.OnActivity(
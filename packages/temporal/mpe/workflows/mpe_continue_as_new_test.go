@@ -0,0 +1,168 @@
+package mpe
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/AdonisEnProvence/MusicRoom/activities"
+	activities_mpe "github.com/AdonisEnProvence/MusicRoom/mpe/activities"
+	shared_mpe "github.com/AdonisEnProvence/MusicRoom/mpe/shared"
+	"github.com/AdonisEnProvence/MusicRoom/random"
+	"github.com/AdonisEnProvence/MusicRoom/shared"
+	"github.com/bxcodec/faker/v3"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	"go.temporal.io/sdk/converter"
+	"go.temporal.io/sdk/workflow"
+)
+
+type ContinueAsNewMpeWorkflowTestUnit struct {
+	UnitTestSuite
+}
+
+func (s *ContinueAsNewMpeWorkflowTestUnit) Test_ContinuesAsNewAfterEventsThreshold() {
+	initialTracksIDs := []string{
+		faker.UUIDHyphenated(),
+	}
+	joiningUserID := faker.UUIDHyphenated()
+	params, _ := s.getWorkflowInitParams(initialTracksIDs)
+	params.ContinueAsNewEventsThreshold = 2
+	params.IdlePolicy = shared.RoomIdlePolicy{
+		EmptyRoomTimeout:  time.Hour,
+		InactivityTimeout: time.Hour,
+	}
+
+	var a *activities_mpe.Activities
+
+	tracks := []shared.TrackMetadata{
+		{
+			ID:         initialTracksIDs[0],
+			Title:      faker.Word(),
+			ArtistName: faker.Name(),
+			Duration:   random.GenerateRandomDuration(),
+		},
+	}
+
+	defaultDuration := 1 * time.Millisecond
+	resetMock, registerDelayedCallbackWrapper := s.initTestEnv()
+
+	defer resetMock()
+
+	s.env.OnActivity(
+		activities.FetchTracksInformationWithPolicyActivity,
+		mock.Anything,
+		fetchTracksInformationArgs(initialTracksIDs),
+	).Return(activities.FetchedTracksInformationWithInitiator{Metadata: tracks}, nil).Once()
+	s.env.OnActivity(
+		a.MpeCreationAcknowledgementActivity,
+		mock.Anything,
+		mock.Anything,
+	).Return(nil).Once()
+	s.env.OnActivity(
+		a.AcknowledgeJoinActivity,
+		mock.Anything,
+		mock.Anything,
+	).Return(nil).Once()
+
+	addUser := defaultDuration
+	registerDelayedCallbackWrapper(func() {
+		s.emitAddUserSignal(shared_mpe.NewAddUserSignalArgs{
+			UserID:             joiningUserID,
+			UserHasBeenInvited: false,
+		})
+	}, addUser)
+
+	s.env.ExecuteWorkflow(MpeRoomWorkflow, params)
+
+	s.True(s.env.IsWorkflowCompleted())
+	err := s.env.GetWorkflowError()
+	s.True(workflow.IsContinueAsNewError(err))
+
+	var continueAsNewError *workflow.ContinueAsNewError
+	s.True(errors.As(err, &continueAsNewError))
+
+	var nextRunParams shared_mpe.MpeRoomParameters
+	err = converter.GetDefaultDataConverter().FromPayloads(continueAsNewError.Input, &nextRunParams)
+	s.NoError(err)
+
+	snapshot := nextRunParams.Snapshot
+	s.NotNil(snapshot)
+	s.Equal(params.RoomID, nextRunParams.RoomID)
+	s.Len(snapshot.Users, 2)
+	s.Contains(snapshot.Users, joiningUserID)
+	s.Equal(tracks, snapshot.Tracks)
+	s.False(snapshot.IdleClocks.LastActivityAt.IsZero())
+	s.True(snapshot.IdleClocks.EmptySince.IsZero())
+}
+
+func (s *ContinueAsNewMpeWorkflowTestUnit) Test_RestoredFromSnapshotDoesNotFetchInitialTracks() {
+	initialTracksIDs := []string{
+		faker.UUIDHyphenated(),
+	}
+	joinedUserID := faker.UUIDHyphenated()
+	params, _ := s.getWorkflowInitParams(initialTracksIDs)
+
+	tracks := []shared.TrackMetadata{
+		{
+			ID:         initialTracksIDs[0],
+			Title:      faker.Word(),
+			ArtistName: faker.Name(),
+			Duration:   random.GenerateRandomDuration(),
+		},
+		{
+			ID:         faker.UUIDHyphenated(),
+			Title:      faker.Word(),
+			ArtistName: faker.Name(),
+			Duration:   random.GenerateRandomDuration(),
+		},
+	}
+
+	params.Snapshot = &shared_mpe.MpeRoomStateSnapshot{
+		Users: map[string]*shared_mpe.InternalStateUser{
+			params.RoomCreatorUserID: params.CreatorUserRelatedInformation,
+			joinedUserID: {
+				UserID:             joinedUserID,
+				UserHasBeenInvited: true,
+			},
+		},
+		Tracks: tracks,
+	}
+
+	defaultDuration := 1 * time.Millisecond
+	resetMock, registerDelayedCallbackWrapper := s.initTestEnv()
+
+	defer resetMock()
+
+	checkRestoredState := defaultDuration
+	registerDelayedCallbackWrapper(func() {
+		mpeState := s.getMpeState(joinedUserID)
+
+		expectedExposedMpeState := shared_mpe.MpeRoomExposedState{
+			IsOpen:                        params.IsOpen,
+			IsOpenOnlyInvitedUsersCanEdit: params.IsOpenOnlyInvitedUsersCanEdit,
+			RoomCreatorUserID:             params.RoomCreatorUserID,
+			RoomID:                        params.RoomID,
+			RoomName:                      params.RoomName,
+			UsersLength:                   2,
+			Tracks:                        tracks,
+			PlaylistTotalDuration:         tracks[0].Duration.Milliseconds() + tracks[1].Duration.Milliseconds(),
+			UserRelatedInformation: &shared_mpe.InternalStateUser{
+				UserID:             joinedUserID,
+				UserHasBeenInvited: true,
+			},
+		}
+
+		s.Equal(expectedExposedMpeState, mpeState)
+	}, checkRestoredState)
+
+	s.env.ExecuteWorkflow(MpeRoomWorkflow, params)
+
+	s.True(s.env.IsWorkflowCompleted())
+	err := s.env.GetWorkflowError()
+	s.ErrorIs(err, workflow.ErrDeadlineExceeded, "The workflow ran on an infinite loop")
+}
+
+func TestContinueAsNewUnitTestSuite(t *testing.T) {
+	suite.Run(t, new(ContinueAsNewMpeWorkflowTestUnit))
+}
@@ -61,10 +61,10 @@ func (s *DeleteTracksTestSuite) Test_DeleteTracksAndSendAcknowledgement() {
 		mock.Anything,
 	).Return(nil).Once()
 	s.env.OnActivity(
-		activities.FetchTracksInformationActivity,
+		activities.FetchTracksInformationWithPolicyActivity,
 		mock.Anything,
-		initialTracksIDs,
-	).Return(initialTracksMetadata, nil).Once()
+		fetchTracksInformationArgs(initialTracksIDs),
+	).Return(activities.FetchedTracksInformationWithInitiator{Metadata: initialTracksMetadata}, nil).Once()
 
 	// Specific activities calls
 	s.env.OnActivity(
@@ -144,10 +144,10 @@ func (s *DeleteTracksTestSuite) Test_PreventUnknownUserFromDeletingTracks() {
 		mock.Anything,
 	).Return(nil).Once()
 	s.env.OnActivity(
-		activities.FetchTracksInformationActivity,
+		activities.FetchTracksInformationWithPolicyActivity,
 		mock.Anything,
-		initialTracksIDs,
-	).Return(initialTracksMetadata, nil).Once()
+		fetchTracksInformationArgs(initialTracksIDs),
+	).Return(activities.FetchedTracksInformationWithInitiator{Metadata: initialTracksMetadata}, nil).Once()
 
 	// Specific activities calls
 	s.env.OnActivity(
@@ -226,10 +226,10 @@ func (s *DeleteTracksTestSuite) Test_AcknowledgeDeletionEvenWhenNoTracksWereDele
 		mock.Anything,
 	).Return(nil).Once()
 	s.env.OnActivity(
-		activities.FetchTracksInformationActivity,
+		activities.FetchTracksInformationWithPolicyActivity,
 		mock.Anything,
-		initialTracksIDs,
-	).Return(initialTracksMetadata, nil).Once()
+		fetchTracksInformationArgs(initialTracksIDs),
+	).Return(activities.FetchedTracksInformationWithInitiator{Metadata: initialTracksMetadata}, nil).Once()
 
 	// Specific activities calls
 	s.env.OnActivity(
@@ -311,10 +311,10 @@ func (s *DeleteTracksTestSuite) Test_InRoomWithConstraintsCreatorCanDeleteTracks
 		mock.Anything,
 	).Return(nil).Once()
 	s.env.OnActivity(
-		activities.FetchTracksInformationActivity,
+		activities.FetchTracksInformationWithPolicyActivity,
 		mock.Anything,
-		initialTracksIDs,
-	).Return(initialTracksMetadata, nil).Once()
+		fetchTracksInformationArgs(initialTracksIDs),
+	).Return(activities.FetchedTracksInformationWithInitiator{Metadata: initialTracksMetadata}, nil).Once()
 
 	// Specific activities calls
 	s.env.OnActivity(
@@ -413,10 +413,10 @@ func (s *DeleteTracksTestSuite) Test_InRoomWithConstraintsOnlyInvitedUsersCanDel
 		mock.Anything,
 	).Return(nil).Once()
 	s.env.OnActivity(
-		activities.FetchTracksInformationActivity,
+		activities.FetchTracksInformationWithPolicyActivity,
 		mock.Anything,
-		initialTracksIDs,
-	).Return(initialTracksMetadata, nil).Once()
+		fetchTracksInformationArgs(initialTracksIDs),
+	).Return(activities.FetchedTracksInformationWithInitiator{Metadata: initialTracksMetadata}, nil).Once()
 
 	// Specific activities calls
 	s.env.OnActivity(
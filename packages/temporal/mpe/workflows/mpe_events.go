@@ -11,14 +11,17 @@ type MpeRoomInitialTrackFetchedEvent struct {
 	brainy.EventWithType
 
 	Tracks []shared.TrackMetadata
+	// Initial tracks that could not be fetched or that can not be played in the room
+	RejectedTracks []shared.RejectedTrack
 }
 
-func NewMpeRoomInitialTracksFetchedEvent(tracks []shared.TrackMetadata) MpeRoomInitialTrackFetchedEvent {
+func NewMpeRoomInitialTracksFetchedEvent(tracks []shared.TrackMetadata, rejectedTracks []shared.RejectedTrack) MpeRoomInitialTrackFetchedEvent {
 	return MpeRoomInitialTrackFetchedEvent{
 		EventWithType: brainy.EventWithType{
 			Event: MpeRoomInitialTracksFetched,
 		},
-		Tracks: tracks,
+		Tracks:         tracks,
+		RejectedTracks: rejectedTracks,
 	}
 }
 
@@ -26,12 +29,14 @@ type MpeRoomAddTracksEvent struct {
 	brainy.EventWithType
 
 	TracksIDs []string
+	Query     string
 	UserID    string
 	DeviceID  string
 }
 
 type NewMpeRoomAddTracksEventArgs struct {
 	TracksIDs []string
+	Query     string
 	UserID    string
 	DeviceID  string
 }
@@ -43,6 +48,7 @@ func NewMpeRoomAddTracksEvent(args NewMpeRoomAddTracksEventArgs) MpeRoomAddTrack
 		},
 
 		TracksIDs: args.TracksIDs,
+		Query:     args.Query,
 		UserID:    args.UserID,
 		DeviceID:  args.DeviceID,
 	}
@@ -52,12 +58,14 @@ type MpeRoomAddedTracksInformationFetchedEvent struct {
 	brainy.EventWithType
 
 	AddedTracksInformation []shared.TrackMetadata
+	RejectedTracks         []shared.RejectedTrack
 	UserID                 string
 	DeviceID               string
 }
 
 type NewMpeRoomAddedTracksInformationFetchedEventArgs struct {
 	AddedTracksInformation []shared.TrackMetadata
+	RejectedTracks         []shared.RejectedTrack
 	UserID                 string
 	DeviceID               string
 }
@@ -69,6 +77,7 @@ func NewMpeRoomAddedTracksInformationFetchedEvent(args NewMpeRoomAddedTracksInfo
 		},
 
 		AddedTracksInformation: args.AddedTracksInformation,
+		RejectedTracks:         args.RejectedTracks,
 		UserID:                 args.UserID,
 		DeviceID:               args.DeviceID,
 	}
@@ -53,10 +53,10 @@ func (s *MpeExportToMtvTestUnit) Test_CreatorExportsMpeToMtv() {
 	defer resetMock()
 
 	s.env.OnActivity(
-		activities.FetchTracksInformationActivity,
+		activities.FetchTracksInformationWithPolicyActivity,
 		mock.Anything,
-		initialTracksIDs,
-	).Return(tracks, nil).Once()
+		fetchTracksInformationArgs(initialTracksIDs),
+	).Return(activities.FetchedTracksInformationWithInitiator{Metadata: tracks}, nil).Once()
 	s.env.OnActivity(
 		a.MpeCreationAcknowledgementActivity,
 		mock.Anything,
@@ -113,10 +113,10 @@ func (s *MpeExportToMtvTestUnit) Test_UserInRoomExportsMpeToMtv() {
 	defer resetMock()
 
 	s.env.OnActivity(
-		activities.FetchTracksInformationActivity,
+		activities.FetchTracksInformationWithPolicyActivity,
 		mock.Anything,
-		initialTracksIDs,
-	).Return(tracks, nil).Once()
+		fetchTracksInformationArgs(initialTracksIDs),
+	).Return(activities.FetchedTracksInformationWithInitiator{Metadata: tracks}, nil).Once()
 	s.env.OnActivity(
 		a.MpeCreationAcknowledgementActivity,
 		mock.Anything,
@@ -207,10 +207,10 @@ func (s *MpeExportToMtvTestUnit) Test_UserExportsMpeRoomIntoMtvRoomWithConstrain
 	defer resetMock()
 
 	s.env.OnActivity(
-		activities.FetchTracksInformationActivity,
+		activities.FetchTracksInformationWithPolicyActivity,
 		mock.Anything,
-		initialTracksIDs,
-	).Return(tracks, nil).Once()
+		fetchTracksInformationArgs(initialTracksIDs),
+	).Return(activities.FetchedTracksInformationWithInitiator{Metadata: tracks}, nil).Once()
 	s.env.OnActivity(
 		a.MpeCreationAcknowledgementActivity,
 		mock.Anything,
@@ -278,10 +278,10 @@ func (s *MpeExportToMtvTestUnit) Test_UserNotInRoomCanNotExportMpeToMtv() {
 	defer resetMock()
 
 	s.env.OnActivity(
-		activities.FetchTracksInformationActivity,
+		activities.FetchTracksInformationWithPolicyActivity,
 		mock.Anything,
-		initialTracksIDs,
-	).Return(tracks, nil).Once()
+		fetchTracksInformationArgs(initialTracksIDs),
+	).Return(activities.FetchedTracksInformationWithInitiator{Metadata: tracks}, nil).Once()
 	s.env.OnActivity(
 		a.MpeCreationAcknowledgementActivity,
 		mock.Anything,
@@ -0,0 +1,317 @@
+package mpe
+
+import (
+	"testing"
+	"time"
+
+	"github.com/AdonisEnProvence/MusicRoom/activities"
+	activities_mpe "github.com/AdonisEnProvence/MusicRoom/mpe/activities"
+	shared_mpe "github.com/AdonisEnProvence/MusicRoom/mpe/shared"
+	"github.com/AdonisEnProvence/MusicRoom/random"
+	"github.com/AdonisEnProvence/MusicRoom/shared"
+	"github.com/bxcodec/faker/v3"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	"go.temporal.io/sdk/workflow"
+)
+
+type IdleTimeoutMpeWorkflowTestUnit struct {
+	UnitTestSuite
+}
+
+func (s *IdleTimeoutMpeWorkflowTestUnit) Test_ExpiresAfterInactivityTimeout() {
+	var a *activities_mpe.Activities
+
+	initialTracksIDs := []string{
+		faker.UUIDHyphenated(),
+	}
+	joiningUserID := faker.UUIDHyphenated()
+	inactivityTimeout := 30 * time.Minute
+	params, _ := s.getWorkflowInitParams(initialTracksIDs)
+	params.IdlePolicy = shared.RoomIdlePolicy{
+		InactivityTimeout: inactivityTimeout,
+	}
+
+	tracks := []shared.TrackMetadata{
+		{
+			ID:         initialTracksIDs[0],
+			Title:      faker.Word(),
+			ArtistName: faker.Name(),
+			Duration:   random.GenerateRandomDuration(),
+		},
+	}
+
+	resetMock, registerDelayedCallbackWrapper := s.initTestEnv()
+
+	defer resetMock()
+
+	s.env.OnActivity(
+		activities.FetchTracksInformationWithPolicyActivity,
+		mock.Anything,
+		fetchTracksInformationArgs(initialTracksIDs),
+	).Return(activities.FetchedTracksInformationWithInitiator{Metadata: tracks}, nil).Once()
+	s.env.OnActivity(
+		a.MpeCreationAcknowledgementActivity,
+		mock.Anything,
+		mock.Anything,
+	).Return(nil).Once()
+	s.env.OnActivity(
+		a.AcknowledgeJoinActivity,
+		mock.Anything,
+		mock.Anything,
+	).Return(nil).Once()
+	s.env.OnActivity(
+		a.RoomExpiredActivity,
+		mock.Anything,
+		activities_mpe.RoomExpiredActivityArgs{
+			RoomID: params.RoomID,
+			Reason: shared.RoomExpirationReasonInactive,
+		},
+	).Return(nil).Once()
+
+	// Receiving a signal restarts the inactivity timer.
+	addUser := inactivityTimeout - time.Minute
+	registerDelayedCallbackWrapper(func() {
+		s.emitAddUserSignal(shared_mpe.NewAddUserSignalArgs{
+			UserID:             joiningUserID,
+			UserHasBeenInvited: false,
+		})
+	}, addUser)
+
+	checkRoomHasNotExpired := 2 * time.Minute
+	registerDelayedCallbackWrapper(func() {
+		s.False(s.env.IsWorkflowCompleted())
+
+		mpeState := s.getMpeState(shared_mpe.NoRelatedUserID)
+		s.Equal(2, mpeState.UsersLength)
+	}, checkRoomHasNotExpired)
+
+	s.env.ExecuteWorkflow(MpeRoomWorkflow, params)
+
+	s.True(s.env.IsWorkflowCompleted())
+	s.NoError(s.env.GetWorkflowError())
+}
+
+func (s *IdleTimeoutMpeWorkflowTestUnit) Test_ExpiresInactivityTimeoutAfterLastSignal() {
+	var a *activities_mpe.Activities
+
+	initialTracksIDs := []string{
+		faker.UUIDHyphenated(),
+	}
+	inactivityTimeout := 30 * time.Minute
+	params, _ := s.getWorkflowInitParams(initialTracksIDs)
+	params.IdlePolicy = shared.RoomIdlePolicy{
+		InactivityTimeout: inactivityTimeout,
+	}
+
+	tracks := []shared.TrackMetadata{
+		{
+			ID:         initialTracksIDs[0],
+			Title:      faker.Word(),
+			ArtistName: faker.Name(),
+			Duration:   random.GenerateRandomDuration(),
+		},
+	}
+
+	resetMock, registerDelayedCallbackWrapper := s.initTestEnv()
+
+	defer resetMock()
+
+	s.env.OnActivity(
+		activities.FetchTracksInformationWithPolicyActivity,
+		mock.Anything,
+		fetchTracksInformationArgs(initialTracksIDs),
+	).Return(activities.FetchedTracksInformationWithInitiator{Metadata: tracks}, nil).Once()
+	s.env.OnActivity(
+		a.MpeCreationAcknowledgementActivity,
+		mock.Anything,
+		mock.Anything,
+	).Return(nil).Once()
+	s.env.OnActivity(
+		a.AcknowledgeJoinActivity,
+		mock.Anything,
+		mock.Anything,
+	).Return(nil).Twice()
+	s.env.OnActivity(
+		a.RoomExpiredActivity,
+		mock.Anything,
+		activities_mpe.RoomExpiredActivityArgs{
+			RoomID: params.RoomID,
+			Reason: shared.RoomExpirationReasonInactive,
+		},
+	).Return(nil).Once()
+
+	firstUserJoins := 20 * time.Minute
+	registerDelayedCallbackWrapper(func() {
+		s.emitAddUserSignal(shared_mpe.NewAddUserSignalArgs{
+			UserID:             faker.UUIDHyphenated(),
+			UserHasBeenInvited: false,
+		})
+	}, firstUserJoins)
+
+	secondUserJoins := 20 * time.Minute
+	registerDelayedCallbackWrapper(func() {
+		s.emitAddUserSignal(shared_mpe.NewAddUserSignalArgs{
+			UserID:             faker.UUIDHyphenated(),
+			UserHasBeenInvited: false,
+		})
+	}, secondUserJoins)
+
+	checkRoomHasNotExpired := inactivityTimeout - 5*time.Minute
+	registerDelayedCallbackWrapper(func() {
+		s.False(s.env.IsWorkflowCompleted())
+
+		mpeState := s.getMpeState(shared_mpe.NoRelatedUserID)
+		s.Equal(3, mpeState.UsersLength)
+	}, checkRoomHasNotExpired)
+
+	s.env.ExecuteWorkflow(MpeRoomWorkflow, params)
+
+	s.True(s.env.IsWorkflowCompleted())
+	s.NoError(s.env.GetWorkflowError())
+}
+
+func (s *IdleTimeoutMpeWorkflowTestUnit) Test_DoesNotExpireWithoutIdlePolicy() {
+	var a *activities_mpe.Activities
+
+	initialTracksIDs := []string{
+		faker.UUIDHyphenated(),
+	}
+	params, _ := s.getWorkflowInitParams(initialTracksIDs)
+
+	tracks := []shared.TrackMetadata{
+		{
+			ID:         initialTracksIDs[0],
+			Title:      faker.Word(),
+			ArtistName: faker.Name(),
+			Duration:   random.GenerateRandomDuration(),
+		},
+	}
+
+	resetMock, registerDelayedCallbackWrapper := s.initTestEnv()
+
+	defer resetMock()
+
+	s.env.OnActivity(
+		activities.FetchTracksInformationWithPolicyActivity,
+		mock.Anything,
+		fetchTracksInformationArgs(initialTracksIDs),
+	).Return(activities.FetchedTracksInformationWithInitiator{Metadata: tracks}, nil).Once()
+	s.env.OnActivity(
+		a.MpeCreationAcknowledgementActivity,
+		mock.Anything,
+		mock.Anything,
+	).Return(nil).Once()
+	s.env.OnActivity(
+		a.AcknowledgeLeaveActivity,
+		mock.Anything,
+		mock.Anything,
+	).Return(nil).Once()
+
+	creatorLeaves := time.Millisecond
+	registerDelayedCallbackWrapper(func() {
+		s.emitRemoveUserSignal(shared_mpe.NewRemoveUserSignalArgs{
+			UserID: params.RoomCreatorUserID,
+		})
+	}, creatorLeaves)
+
+	checkRoomHasNotExpired := 30 * 24 * time.Hour
+	registerDelayedCallbackWrapper(func() {
+		s.False(s.env.IsWorkflowCompleted())
+
+		mpeState := s.getMpeState(shared_mpe.NoRelatedUserID)
+		s.Equal(0, mpeState.UsersLength)
+	}, checkRoomHasNotExpired)
+
+	s.env.ExecuteWorkflow(MpeRoomWorkflow, params)
+
+	s.True(s.env.IsWorkflowCompleted())
+	err := s.env.GetWorkflowError()
+	s.ErrorIs(err, workflow.ErrDeadlineExceeded, "The workflow ran on an infinite loop")
+}
+
+func (s *IdleTimeoutMpeWorkflowTestUnit) Test_RestoredRoomExpiresAfterRemainingInactivityTimeout() {
+	var a *activities_mpe.Activities
+
+	inactivityTimeout := 30 * time.Minute
+	inactiveBeforeContinueAsNew := 20 * time.Minute
+	params, _ := s.getWorkflowInitParams([]string{faker.UUIDHyphenated()})
+	params.IdlePolicy = shared.RoomIdlePolicy{
+		InactivityTimeout: inactivityTimeout,
+	}
+
+	startTime := time.Now()
+	s.env.SetStartTime(startTime)
+	params.Snapshot = &shared_mpe.MpeRoomStateSnapshot{
+		Users: map[string]*shared_mpe.InternalStateUser{
+			params.RoomCreatorUserID: params.CreatorUserRelatedInformation,
+		},
+		Tracks: []shared.TrackMetadata{},
+		IdleClocks: shared.RoomIdleClocks{
+			LastActivityAt: startTime.Add(-inactiveBeforeContinueAsNew),
+		},
+	}
+
+	resetMock, _ := s.initTestEnv()
+
+	defer resetMock()
+
+	s.env.OnActivity(
+		a.RoomExpiredActivity,
+		mock.Anything,
+		activities_mpe.RoomExpiredActivityArgs{
+			RoomID: params.RoomID,
+			Reason: shared.RoomExpirationReasonInactive,
+		},
+	).Return(nil).Once()
+
+	s.env.ExecuteWorkflow(MpeRoomWorkflow, params)
+
+	s.True(s.env.IsWorkflowCompleted())
+	s.NoError(s.env.GetWorkflowError())
+	s.Equal(inactivityTimeout-inactiveBeforeContinueAsNew, s.env.Now().Sub(startTime))
+}
+
+func (s *IdleTimeoutMpeWorkflowTestUnit) Test_RestoredEmptyRoomExpiresAfterRemainingEmptyRoomTimeout() {
+	var a *activities_mpe.Activities
+
+	emptyRoomTimeout := 30 * time.Minute
+	emptyBeforeContinueAsNew := 20 * time.Minute
+	params, _ := s.getWorkflowInitParams([]string{faker.UUIDHyphenated()})
+	params.IdlePolicy = shared.RoomIdlePolicy{
+		EmptyRoomTimeout: emptyRoomTimeout,
+	}
+
+	startTime := time.Now()
+	s.env.SetStartTime(startTime)
+	params.Snapshot = &shared_mpe.MpeRoomStateSnapshot{
+		Users:  map[string]*shared_mpe.InternalStateUser{},
+		Tracks: []shared.TrackMetadata{},
+		IdleClocks: shared.RoomIdleClocks{
+			EmptySince: startTime.Add(-emptyBeforeContinueAsNew),
+		},
+	}
+
+	resetMock, _ := s.initTestEnv()
+
+	defer resetMock()
+
+	s.env.OnActivity(
+		a.RoomExpiredActivity,
+		mock.Anything,
+		activities_mpe.RoomExpiredActivityArgs{
+			RoomID: params.RoomID,
+			Reason: shared.RoomExpirationReasonEmpty,
+		},
+	).Return(nil).Once()
+
+	s.env.ExecuteWorkflow(MpeRoomWorkflow, params)
+
+	s.True(s.env.IsWorkflowCompleted())
+	s.NoError(s.env.GetWorkflowError())
+	s.Equal(emptyRoomTimeout-emptyBeforeContinueAsNew, s.env.Now().Sub(startTime))
+}
+
+func TestIdleTimeoutUnitTestSuite(t *testing.T) {
+	suite.Run(t, new(IdleTimeoutMpeWorkflowTestUnit))
+}
@@ -45,10 +45,10 @@ func (s *RemoveUserMpeWorkflowTestUnit) Test_RemoveUser() {
 	defer resetMock()
 
 	s.env.OnActivity(
-		activities.FetchTracksInformationActivity,
+		activities.FetchTracksInformationWithPolicyActivity,
 		mock.Anything,
-		initialTracksIDs,
-	).Return(tracks, nil).Once()
+		fetchTracksInformationArgs(initialTracksIDs),
+	).Return(activities.FetchedTracksInformationWithInitiator{Metadata: tracks}, nil).Once()
 	s.env.OnActivity(
 		a.MpeCreationAcknowledgementActivity,
 		mock.Anything,
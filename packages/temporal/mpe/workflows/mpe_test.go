@@ -52,10 +52,10 @@ func (s *CreateMpeWorkflowTestUnit) Test_CreateMpeWorkflow() {
 	defer resetMock()
 
 	s.env.OnActivity(
-		activities.FetchTracksInformationActivity,
+		activities.FetchTracksInformationWithPolicyActivity,
 		mock.Anything,
-		initialTracksIDs,
-	).Return(tracks, nil).Once()
+		fetchTracksInformationArgs(initialTracksIDs),
+	).Return(activities.FetchedTracksInformationWithInitiator{Metadata: tracks}, nil).Once()
 	s.env.OnActivity(
 		a.MpeCreationAcknowledgementActivity,
 		mock.Anything,
@@ -88,6 +88,132 @@ func (s *CreateMpeWorkflowTestUnit) Test_CreateMpeWorkflow() {
 	s.ErrorIs(err, workflow.ErrDeadlineExceeded, "The workflow ran on an infinite loop")
 }
 
+func (s *CreateMpeWorkflowTestUnit) Test_CreateMpeWorkflowAcknowledgesRejectedInitialTracks() {
+	playableTrack := shared.TrackMetadata{
+		ID:         faker.UUIDHyphenated(),
+		Title:      faker.Word(),
+		ArtistName: faker.Name(),
+		Duration:   random.GenerateRandomDuration(),
+	}
+	rejectedTracks := []shared.RejectedTrack{
+		{
+			ID:     faker.UUIDHyphenated(),
+			Reason: shared.RejectedTrackReasonLiveBroadcast,
+		},
+	}
+	initialTracksIDs := []string{playableTrack.ID, rejectedTracks[0].ID}
+	params, _ := s.getWorkflowInitParams(initialTracksIDs)
+	params.PlayabilityPolicy = shared.TrackPlayabilityPolicy{
+		AllowNotEmbeddable: true,
+	}
+	var a *activities_mpe.Activities
+
+	defaultDuration := 1 * time.Millisecond
+	resetMock, registerDelayedCallbackWrapper := s.initTestEnv()
+
+	defer resetMock()
+
+	s.env.OnActivity(
+		activities.FetchTracksInformationWithPolicyActivity,
+		mock.Anything,
+		activities.FetchTracksInformationArgs{
+			TracksIDs: initialTracksIDs,
+			UserID:    params.RoomCreatorUserID,
+			Policy:    params.PlayabilityPolicy,
+		},
+	).Return(activities.FetchedTracksInformationWithInitiator{
+		Metadata:       []shared.TrackMetadata{playableTrack},
+		RejectedTracks: rejectedTracks,
+		UserID:         params.RoomCreatorUserID,
+	}, nil).Once()
+	s.env.OnActivity(
+		a.MpeCreationAcknowledgementActivity,
+		mock.Anything,
+		mock.MatchedBy(func(args activities_mpe.MpeCreationAcknowledgementArgs) bool {
+			return args.RoomID == params.RoomID &&
+				len(args.Tracks) == 1 &&
+				len(args.RejectedTracks) == 1 &&
+				args.RejectedTracks[0] == rejectedTracks[0]
+		}),
+	).Return(nil).Once()
+
+	checkOnlyPlayableTrackIsKept := defaultDuration
+	registerDelayedCallbackWrapper(func() {
+		mpeState := s.getMpeState(shared_mpe.NoRelatedUserID)
+
+		s.Equal([]shared.TrackMetadata{playableTrack}, mpeState.Tracks)
+	}, checkOnlyPlayableTrackIsKept)
+
+	s.env.ExecuteWorkflow(MpeRoomWorkflow, params)
+
+	s.True(s.env.IsWorkflowCompleted())
+	err := s.env.GetWorkflowError()
+	s.ErrorIs(err, workflow.ErrDeadlineExceeded, "The workflow ran on an infinite loop")
+}
+
+func (s *CreateMpeWorkflowTestUnit) Test_CreateMpeWorkflowRetriesInitialTracksFetchingAfterQuotaReset() {
+	initialTrack := shared.TrackMetadata{
+		ID:         faker.UUIDHyphenated(),
+		Title:      faker.Word(),
+		ArtistName: faker.Name(),
+		Duration:   random.GenerateRandomDuration(),
+	}
+	initialTracksIDs := []string{initialTrack.ID}
+	params, _ := s.getWorkflowInitParams(initialTracksIDs)
+	var a *activities_mpe.Activities
+
+	quotaResetDelay := time.Hour
+	quotaExceededError := temporal.NewApplicationError(
+		"quota exceeded",
+		activities.QuotaExceededErrorType,
+		time.Now().Add(quotaResetDelay),
+	)
+	quotaIsReset := false
+
+	resetMock, registerDelayedCallbackWrapper := s.initTestEnv()
+
+	defer resetMock()
+
+	s.env.OnActivity(
+		activities.FetchTracksInformationWithPolicyActivity,
+		mock.Anything,
+		fetchTracksInformationArgs(initialTracksIDs),
+	).Return(activities.FetchedTracksInformationWithInitiator{}, quotaExceededError).Once()
+	s.env.OnActivity(
+		activities.FetchTracksInformationWithPolicyActivity,
+		mock.Anything,
+		fetchTracksInformationArgs(initialTracksIDs),
+	).Return(activities.FetchedTracksInformationWithInitiator{Metadata: []shared.TrackMetadata{initialTrack}}, nil).Once()
+	s.env.OnActivity(
+		a.MpeCreationAcknowledgementActivity,
+		mock.Anything,
+		mock.MatchedBy(func(args activities_mpe.MpeCreationAcknowledgementArgs) bool {
+			return quotaIsReset &&
+				len(args.Tracks) == 1 &&
+				len(args.RejectedTracks) == 0
+		}),
+	).Return(nil).Once()
+
+	// The creation must not be acknowledged before the quota is reset.
+	resetQuota := quotaResetDelay - time.Second
+	registerDelayedCallbackWrapper(func() {
+		quotaIsReset = true
+	}, resetQuota)
+
+	checkInitialTrackIsKept := 2 * time.Second
+	registerDelayedCallbackWrapper(func() {
+		mpeState := s.getMpeState(shared_mpe.NoRelatedUserID)
+
+		s.Equal([]shared.TrackMetadata{initialTrack}, mpeState.Tracks)
+	}, checkInitialTrackIsKept)
+
+	s.env.ExecuteWorkflow(MpeRoomWorkflow, params)
+
+	s.True(s.env.IsWorkflowCompleted())
+	err := s.env.GetWorkflowError()
+	s.ErrorIs(err, workflow.ErrDeadlineExceeded, "The workflow ran on an infinite loop")
+}
+
 func (s *CreateMpeWorkflowTestUnit) Test_CreateMpeWorkflowWithSeveralInitialTracksIDs() {
 	initialTracksIDs := []string{
 		faker.UUIDHyphenated(),
@@ -141,10 +267,10 @@ func (s *CreateMpeWorkflowTestUnit) Test_CreateMpeWorkflowWithSeveralInitialTrac
 	defer resetMock()
 
 	s.env.OnActivity(
-		activities.FetchTracksInformationActivity,
+		activities.FetchTracksInformationWithPolicyActivity,
 		mock.Anything,
-		initialTracksIDs,
-	).Return(tracks, nil).Once()
+		fetchTracksInformationArgs(initialTracksIDs),
+	).Return(activities.FetchedTracksInformationWithInitiator{Metadata: tracks}, nil).Once()
 	s.env.OnActivity(
 		a.MpeCreationAcknowledgementActivity,
 		mock.Anything,
@@ -229,10 +355,10 @@ func (s *CreateMpeWorkflowTestUnit) Test_GetStateProvidesUserRelatedInformation(
 	defer resetMock()
 
 	s.env.OnActivity(
-		activities.FetchTracksInformationActivity,
+		activities.FetchTracksInformationWithPolicyActivity,
 		mock.Anything,
-		initialTracksIDs,
-	).Return(tracks, nil).Once()
+		fetchTracksInformationArgs(initialTracksIDs),
+	).Return(activities.FetchedTracksInformationWithInitiator{Metadata: tracks}, nil).Once()
 	s.env.OnActivity(
 		a.MpeCreationAcknowledgementActivity,
 		mock.Anything,
@@ -322,10 +448,10 @@ func (s *CreateMpeWorkflowTestUnit) Test_CreateMpeWorkflowFetchInitialTrackFaile
 	defer resetMock()
 
 	s.env.OnActivity(
-		activities.FetchTracksInformationActivity,
+		activities.FetchTracksInformationWithPolicyActivity,
 		mock.Anything,
-		initialTracksIDs,
-	).Return(nil, nil).Once()
+		fetchTracksInformationArgs(initialTracksIDs),
+	).Return(activities.FetchedTracksInformationWithInitiator{Metadata: nil}, nil).Once()
 
 	checkOnlyOneUser := defaultDuration
 	registerDelayedCallbackWrapper(func() {
@@ -373,10 +499,10 @@ func (s *CreateMpeWorkflowTestUnit) Test_MtvRoomPanicAfterUnkownWorkflowSignal()
 	defer resetMock()
 
 	s.env.OnActivity(
-		activities.FetchTracksInformationActivity,
+		activities.FetchTracksInformationWithPolicyActivity,
 		mock.Anything,
-		initialTracksIDs,
-	).Return(tracks, nil).Once()
+		fetchTracksInformationArgs(initialTracksIDs),
+	).Return(activities.FetchedTracksInformationWithInitiator{Metadata: tracks}, nil).Once()
 	s.env.OnActivity(
 		a.MpeCreationAcknowledgementActivity,
 		mock.Anything,
@@ -398,6 +524,40 @@ func (s *CreateMpeWorkflowTestUnit) Test_MtvRoomPanicAfterUnkownWorkflowSignal()
 	s.Contains(panicError.Error(), ErrUnknownWorflowSignal.Error())
 }
 
+// fetchTracksInformationArgs matches the arguments of FetchTracksInformationWithPolicyActivity
+// requesting tracksIDs, whoever the initiator and whatever the policy are.
+func fetchTracksInformationArgs(tracksIDs []string) interface{} {
+	return mock.MatchedBy(func(args activities.FetchTracksInformationArgs) bool {
+		return sameTracksIDs(args.TracksIDs, tracksIDs)
+	})
+}
+
+// fetchTracksInformationArgsForInitiator also checks the initiator of the request.
+// Any tracks IDs are matched when tracksIDs is nil.
+func fetchTracksInformationArgsForInitiator(tracksIDs []string, userID string, deviceID string) interface{} {
+	return mock.MatchedBy(func(args activities.FetchTracksInformationArgs) bool {
+		if tracksIDs != nil && !sameTracksIDs(args.TracksIDs, tracksIDs) {
+			return false
+		}
+
+		return args.UserID == userID && args.DeviceID == deviceID
+	})
+}
+
+func sameTracksIDs(a []string, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	for index := range a {
+		if a[index] != b[index] {
+			return false
+		}
+	}
+
+	return true
+}
+
 func TestCreateMpeWorkflowTestSuite(t *testing.T) {
 	suite.Run(t, new(CreateMpeWorkflowTestUnit))
 }
@@ -1,101 +1,42 @@
 package activities_mtv
 
 import (
-	"bytes"
 	"context"
-	"encoding/json"
-	"net/http"
-	"os"
 
 	activities "github.com/AdonisEnProvence/MusicRoom/activities"
 	shared_mtv "github.com/AdonisEnProvence/MusicRoom/mtv/shared"
+	"github.com/AdonisEnProvence/MusicRoom/shared"
 )
 
-func (a *Activities) PauseActivity(_ context.Context, state shared_mtv.MtvRoomExposedState) error {
-	requestBody := state
-
-	marshaledBody, err := json.Marshal(requestBody)
-	if err != nil {
-		return err
-	}
-
-	url := activities.ADONIS_MTV_ENDPOINT + "/pause"
-
-	req, err := http.NewRequest(http.MethodPost, url, bytes.NewBuffer(marshaledBody))
-	if err != nil {
-		return err
-	}
-
-	req.Header.Set("Authorization", os.Getenv("TEMPORAL_ADONIS_KEY"))
-	req.Header.Set("Content-Type", "application/json")
-	client := &http.Client{}
-	_, err = client.Do(req)
-
-	return err
+func (a *Activities) PauseActivity(ctx context.Context, state shared_mtv.MtvRoomExposedState) error {
+	return activities.PostAdonisCallback(ctx, activities.AdonisMtvCallbacksPath+"/pause", state)
 }
 
-func (a *Activities) PlayActivity(_ context.Context, state shared_mtv.MtvRoomExposedState) error {
-	requestBody := state
-
-	marshaledBody, err := json.Marshal(requestBody)
-	if err != nil {
-		return err
-	}
-
-	url := activities.ADONIS_MTV_ENDPOINT + "/play"
-	req, err := http.NewRequest(http.MethodPost, url, bytes.NewBuffer(marshaledBody))
-	if err != nil {
-		return err
-	}
-
-	req.Header.Set("Authorization", os.Getenv("TEMPORAL_ADONIS_KEY"))
-	req.Header.Set("Content-Type", "application/json")
-	client := &http.Client{}
-	_, err = client.Do(req)
-
-	return err
+// SeekActivity resyncs the devices of a paused room on the new elapsed time of the current track,
+// playing rooms are resynced by PlayActivity.
+func (a *Activities) SeekActivity(ctx context.Context, state shared_mtv.MtvRoomExposedState) error {
+	return activities.PostAdonisCallback(ctx, activities.AdonisMtvCallbacksPath+"/seek", state)
 }
 
-func (a *Activities) CreationAcknowledgementActivity(_ context.Context, state shared_mtv.MtvRoomExposedState) error {
-	marshaledBody, err := json.Marshal(state)
-	if err != nil {
-		return err
-	}
-
-	url := activities.ADONIS_MTV_ENDPOINT + "/mtv-creation-acknowledgement"
-
-	req, err := http.NewRequest(http.MethodPost, url, bytes.NewBuffer(marshaledBody))
-	if err != nil {
-		return err
-	}
+func (a *Activities) PlayActivity(ctx context.Context, state shared_mtv.MtvRoomExposedState) error {
+	return activities.PostAdonisCallback(ctx, activities.AdonisMtvCallbacksPath+"/play", state)
+}
 
-	req.Header.Set("Authorization", os.Getenv("TEMPORAL_ADONIS_KEY"))
-	req.Header.Set("Content-Type", "application/json")
-	client := &http.Client{}
-	_, err = client.Do(req)
+// CreationAcknowledgementArgs is encoded as the room state with the rejected tracks next to its fields.
+type CreationAcknowledgementArgs struct {
+	shared_mtv.MtvRoomExposedState
+	// Initial tracks that could not be fetched or that can not be played in the room
+	RejectedTracks []shared.RejectedTrack `json:"rejectedTracks,omitempty"`
+}
 
-	return err
+func (a *Activities) CreationAcknowledgementActivity(ctx context.Context, args CreationAcknowledgementArgs) error {
+	return activities.PostAdonisCallback(ctx, activities.AdonisMtvCallbacksPath+"/mtv-creation-acknowledgement", args)
 }
 
 // As we removed a user we need to send back the new UserLength value to every others clients
 // Calculated in the internalState.Export()
 func (a *Activities) UserLengthUpdateActivity(ctx context.Context, state shared_mtv.MtvRoomExposedState) error {
-	marshaledBody, err := json.Marshal(state)
-	if err != nil {
-		return err
-	}
-
-	url := activities.ADONIS_MTV_ENDPOINT + "/user-length-update"
-	req, err := http.NewRequest(http.MethodPost, url, bytes.NewBuffer(marshaledBody))
-	if err != nil {
-		return err
-	}
-
-	req.Header.Set("Authorization", os.Getenv("TEMPORAL_ADONIS_KEY"))
-	req.Header.Set("Content-Type", "application/json")
-	client := &http.Client{}
-	_, err = client.Do(req)
-	return err
+	return activities.PostAdonisCallback(ctx, activities.AdonisMtvCallbacksPath+"/user-length-update", state)
 }
 
 type MtvJoinCallbackRequestBody struct {
@@ -104,25 +45,7 @@ type MtvJoinCallbackRequestBody struct {
 }
 
 func (a *Activities) JoinActivity(ctx context.Context, args MtvJoinCallbackRequestBody) error {
-	requestBody := args
-
-	marshaledBody, err := json.Marshal(requestBody)
-	if err != nil {
-		return err
-	}
-
-	url := activities.ADONIS_MTV_ENDPOINT + "/join"
-	req, err := http.NewRequest(http.MethodPost, url, bytes.NewBuffer(marshaledBody))
-	if err != nil {
-		return err
-	}
-
-	req.Header.Set("Authorization", os.Getenv("TEMPORAL_ADONIS_KEY"))
-	req.Header.Set("Content-Type", "application/json")
-	client := &http.Client{}
-	_, err = client.Do(req)
-
-	return err
+	return activities.PostAdonisCallback(ctx, activities.AdonisMtvCallbacksPath+"/join", args)
 }
 
 type AcknowledgeLeaveRoomRequestBody struct {
@@ -131,233 +54,131 @@ type AcknowledgeLeaveRoomRequestBody struct {
 }
 
 func (a *Activities) LeaveActivity(ctx context.Context, args AcknowledgeLeaveRoomRequestBody) error {
-	requestBody := AcknowledgeLeaveRoomRequestBody{
-		State:         args.State,
-		LeavingUserID: args.LeavingUserID,
-	}
-
-	marshaledBody, err := json.Marshal(requestBody)
-	if err != nil {
-		return err
-	}
-
-	url := activities.ADONIS_MTV_ENDPOINT + "/leave"
-	req, err := http.NewRequest(http.MethodPost, url, bytes.NewBuffer(marshaledBody))
-	if err != nil {
-		return err
-	}
-
-	req.Header.Set("Authorization", os.Getenv("TEMPORAL_ADONIS_KEY"))
-	req.Header.Set("Content-Type", "application/json")
-	client := &http.Client{}
-	_, err = client.Do(req)
-
-	return err
+	return activities.PostAdonisCallback(ctx, activities.AdonisMtvCallbacksPath+"/leave", args)
 }
 
 func (a *Activities) UserVoteForTrackAcknowledgement(ctx context.Context, state shared_mtv.MtvRoomExposedState) error {
-	requestBody := state
-
-	marshaledBody, err := json.Marshal(requestBody)
-	if err != nil {
-		return err
-	}
-
-	url := activities.ADONIS_MTV_ENDPOINT + "/acknowledge-user-vote-for-track"
-	req, err := http.NewRequest(http.MethodPost, url, bytes.NewBuffer(marshaledBody))
-	if err != nil {
-		return err
-	}
+	return activities.PostAdonisCallback(ctx, activities.AdonisMtvCallbacksPath+"/acknowledge-user-vote-for-track", state)
+}
 
-	req.Header.Set("Authorization", os.Getenv("TEMPORAL_ADONIS_KEY"))
-	req.Header.Set("Content-Type", "application/json")
-	client := &http.Client{}
-	_, err = client.Do(req)
+func (a *Activities) UserUnvoteForTrackAcknowledgement(ctx context.Context, state shared_mtv.MtvRoomExposedState) error {
+	return activities.PostAdonisCallback(ctx, activities.AdonisMtvCallbacksPath+"/acknowledge-user-unvote-for-track", state)
+}
 
-	return err
+func (a *Activities) NotifySkipVotesUpdateActivity(ctx context.Context, state shared_mtv.MtvRoomExposedState) error {
+	return activities.PostAdonisCallback(ctx, activities.AdonisMtvCallbacksPath+"/notify-skip-votes-update", state)
 }
 
 func (a *Activities) ChangeUserEmittingDeviceActivity(ctx context.Context, state shared_mtv.MtvRoomExposedState) error {
-	requestBody := state
-
-	marshaledBody, err := json.Marshal(requestBody)
-	if err != nil {
-		return err
-	}
-
-	url := activities.ADONIS_MTV_ENDPOINT + "/change-user-emitting-device"
-	req, err := http.NewRequest(http.MethodPost, url, bytes.NewBuffer(marshaledBody))
-	if err != nil {
-		return err
-	}
-
-	req.Header.Set("Authorization", os.Getenv("TEMPORAL_ADONIS_KEY"))
-	req.Header.Set("Content-Type", "application/json")
-	client := &http.Client{}
-	_, err = client.Do(req)
-
-	return err
+	return activities.PostAdonisCallback(ctx, activities.AdonisMtvCallbacksPath+"/change-user-emitting-device", state)
 }
 
 func (a *Activities) NotifySuggestOrVoteUpdateActivity(ctx context.Context, state shared_mtv.MtvRoomExposedState) error {
-	requestBody := state
-
-	marshaledBody, err := json.Marshal(requestBody)
-	if err != nil {
-		return err
-	}
-
-	url := activities.ADONIS_MTV_ENDPOINT + "/suggest-or-vote-update"
-	req, err := http.NewRequest(http.MethodPost, url, bytes.NewBuffer(marshaledBody))
-	if err != nil {
-		return err
-	}
-
-	req.Header.Set("Authorization", os.Getenv("TEMPORAL_ADONIS_KEY"))
-	req.Header.Set("Content-Type", "application/json")
-	client := &http.Client{}
-	_, err = client.Do(req)
-
-	return err
+	return activities.PostAdonisCallback(ctx, activities.AdonisMtvCallbacksPath+"/suggest-or-vote-update", state)
 }
 
 type AcknowledgeTracksSuggestionArgs struct {
 	State    shared_mtv.MtvRoomExposedState `json:"state"`
 	DeviceID string                         `json:"deviceID"`
+	// Suggested tracks that could not be resolved
+	RejectedTracks []shared.RejectedTrack `json:"rejectedTracks,omitempty"`
 }
 
 func (a *Activities) AcknowledgeTracksSuggestion(ctx context.Context, args AcknowledgeTracksSuggestionArgs) error {
-	requestBody := args
-
-	marshaledBody, err := json.Marshal(requestBody)
-	if err != nil {
-		return err
-	}
-
-	url := activities.ADONIS_MTV_ENDPOINT + "/acknowledge-tracks-suggestion"
-	req, err := http.NewRequest(http.MethodPost, url, bytes.NewBuffer(marshaledBody))
-	if err != nil {
-		return err
-	}
-
-	req.Header.Set("Authorization", os.Getenv("TEMPORAL_ADONIS_KEY"))
-	req.Header.Set("Content-Type", "application/json")
-	client := &http.Client{}
-	_, err = client.Do(req)
-
-	return err
+	return activities.PostAdonisCallback(ctx, activities.AdonisMtvCallbacksPath+"/acknowledge-tracks-suggestion", args)
 }
 
 type AcknowledgeTracksSuggestionFailArgs struct {
-	DeviceID string `json:"deviceID"`
+	DeviceID       string                                       `json:"deviceID"`
+	Reason         shared_mtv.MtvRoomTracksSuggestionFailReason `json:"reason,omitempty"`
+	RejectedTracks []shared.RejectedTrack                       `json:"rejectedTracks,omitempty"`
 }
 
 func (a *Activities) AcknowledgeTracksSuggestionFail(ctx context.Context, args AcknowledgeTracksSuggestionFailArgs) error {
-	requestBody := args
-
-	marshaledBody, err := json.Marshal(requestBody)
-	if err != nil {
-		return err
-	}
-
-	url := activities.ADONIS_MTV_ENDPOINT + "/acknowledge-tracks-suggestion-fail"
-	req, err := http.NewRequest(http.MethodPost, url, bytes.NewBuffer(marshaledBody))
-	if err != nil {
-		return err
-	}
+	return activities.PostAdonisCallback(ctx, activities.AdonisMtvCallbacksPath+"/acknowledge-tracks-suggestion-fail", args)
+}
 
-	req.Header.Set("Authorization", os.Getenv("TEMPORAL_ADONIS_KEY"))
-	req.Header.Set("Content-Type", "application/json")
-	client := &http.Client{}
-	_, err = client.Do(req)
+type AcknowledgeDownvotedTrackRemovalArgs struct {
+	State   shared_mtv.MtvRoomExposedState `json:"state"`
+	TrackID string                         `json:"trackID"`
+}
 
-	return err
+func (a *Activities) AcknowledgeDownvotedTrackRemoval(ctx context.Context, args AcknowledgeDownvotedTrackRemovalArgs) error {
+	return activities.PostAdonisCallback(ctx, activities.AdonisMtvCallbacksPath+"/acknowledge-downvoted-track-removal", args)
 }
 
 func (a *Activities) AcknowledgeUpdateUserFitsPositionConstraint(ctx context.Context, state shared_mtv.MtvRoomExposedState) error {
-	requestBody := state
-
-	marshaledBody, err := json.Marshal(requestBody)
-	if err != nil {
-		return err
-	}
-
-	url := activities.ADONIS_MTV_ENDPOINT + "/acknowledge-update-user-fits-position-constraint"
-	req, err := http.NewRequest(http.MethodPost, url, bytes.NewBuffer(marshaledBody))
-	if err != nil {
-		return err
-	}
-
-	req.Header.Set("Authorization", os.Getenv("TEMPORAL_ADONIS_KEY"))
-	req.Header.Set("Content-Type", "application/json")
-	client := &http.Client{}
-	_, err = client.Do(req)
-
-	return err
+	return activities.PostAdonisCallback(ctx, activities.AdonisMtvCallbacksPath+"/acknowledge-update-user-fits-position-constraint", state)
 }
 
 func (a *Activities) AcknowledgeUpdateDelegationOwner(ctx context.Context, state shared_mtv.MtvRoomExposedState) error {
-	requestBody := state
+	return activities.PostAdonisCallback(ctx, activities.AdonisMtvCallbacksPath+"/acknowledge-update-delegation-owner", state)
+}
 
-	marshaledBody, err := json.Marshal(requestBody)
-	if err != nil {
-		return err
-	}
+func (a *Activities) AcknowledgeUpdateControlAndDelegationPermission(ctx context.Context, state shared_mtv.MtvRoomExposedState) error {
+	return activities.PostAdonisCallback(ctx, activities.AdonisMtvCallbacksPath+"/acknowledge-update-control-and-delegation-permission", state)
+}
 
-	url := activities.ADONIS_MTV_ENDPOINT + "/acknowledge-update-delegation-owner"
-	req, err := http.NewRequest(http.MethodPost, url, bytes.NewBuffer(marshaledBody))
-	if err != nil {
-		return err
-	}
+func (a *Activities) AcknowledgeUpdateRoomSettings(ctx context.Context, state shared_mtv.MtvRoomExposedState) error {
+	return activities.PostAdonisCallback(ctx, activities.AdonisMtvCallbacksPath+"/acknowledge-update-room-settings", state)
+}
 
-	req.Header.Set("Authorization", os.Getenv("TEMPORAL_ADONIS_KEY"))
-	req.Header.Set("Content-Type", "application/json")
-	client := &http.Client{}
-	_, err = client.Do(req)
+type AcknowledgeUpdateRoomSettingsFailArgs struct {
+	UserID string                                     `json:"userID"`
+	Reason shared_mtv.MtvRoomSettingsUpdateFailReason `json:"reason"`
+}
 
-	return err
+func (a *Activities) AcknowledgeUpdateRoomSettingsFail(ctx context.Context, args AcknowledgeUpdateRoomSettingsFailArgs) error {
+	return activities.PostAdonisCallback(ctx, activities.AdonisMtvCallbacksPath+"/acknowledge-update-room-settings-fail", args)
 }
 
-func (a *Activities) AcknowledgeUpdateControlAndDelegationPermission(ctx context.Context, state shared_mtv.MtvRoomExposedState) error {
-	requestBody := state
+func (a *Activities) AcknowledgeUpdateTimeConstraint(ctx context.Context, state shared_mtv.MtvRoomExposedState) error {
+	return activities.PostAdonisCallback(ctx, activities.AdonisMtvCallbacksPath+"/acknowledge-update-time-constraint", state)
+}
+
+type AcknowledgeUserKickedArgs struct {
+	State        shared_mtv.MtvRoomExposedState `json:"state"`
+	KickedUserID string                         `json:"kickedUserID"`
+}
 
-	marshaledBody, err := json.Marshal(requestBody)
-	if err != nil {
-		return err
-	}
+func (a *Activities) AcknowledgeUserKickedActivity(ctx context.Context, args AcknowledgeUserKickedArgs) error {
+	return activities.PostAdonisCallback(ctx, activities.AdonisMtvCallbacksPath+"/acknowledge-user-kicked", args)
+}
 
-	url := activities.ADONIS_MTV_ENDPOINT + "/acknowledge-update-control-and-delegation-permission"
-	req, err := http.NewRequest(http.MethodPost, url, bytes.NewBuffer(marshaledBody))
-	if err != nil {
-		return err
-	}
+type AcknowledgeUserBannedArgs struct {
+	State        shared_mtv.MtvRoomExposedState `json:"state"`
+	BannedUserID string                         `json:"bannedUserID"`
+}
 
-	req.Header.Set("Authorization", os.Getenv("TEMPORAL_ADONIS_KEY"))
-	req.Header.Set("Content-Type", "application/json")
-	client := &http.Client{}
-	_, err = client.Do(req)
+func (a *Activities) AcknowledgeUserBannedActivity(ctx context.Context, args AcknowledgeUserBannedArgs) error {
+	return activities.PostAdonisCallback(ctx, activities.AdonisMtvCallbacksPath+"/acknowledge-user-banned", args)
+}
 
-	return err
+type AcknowledgeTracksRemovedArgs struct {
+	State            shared_mtv.MtvRoomExposedState `json:"state"`
+	UserID           string                         `json:"userID"`
+	RemovedTracksIDs []string                       `json:"removedTracksIDs"`
 }
 
-func (a *Activities) AcknowledgeUpdateTimeConstraint(ctx context.Context, state shared_mtv.MtvRoomExposedState) error {
-	requestBody := state
+func (a *Activities) AcknowledgeTracksRemovedActivity(ctx context.Context, args AcknowledgeTracksRemovedArgs) error {
+	return activities.PostAdonisCallback(ctx, activities.AdonisMtvCallbacksPath+"/acknowledge-tracks-removed", args)
+}
 
-	marshaledBody, err := json.Marshal(requestBody)
-	if err != nil {
-		return err
-	}
+type RejectBannedUserJoinArgs struct {
+	RoomID   string `json:"roomID"`
+	UserID   string `json:"userID"`
+	DeviceID string `json:"deviceID"`
+}
 
-	url := activities.ADONIS_MTV_ENDPOINT + "/acknowledge-update-time-constraint"
-	req, err := http.NewRequest(http.MethodPost, url, bytes.NewBuffer(marshaledBody))
-	if err != nil {
-		return err
-	}
+func (a *Activities) RejectBannedUserJoinActivity(ctx context.Context, args RejectBannedUserJoinArgs) error {
+	return activities.PostAdonisCallback(ctx, activities.AdonisMtvCallbacksPath+"/reject-banned-user-join", args)
+}
 
-	req.Header.Set("Authorization", os.Getenv("TEMPORAL_ADONIS_KEY"))
-	req.Header.Set("Content-Type", "application/json")
-	client := &http.Client{}
-	_, err = client.Do(req)
+type RoomExpiredActivityArgs struct {
+	RoomID string                      `json:"roomID"`
+	Reason shared.RoomExpirationReason `json:"reason"`
+}
 
-	return err
+func (a *Activities) RoomExpiredActivity(ctx context.Context, args RoomExpiredActivityArgs) error {
+	return activities.PostAdonisCallback(ctx, activities.AdonisMtvCallbacksPath+"/room-expired", args)
 }
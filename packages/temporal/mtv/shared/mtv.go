@@ -16,6 +16,30 @@ const (
 	CheckForVoteUpdateIntervalDuration time.Duration             = 2000 * time.Millisecond
 )
 
+const (
+	DefaultContinueAsNewEventsThreshold = 1000
+	// Leaves time to the activities scheduled by the current run to be started
+	// before the run is closed by the continue-as-new.
+	ContinueAsNewGracePeriodDuration = 1000 * time.Millisecond
+)
+
+// Share of the room users that have to vote to skip the current track
+// when the room does not specify one.
+const DefaultSkipVotesRequiredShare = 0.5
+
+// Sliding window over which MaximumSuggestionsPerUserPerMinute is enforced.
+const SuggestionsRateLimitWindow = time.Minute
+
+type MtvRoomTracksSuggestionFailReason string
+
+const (
+	MtvRoomTracksSuggestionFailReasonTracksListIsFull          MtvRoomTracksSuggestionFailReason = "TRACKS_LIST_IS_FULL"
+	MtvRoomTracksSuggestionFailReasonTooManyPendingSuggestions MtvRoomTracksSuggestionFailReason = "TOO_MANY_PENDING_SUGGESTIONS"
+	MtvRoomTracksSuggestionFailReasonRateLimited               MtvRoomTracksSuggestionFailReason = "RATE_LIMITED"
+	// None of the suggested tracks could be resolved or played, see the rejected tracks reasons
+	MtvRoomTracksSuggestionFailReasonTracksRejected MtvRoomTracksSuggestionFailReason = "TRACKS_REJECTED"
+)
+
 var (
 	TrueValue  bool = true
 	FalseValue bool = false
@@ -34,13 +58,33 @@ var (
 	MtvGetStateQuery             = "getState"
 	MtvGetUsersListQuery         = "getUsersList"
 	MtvGetRoomConstraintsDetails = "getRoomConstraintsDetails"
+	MtvGetPlayedTracksQuery      = "getPlayedTracks"
 	NoRelatedUserID              = ""
 )
 
+// Score is the net score of the track, upvotes minus downvotes.
+// It is the value used to sort the tracks list and to determine
+// if a track is ready to be played.
+// SuggestedBySystem is true for the tracks queued by the auto-DJ, they are
+// ready to be played whatever their score once no other track is.
 type TrackMetadataWithScore struct {
 	shared.TrackMetadata
 
-	Score int `json:"score"`
+	Score             int  `json:"score"`
+	Downvotes         int  `json:"downvotes"`
+	SuggestedBySystem bool `json:"suggestedBySystem"`
+}
+
+// Equal shadows the one of shared.TrackMetadata to compare scores as well.
+func (t TrackMetadataWithScore) Equal(other TrackMetadataWithScore) bool {
+	return t.TrackMetadata.Equal(other.TrackMetadata) &&
+		t.Score == other.Score &&
+		t.Downvotes == other.Downvotes &&
+		t.SuggestedBySystem == other.SuggestedBySystem
+}
+
+func (t TrackMetadataWithScore) Upvotes() int {
+	return t.Score + t.Downvotes
 }
 
 func (t TrackMetadataWithScore) WithMillisecondsDuration() TrackMetadataWithScoreWithDuration {
@@ -51,8 +95,20 @@ func (t TrackMetadataWithScore) WithMillisecondsDuration() TrackMetadataWithScor
 	}
 }
 
+// TracksMetadataWithScoreSet keeps the tracks sorted by higher score,
+// tracks with the same score staying in the order a stable sort would give them.
+// Positions are indexed by track ID so that lookups and score updates do not scan the tracks.
 type TracksMetadataWithScoreSet struct {
 	tracks []TrackMetadataWithScore
+	// Maps track IDs to their position shifted by offset,
+	// it is lazily built as clones do not copy it.
+	indexes map[string]int
+	// Incremented each time the first track is shifted,
+	// it avoids updating the position of every remaining track.
+	offset int
+	// Set when a track has been added with a higher score than the last track,
+	// the next score update sorts the whole set as tracks are not sorted anymore.
+	unsorted bool
 }
 
 func (s *TracksMetadataWithScoreSet) Clone() TracksMetadataWithScoreSet {
@@ -62,12 +118,16 @@ func (s *TracksMetadataWithScoreSet) Clone() TracksMetadataWithScoreSet {
 	copy(copiedTracks, originalTracks)
 
 	return TracksMetadataWithScoreSet{
-		tracks: copiedTracks,
+		tracks:   copiedTracks,
+		unsorted: s.unsorted,
 	}
 }
 
 func (s *TracksMetadataWithScoreSet) Clear() {
 	s.tracks = []TrackMetadataWithScore{}
+	s.indexes = make(map[string]int)
+	s.offset = 0
+	s.unsorted = false
 }
 
 func (s *TracksMetadataWithScoreSet) Len() int {
@@ -75,23 +135,20 @@ func (s *TracksMetadataWithScoreSet) Len() int {
 }
 
 func (s *TracksMetadataWithScoreSet) Has(trackID string) bool {
-	for _, track := range s.tracks {
-		if track.ID == trackID {
-			return true
-		}
-	}
+	_, exists := s.IndexOf(trackID)
 
-	return false
+	return exists
 }
 
 func (s *TracksMetadataWithScoreSet) IndexOf(trackID string) (int, bool) {
-	for index, track := range s.tracks {
-		if track.ID == trackID {
-			return index, true
-		}
+	s.ensureIndexes()
+
+	position, exists := s.indexes[trackID]
+	if !exists {
+		return -1, false
 	}
 
-	return -1, false
+	return position - s.offset, true
 }
 
 func (s *TracksMetadataWithScoreSet) Get(trackID string) (*TrackMetadataWithScore, bool) {
@@ -105,14 +162,63 @@ func (s *TracksMetadataWithScoreSet) Get(trackID string) (*TrackMetadataWithScor
 }
 
 func (s *TracksMetadataWithScoreSet) IncrementTrackScoreAndSortTracks(trackID string) bool {
-	track, exists := s.Get(trackID)
+	return s.updateTrackScoreAndSortTracks(trackID, 1, 0)
+}
+
+func (s *TracksMetadataWithScoreSet) DecrementTrackScoreAndSortTracks(trackID string) bool {
+	return s.updateTrackScoreAndSortTracks(trackID, -1, 0)
+}
+
+func (s *TracksMetadataWithScoreSet) DownvoteTrackAndSortTracks(trackID string) bool {
+	return s.updateTrackScoreAndSortTracks(trackID, -1, 1)
+}
+
+func (s *TracksMetadataWithScoreSet) RemoveTrackDownvoteAndSortTracks(trackID string) bool {
+	return s.updateTrackScoreAndSortTracks(trackID, 1, -1)
+}
+
+// updateTrackScoreAndSortTracks only moves the updated track, which gives the same order as
+// sorting the whole set with a stable sort:
+// - a track whose score increases goes before the tracks it had the same score as
+// - a track whose score decreases goes after the tracks it had the same score as
+func (s *TracksMetadataWithScoreSet) updateTrackScoreAndSortTracks(trackID string, scoreDelta int, downvotesDelta int) bool {
+	index, exists := s.IndexOf(trackID)
 
 	if !exists {
 		return false
 	}
 
-	track.Score++
-	s.StableSortByHigherScore()
+	track := s.tracks[index]
+	track.Score += scoreDelta
+	track.Downvotes += downvotesDelta
+	s.tracks[index] = track
+
+	if s.unsorted {
+		s.StableSortByHigherScore()
+		return true
+	}
+
+	switch {
+	case scoreDelta > 0:
+		newIndex := sort.Search(index, func(i int) bool {
+			return s.tracks[i].Score < track.Score
+		})
+
+		copy(s.tracks[newIndex+1:index+1], s.tracks[newIndex:index])
+		s.tracks[newIndex] = track
+		s.reindex(newIndex, index)
+
+	case scoreDelta < 0:
+		followingTracks := s.tracks[index+1:]
+		followingTracksWithHigherScore := sort.Search(len(followingTracks), func(i int) bool {
+			return followingTracks[i].Score <= track.Score
+		})
+		newIndex := index + followingTracksWithHigherScore
+
+		copy(s.tracks[index:newIndex], s.tracks[index+1:newIndex+1])
+		s.tracks[newIndex] = track
+		s.reindex(index, newIndex)
+	}
 
 	return true
 }
@@ -120,11 +226,11 @@ func (s *TracksMetadataWithScoreSet) IncrementTrackScoreAndSortTracks(trackID st
 func (s *TracksMetadataWithScoreSet) GetByIndex(index int) *TrackMetadataWithScore {
 	tracksLength := s.Len()
 
-	if tracksLength <= index {
+	if index < 0 || tracksLength <= index {
 		return nil
 	}
 
-	return &s.Values()[index]
+	return &s.tracks[index]
 }
 
 func (s *TracksMetadataWithScoreSet) FirstTrackIsReadyToBePlayed(minimumScoreToBePlayed int) bool {
@@ -138,8 +244,49 @@ func (s *TracksMetadataWithScoreSet) FirstTrackIsReadyToBePlayed(minimumScoreToB
 	return firstTrack.Score >= minimumScoreToBePlayed
 }
 
+// NextTrackIsReadyToBePlayed is true when the first track is ready to be played,
+// or when a track suggested by the system is waiting to be.
+func (s *TracksMetadataWithScoreSet) NextTrackIsReadyToBePlayed(minimumScoreToBePlayed int) bool {
+	_, exists := s.nextTrackToPlayIndex(minimumScoreToBePlayed)
+
+	return exists
+}
+
+// ShiftNextTrackToPlay removes the track NextTrackIsReadyToBePlayed refers to
+// from the set and returns it as well as true.
+// If there is none, it returns an empty TrackMetadataWithScore and false.
+func (s *TracksMetadataWithScoreSet) ShiftNextTrackToPlay(minimumScoreToBePlayed int) (TrackMetadataWithScore, bool) {
+	index, exists := s.nextTrackToPlayIndex(minimumScoreToBePlayed)
+	if !exists {
+		return TrackMetadataWithScore{}, false
+	}
+
+	track := s.tracks[index]
+	s.Delete(track.ID)
+
+	return track, true
+}
+
+func (s *TracksMetadataWithScoreSet) nextTrackToPlayIndex(minimumScoreToBePlayed int) (int, bool) {
+	if s.FirstTrackIsReadyToBePlayed(minimumScoreToBePlayed) {
+		return 0, true
+	}
+
+	for index, track := range s.tracks {
+		if track.SuggestedBySystem {
+			return index, true
+		}
+	}
+
+	return -1, false
+}
+
 func (s *TracksMetadataWithScoreSet) StableSortByHigherScore() {
 	sort.SliceStable(s.tracks, func(i, j int) bool { return s.tracks[i].Score > s.tracks[j].Score })
+
+	s.unsorted = false
+	s.indexes = nil
+	s.ensureIndexes()
 }
 
 func (s *TracksMetadataWithScoreSet) Add(track TrackMetadataWithScore) bool {
@@ -147,20 +294,32 @@ func (s *TracksMetadataWithScoreSet) Add(track TrackMetadataWithScore) bool {
 		return false
 	}
 
+	tracksCount := s.Len()
+	if tracksCount > 0 && track.Score > s.tracks[tracksCount-1].Score {
+		s.unsorted = true
+	}
+
 	s.tracks = append(s.tracks, track)
+	s.indexes[track.ID] = tracksCount + s.offset
 	return true
 }
 
 func (s *TracksMetadataWithScoreSet) Delete(trackID string) bool {
-	for index, track := range s.tracks {
-		if isMatching := track.ID == trackID; isMatching {
-			s.tracks = append(s.tracks[:index], s.tracks[index+1:]...)
+	index, exists := s.IndexOf(trackID)
+	if !exists {
+		return false
+	}
 
-			return true
-		}
+	if index == 0 {
+		s.Shift()
+		return true
 	}
 
-	return false
+	s.tracks = append(s.tracks[:index], s.tracks[index+1:]...)
+	delete(s.indexes, trackID)
+	s.reindex(index, s.Len()-1)
+
+	return true
 }
 
 func (s *TracksMetadataWithScoreSet) Values() []TrackMetadataWithScore {
@@ -180,19 +339,40 @@ func (s *TracksMetadataWithScoreSet) Shift() (TrackMetadataWithScore, bool) {
 	if tracksCount == 1 {
 		s.Clear()
 	} else {
+		s.ensureIndexes()
+
 		s.tracks = s.tracks[1:]
+		delete(s.indexes, firstElement.ID)
+		s.offset++
 	}
 
 	return firstElement, true
 }
 
+func (s *TracksMetadataWithScoreSet) ensureIndexes() {
+	if s.indexes != nil {
+		return
+	}
+
+	s.indexes = make(map[string]int, len(s.tracks))
+	s.offset = 0
+	s.reindex(0, len(s.tracks)-1)
+}
+
+// reindex updates the indexed position of the tracks between from and to included.
+func (s *TracksMetadataWithScoreSet) reindex(from int, to int) {
+	for index := from; index <= to; index++ {
+		s.indexes[s.tracks[index].ID] = index + s.offset
+	}
+}
+
 func (s TracksMetadataWithScoreSet) DeepEqual(toCmpTracksList TracksMetadataWithScoreSet) bool {
 	if len(s.tracks) != len(toCmpTracksList.tracks) {
 		return false
 	}
 
 	for index, track := range s.tracks {
-		if track != toCmpTracksList.tracks[index] {
+		if !track.Equal(toCmpTracksList.tracks[index]) {
 			return false
 		}
 	}
@@ -212,7 +392,31 @@ type CurrentTrack struct {
 }
 
 func (s CurrentTrack) DeepEqual(toCmpCurrentTrack CurrentTrack) bool {
-	return s == toCmpCurrentTrack
+	return s.TrackMetadataWithScore.Equal(toCmpCurrentTrack.TrackMetadataWithScore) &&
+		s.AlreadyElapsed == toCmpCurrentTrack.AlreadyElapsed
+}
+
+// CurrentTrackSnapshot is the serializable version of CurrentTrack.
+// CurrentTrack hides AlreadyElapsed from its JSON representation as it is sent to clients,
+// but we need to keep it when the current track is handed over to a new workflow run.
+type CurrentTrackSnapshot struct {
+	TrackMetadataWithScore
+
+	AlreadyElapsed time.Duration `json:"alreadyElapsed"`
+}
+
+func (c CurrentTrack) Snapshot() CurrentTrackSnapshot {
+	return CurrentTrackSnapshot{
+		TrackMetadataWithScore: c.TrackMetadataWithScore,
+		AlreadyElapsed:         c.AlreadyElapsed,
+	}
+}
+
+func (c CurrentTrackSnapshot) Restore() CurrentTrack {
+	return CurrentTrack{
+		TrackMetadataWithScore: c.TrackMetadataWithScore,
+		AlreadyElapsed:         c.AlreadyElapsed,
+	}
 }
 
 type ExposedCurrentTrack struct {
@@ -236,6 +440,7 @@ type InternalStateUser struct {
 	UserID                            string   `json:"userID"`
 	DeviceID                          string   `json:"emittingDeviceID"`
 	TracksVotedFor                    []string `json:"tracksVotedFor"`
+	TracksDownvotedFor                []string `json:"tracksDownvotedFor,omitempty"`
 	UserFitsPositionConstraint        *bool    `json:"userFitsPositionConstraint"`
 	HasControlAndDelegationPermission bool     `json:"hasControlAndDelegationPermission"`
 	UserHasBeenInvited                bool     `json:"userHasBeenInvited"`
@@ -257,6 +462,47 @@ func (s *InternalStateUser) HasVotedFor(trackID string) bool {
 	return false
 }
 
+//Returns false if the user had not voted for the given track
+func (s *InternalStateUser) RemoveTrackVotedFor(trackID string) bool {
+	var removed bool
+
+	s.TracksVotedFor, removed = removeTrackIDFromSlice(s.TracksVotedFor, trackID)
+
+	return removed
+}
+
+func (s *InternalStateUser) HasDownvotedFor(trackID string) bool {
+	for _, downvotedFortrackID := range s.TracksDownvotedFor {
+		if downvotedFortrackID == trackID {
+			return true
+		}
+	}
+	return false
+}
+
+//Returns false if the user had not downvoted for the given track
+func (s *InternalStateUser) RemoveTrackDownvotedFor(trackID string) bool {
+	var removed bool
+
+	s.TracksDownvotedFor, removed = removeTrackIDFromSlice(s.TracksDownvotedFor, trackID)
+
+	return removed
+}
+
+func removeTrackIDFromSlice(tracksIDs []string, trackID string) ([]string, bool) {
+	lastElementIndex := len(tracksIDs) - 1
+
+	for index, currentTrackID := range tracksIDs {
+		if currentTrackID == trackID {
+			//remove element from slice
+			tracksIDs[index] = tracksIDs[lastElementIndex]
+			return tracksIDs[:lastElementIndex], true
+		}
+	}
+
+	return tracksIDs, false
+}
+
 type MtvRoomCoords struct {
 	Lat float32 `json:"lat" validate:"required"`
 	Lng float32 `json:"lng" validate:"required"`
@@ -298,6 +544,63 @@ const (
 
 var MtvPlayingModesAllValues = [...]MtvPlayingModes{MtvPlayingModeDirect, MtvPlayingModeBroadcast}
 
+type MtvRoomAutoDJSource string
+
+const (
+	// Tracks of the MPE room given by MpeRoomID, in the playlist order
+	MtvRoomAutoDJSourceMpePlaylist MtvRoomAutoDJSource = "MPE_PLAYLIST"
+	// Tracks of FallbackTracksIDs, in the given order
+	MtvRoomAutoDJSourceFallbackTracks MtvRoomAutoDJSource = "FALLBACK_TRACKS"
+	// Tracks the provider of the current track relates to it
+	MtvRoomAutoDJSourceRelatedTracks MtvRoomAutoDJSource = "RELATED_TRACKS"
+)
+
+// Tracks queued each time the auto-DJ fills the tracks list,
+// when the room does not specify a batch size.
+const DefaultAutoDJBatchSize = 3
+
+// MtvRoomAutoDJOptions enables the auto-DJ, which queues tracks from Source
+// as system suggestions when no track of the tracks list is ready to be played.
+// Tracks of the tracks list are never queued again, played ones are only
+// queued again once every other track of Source has been.
+type MtvRoomAutoDJOptions struct {
+	Source            MtvRoomAutoDJSource `json:"source" validate:"required,oneof=MPE_PLAYLIST FALLBACK_TRACKS RELATED_TRACKS"`
+	MpeRoomID         string              `json:"mpeRoomID,omitempty" validate:"required_if=Source MPE_PLAYLIST,omitempty,uuid"`
+	FallbackTracksIDs []string            `json:"fallbackTracksIDs,omitempty" validate:"required_if=Source FALLBACK_TRACKS,dive,required"`
+	// DefaultAutoDJBatchSize is used when zero.
+	BatchSize int `json:"batchSize" validate:"min=0,max=10"`
+}
+
+func (o MtvRoomAutoDJOptions) CheckValidity() error {
+	switch o.Source {
+	case MtvRoomAutoDJSourceMpePlaylist:
+		if o.MpeRoomID == "" {
+			return errors.New("auto-DJ MPE_PLAYLIST source requires MpeRoomID")
+		}
+	case MtvRoomAutoDJSourceFallbackTracks:
+		if len(o.FallbackTracksIDs) == 0 {
+			return errors.New("auto-DJ FALLBACK_TRACKS source requires FallbackTracksIDs")
+		}
+	case MtvRoomAutoDJSourceRelatedTracks:
+	default:
+		return errors.New("auto-DJ Source is invalid")
+	}
+
+	if o.BatchSize < 0 {
+		return errors.New("auto-DJ BatchSize must be positive")
+	}
+
+	return nil
+}
+
+func (o MtvRoomAutoDJOptions) GetBatchSize() int {
+	if o.BatchSize <= 0 {
+		return DefaultAutoDJBatchSize
+	}
+
+	return o.BatchSize
+}
+
 type MtvRoomCreationOptions struct {
 	RoomName               string `json:"name" validate:"required" mapstructure:"name"`
 	MinimumScoreToBePlayed int    `json:"minimumScoreToBePlayed" validate:"min=0"`
@@ -308,6 +611,37 @@ type MtvRoomCreationOptions struct {
 	HasPhysicalAndTimeConstraints bool                               `json:"hasPhysicalAndTimeConstraints"`
 	PhysicalAndTimeConstraints    *MtvRoomPhysicalAndTimeConstraints `json:"physicalAndTimeConstraints,omitempty"`
 	PlayingMode                   MtvPlayingModes                    `json:"playingMode" validate:"required,oneof=DIRECT BROADCAST"`
+	// When HasDownvotes is true users can downvote tracks, tracks whose net score
+	// falls below MinimumScoreToStayInQueue are removed from the tracks list
+	HasDownvotes              bool `json:"hasDownvotes"`
+	MinimumScoreToStayInQueue int  `json:"minimumScoreToStayInQueue"`
+	// Share of UsersLength, between 0 and 1, that has to vote to skip the current track.
+	// DefaultSkipVotesRequiredShare is used when zero.
+	SkipVotesRequiredShare float64 `json:"skipVotesRequiredShare"`
+	// Suggestions limits, a zero value disables the matching limit.
+	// MaximumTracksListLength counts the tracks of the list and the ones being fetched.
+	// MaximumPendingSuggestionsPerUser counts the tracks suggested by a user that have not been played yet.
+	MaximumTracksListLength            int `json:"maximumTracksListLength"`
+	MaximumPendingSuggestionsPerUser   int `json:"maximumPendingSuggestionsPerUser"`
+	MaximumSuggestionsPerUserPerMinute int `json:"maximumSuggestionsPerUserPerMinute"`
+	// PlayabilityPolicy defines which suggested tracks are accepted.
+	PlayabilityPolicy shared.TrackPlayabilityPolicy `json:"playabilityPolicy"`
+	// AutoDJ is disabled when nil.
+	AutoDJ *MtvRoomAutoDJOptions `json:"autoDJ,omitempty"`
+	// IdlePolicy defines when the room terminates by itself once it has been abandoned.
+	IdlePolicy shared.RoomIdlePolicy `json:"idlePolicy"`
+}
+
+func (o MtvRoomCreationOptions) HasAutoDJ() bool {
+	return o.AutoDJ != nil
+}
+
+func (o MtvRoomCreationOptions) GetSkipVotesRequiredShare() float64 {
+	if o.SkipVotesRequiredShare <= 0 {
+		return DefaultSkipVotesRequiredShare
+	}
+
+	return o.SkipVotesRequiredShare
 }
 
 type MtvRoomCreationOptionsFromExportWithPlaceID struct {
@@ -315,11 +649,20 @@ type MtvRoomCreationOptionsFromExportWithPlaceID struct {
 	MinimumScoreToBePlayed int    `json:"minimumScoreToBePlayed" validate:"min=0"`
 	// Same as for PhysicalConstraintPosition IsOpen won't be useful
 	// for temporal itself but for the adonis mtv room search engine
-	IsOpen                        bool                                          `json:"isOpen"`
-	IsOpenOnlyInvitedUsersCanVote bool                                          `json:"isOpenOnlyInvitedUsersCanVote"`
-	HasPhysicalAndTimeConstraints bool                                          `json:"hasPhysicalAndTimeConstraints"`
-	PhysicalAndTimeConstraints    *MtvRoomPhysicalAndTimeConstraintsWithPlaceID `json:"physicalAndTimeConstraints,omitempty"`
-	PlayingMode                   MtvPlayingModes                               `json:"playingMode" validate:"required,oneof=DIRECT BROADCAST"`
+	IsOpen                             bool                                          `json:"isOpen"`
+	IsOpenOnlyInvitedUsersCanVote      bool                                          `json:"isOpenOnlyInvitedUsersCanVote"`
+	HasPhysicalAndTimeConstraints      bool                                          `json:"hasPhysicalAndTimeConstraints"`
+	PhysicalAndTimeConstraints         *MtvRoomPhysicalAndTimeConstraintsWithPlaceID `json:"physicalAndTimeConstraints,omitempty"`
+	PlayingMode                        MtvPlayingModes                               `json:"playingMode" validate:"required,oneof=DIRECT BROADCAST"`
+	HasDownvotes                       bool                                          `json:"hasDownvotes"`
+	MinimumScoreToStayInQueue          int                                           `json:"minimumScoreToStayInQueue"`
+	SkipVotesRequiredShare             float64                                       `json:"skipVotesRequiredShare"`
+	MaximumTracksListLength            int                                           `json:"maximumTracksListLength"`
+	MaximumPendingSuggestionsPerUser   int                                           `json:"maximumPendingSuggestionsPerUser"`
+	MaximumSuggestionsPerUserPerMinute int                                           `json:"maximumSuggestionsPerUserPerMinute"`
+	PlayabilityPolicy                  shared.TrackPlayabilityPolicy                 `json:"playabilityPolicy"`
+	AutoDJ                             *MtvRoomAutoDJOptions                         `json:"autoDJ,omitempty"`
+	IdlePolicy                         shared.RoomIdlePolicy                         `json:"idlePolicy"`
 }
 
 type MtvRoomParameters struct {
@@ -329,6 +672,131 @@ type MtvRoomParameters struct {
 	RoomCreatorUserID             string
 	CreatorUserRelatedInformation *InternalStateUser
 	InitialTracksIDsList          []string
+
+	// ContinueAsNewEventsThreshold is the number of events a workflow run
+	// handles before continuing as new. DefaultContinueAsNewEventsThreshold is used when zero.
+	ContinueAsNewEventsThreshold int
+	// Snapshot is set when the workflow run has been started by a continue-as-new.
+	// The room is then restored from it instead of being created from scratch.
+	Snapshot *MtvRoomStateSnapshot
+}
+
+func (p MtvRoomParameters) GetContinueAsNewEventsThreshold() int {
+	if p.ContinueAsNewEventsThreshold <= 0 {
+		return DefaultContinueAsNewEventsThreshold
+	}
+
+	return p.ContinueAsNewEventsThreshold
+}
+
+type MtvRoomPendingTracksSuggestion struct {
+	TracksIDs []string
+	// Set instead of TracksIDs when suggesting the top hit of a search
+	Query    string
+	UserID   string
+	DeviceID string
+}
+
+// TracksCount counts the searched track of a query as one track.
+func (s MtvRoomPendingTracksSuggestion) TracksCount() int {
+	if s.Query != "" {
+		return 1
+	}
+
+	return len(s.TracksIDs)
+}
+
+// MtvRoomStateSnapshot contains everything a new run of MtvRoomWorkflow
+// needs to resume a room where the previous run stopped.
+type MtvRoomStateSnapshot struct {
+	Users                 map[string]*InternalStateUser
+	Tracks                []TrackMetadataWithScore
+	CurrentTrack          CurrentTrackSnapshot
+	Playing               bool
+	DelegationOwnerUserID *string
+	TimeConstraintIsValid *bool
+
+	// Suggested tracks whose information was still being fetched during the handover.
+	PendingTracksSuggestions []MtvRoomPendingTracksSuggestion
+
+	VoteUpdateIntervalIsPending            bool
+	TracksCheckForVoteUpdateLastSave       []TrackMetadataWithScore
+	CurrentTrackCheckForVoteUpdateLastSave CurrentTrackSnapshot
+
+	CurrentTrackSkipVotesUserIDs []string
+
+	PlayedTracks               []MtvRoomPlayedTrack
+	CurrentTrackStartedAt      time.Time
+	CurrentTrackSeekedDuration time.Duration
+	TracksSuggesterUserIDs     map[string]string
+
+	BannedUserIDs []string
+
+	UsersSuggestionsTimestamps map[string][]time.Time
+
+	// Set when the auto-DJ was fetching tracks during the handover.
+	AutoDJFetchIsPending bool
+
+	IdleClocks shared.RoomIdleClocks
+}
+
+// MtvRoomSettingsUpdate holds the room settings that can be edited while the room is running.
+// Nil fields are left untouched.
+// It is checked by the room workflow, which acknowledges invalid updates with a MtvRoomSettingsUpdateFailReason.
+type MtvRoomSettingsUpdate struct {
+	RoomName                      *string `json:"name,omitempty" mapstructure:"name"`
+	MinimumScoreToBePlayed        *int    `json:"minimumScoreToBePlayed,omitempty"`
+	IsOpen                        *bool   `json:"isOpen,omitempty"`
+	IsOpenOnlyInvitedUsersCanVote *bool   `json:"isOpenOnlyInvitedUsersCanVote,omitempty"`
+}
+
+type MtvRoomSettingsUpdateFailReason string
+
+const (
+	MtvRoomSettingsUpdateFailReasonEmptyRoomName                        MtvRoomSettingsUpdateFailReason = "EMPTY_ROOM_NAME"
+	MtvRoomSettingsUpdateFailReasonNegativeMinimumScoreToBePlayed       MtvRoomSettingsUpdateFailReason = "NEGATIVE_MINIMUM_SCORE_TO_BE_PLAYED"
+	MtvRoomSettingsUpdateFailReasonOnlyInvitedUsersCanVoteInPrivateRoom MtvRoomSettingsUpdateFailReason = "ONLY_INVITED_USERS_CAN_VOTE_IN_PRIVATE_ROOM"
+)
+
+// CheckSettingsUpdateValidity only checks the settings changed by update,
+// the other parameters, such as the time constraints that may have ended since the room creation, are left out.
+func (p MtvRoomParameters) CheckSettingsUpdateValidity(update MtvRoomSettingsUpdate) (MtvRoomSettingsUpdateFailReason, bool) {
+	if update.RoomName != nil && *update.RoomName == "" {
+		return MtvRoomSettingsUpdateFailReasonEmptyRoomName, false
+	}
+
+	if update.MinimumScoreToBePlayed != nil && *update.MinimumScoreToBePlayed < 0 {
+		return MtvRoomSettingsUpdateFailReasonNegativeMinimumScoreToBePlayed, false
+	}
+
+	openingIsUpdated := update.IsOpen != nil || update.IsOpenOnlyInvitedUsersCanVote != nil
+	if openingIsUpdated {
+		updatedParams := p.WithSettingsUpdate(update)
+
+		onlyInvitedUserTrueButRoomIsNotPublic := updatedParams.IsOpenOnlyInvitedUsersCanVote && !updatedParams.IsOpen
+		if onlyInvitedUserTrueButRoomIsNotPublic {
+			return MtvRoomSettingsUpdateFailReasonOnlyInvitedUsersCanVoteInPrivateRoom, false
+		}
+	}
+
+	return "", true
+}
+
+func (p MtvRoomParameters) WithSettingsUpdate(update MtvRoomSettingsUpdate) MtvRoomParameters {
+	if update.RoomName != nil {
+		p.RoomName = *update.RoomName
+	}
+	if update.MinimumScoreToBePlayed != nil {
+		p.MinimumScoreToBePlayed = *update.MinimumScoreToBePlayed
+	}
+	if update.IsOpen != nil {
+		p.IsOpen = *update.IsOpen
+	}
+	if update.IsOpenOnlyInvitedUsersCanVote != nil {
+		p.IsOpenOnlyInvitedUsersCanVote = *update.IsOpenOnlyInvitedUsersCanVote
+	}
+
+	return p
 }
 
 //This method will return an error if it determines that params are corrupted
@@ -349,6 +817,26 @@ func (p MtvRoomParameters) CheckParamsValidity(now time.Time) error {
 		return err
 	}
 
+	skipVotesRequiredShareIsOutOfRange := p.SkipVotesRequiredShare < 0 || p.SkipVotesRequiredShare > 1
+	if skipVotesRequiredShareIsOutOfRange {
+		return errors.New("SkipVotesRequiredShare must be between 0 and 1")
+	}
+
+	suggestionsLimitsAreNegative := p.MaximumTracksListLength < 0 || p.MaximumPendingSuggestionsPerUser < 0 || p.MaximumSuggestionsPerUserPerMinute < 0
+	if suggestionsLimitsAreNegative {
+		return errors.New("suggestions limits must be positive")
+	}
+
+	if p.HasAutoDJ() {
+		if err := p.AutoDJ.CheckValidity(); err != nil {
+			return err
+		}
+	}
+
+	if err := p.IdlePolicy.CheckValidity(); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -394,6 +882,62 @@ func (p MtvRoomParameters) VerifyTimeConstraint(now time.Time) error {
 	return nil
 }
 
+// Count of played tracks returned per page by getPlayedTracks query.
+const MtvPlayedTracksPageSize = 10
+
+// Only the most recently played tracks are kept, so that the history
+// carried over each continue-as-new does not grow with the room lifetime.
+const MtvPlayedTracksHistoryMaximumLength = 200
+
+type MtvRoomPlayedTrack struct {
+	TrackMetadataWithScore
+
+	//Zero when the track has been skipped before being played at all
+	StartedAt time.Time `json:"startedAt"`
+	//Time the track has actually been played for, the parts jumped over by seeks are left out
+	PlayedDuration time.Duration `json:"playedDuration"`
+	//Sum of the jumps made by seeks, negative when the track has been seeked backward
+	SeekedDuration  time.Duration `json:"seekedDuration"`
+	Skipped         bool          `json:"skipped"`
+	SuggesterUserID string        `json:"suggesterUserID"`
+}
+
+func (t MtvRoomPlayedTrack) Export() ExposedMtvRoomPlayedTrack {
+	var startedAt *string
+	if !t.StartedAt.IsZero() {
+		formattedStartedAt := t.StartedAt.Format(time.RFC3339)
+		startedAt = &formattedStartedAt
+	}
+
+	return ExposedMtvRoomPlayedTrack{
+		TrackMetadataWithScoreWithDuration: t.WithMillisecondsDuration(),
+		StartedAt:                          startedAt,
+		PlayedDuration:                     t.PlayedDuration.Milliseconds(),
+		SeekedDuration:                     t.SeekedDuration.Milliseconds(),
+		Skipped:                            t.Skipped,
+		SuggesterUserID:                    t.SuggesterUserID,
+	}
+}
+
+type ExposedMtvRoomPlayedTrack struct {
+	TrackMetadataWithScoreWithDuration
+
+	//Dates are stored using time.Time.Format()
+	StartedAt       *string `json:"startedAt"`
+	PlayedDuration  int64   `json:"playedDuration"`
+	SeekedDuration  int64   `json:"seekedDuration"`
+	Skipped         bool    `json:"skipped"`
+	SuggesterUserID string  `json:"suggesterUserID"`
+}
+
+//Played tracks are listed from the most recently played one
+type MtvRoomPlayedTracksPage struct {
+	Page         int                         `json:"page"`
+	HasMore      bool                        `json:"hasMore"`
+	TotalEntries int                         `json:"totalEntries"`
+	Data         []ExposedMtvRoomPlayedTrack `json:"data"`
+}
+
 type MtvRoomConstraintsDetails struct {
 	RoomID                     string        `json:"roomID" validate:"required"`
 	PhysicalConstraintPosition MtvRoomCoords `json:"physicalConstraintPosition" validate:"required"`
@@ -419,6 +963,10 @@ type MtvRoomExposedState struct {
 	TimeConstraintIsValid             *bool                                `json:"timeConstraintIsValid"`
 	PlayingMode                       MtvPlayingModes                      `json:"playingMode"`
 	DelegationOwnerUserID             *string                              `json:"delegationOwnerUserID"`
+	HasDownvotes                      bool                                 `json:"hasDownvotes"`
+	MinimumScoreToStayInQueue         int                                  `json:"minimumScoreToStayInQueue"`
+	CurrentTrackSkipVotes             int                                  `json:"currentTrackSkipVotes"`
+	SkipVotesRequired                 int                                  `json:"skipVotesRequired"`
 }
 
 const (
@@ -431,6 +979,14 @@ const (
 	SignalRouteChangeUserEmittingDevice        shared.SignalRoute = "change-user-emitting-device"
 	SignalRouteSuggestTracks                   shared.SignalRoute = "suggest-tracks"
 	SignalRouteVoteForTrack                    shared.SignalRoute = "vote-for-track"
+	SignalRouteUnvoteForTrack                  shared.SignalRoute = "unvote-for-track"
+	SignalRouteDownvoteForTrack                shared.SignalRoute = "downvote-for-track"
+	SignalRouteVoteToSkipCurrentTrack          shared.SignalRoute = "vote-to-skip-current-track"
+	SignalRouteSeek                            shared.SignalRoute = "seek"
+	SignalRouteKickUser                        shared.SignalRoute = "kick-user"
+	SignalRouteBanUser                         shared.SignalRoute = "ban-user"
+	SignalRouteUpdateRoomSettings              shared.SignalRoute = "update-room-settings"
+	SignalRouteRemoveTracks                    shared.SignalRoute = "remove-tracks"
 	SignalUpdateUserFitsPositionConstraint     shared.SignalRoute = "update-user-fits-position-constraint"
 	SignalUpdateDelegationOwner                shared.SignalRoute = "update-delegation-owner"
 	SignalUpdateControlAndDelegationPermission shared.SignalRoute = "update-control-and-delegation-permision"
@@ -534,6 +1090,42 @@ func NewGoToNexTrackSignal(args NewGoToNextTrackSignalArgs) GoToNextTrackSignal
 	}
 }
 
+type SeekSignal struct {
+	Route  shared.SignalRoute `validate:"required"`
+	UserID string             `validate:"required,uuid"`
+	//Position to reach in the current track
+	Offset time.Duration `validate:"min=0"`
+}
+
+type NewSeekSignalArgs struct {
+	UserID string        `validate:"required,uuid"`
+	Offset time.Duration `validate:"min=0"`
+}
+
+func NewSeekSignal(args NewSeekSignalArgs) SeekSignal {
+	return SeekSignal{
+		Route:  SignalRouteSeek,
+		UserID: args.UserID,
+		Offset: args.Offset,
+	}
+}
+
+type VoteToSkipCurrentTrackSignal struct {
+	Route  shared.SignalRoute `validate:"required"`
+	UserID string             `validate:"required,uuid"`
+}
+
+type NewVoteToSkipCurrentTrackSignalArgs struct {
+	UserID string `validate:"required,uuid"`
+}
+
+func NewVoteToSkipCurrentTrackSignal(args NewVoteToSkipCurrentTrackSignalArgs) VoteToSkipCurrentTrackSignal {
+	return VoteToSkipCurrentTrackSignal{
+		Route:  SignalRouteVoteToSkipCurrentTrack,
+		UserID: args.UserID,
+	}
+}
+
 type ChangeUserEmittingDeviceSignal struct {
 	Route    shared.SignalRoute `validate:"required"`
 	UserID   string             `validate:"required,uuid"`
@@ -553,15 +1145,18 @@ func NewChangeUserEmittingDeviceSignal(args ChangeUserEmittingDeviceSignalArgs)
 	}
 }
 
+// SuggestTracksSignal suggests either TracksToSuggest or the top hit of the search of Query.
 type SuggestTracksSignal struct {
 	Route           shared.SignalRoute `validate:"required"`
-	TracksToSuggest []string           `validate:"required,dive,required"`
+	TracksToSuggest []string           `validate:"required_without=Query,excluded_with=Query,dive,required"`
+	Query           string             `validate:"max=200"`
 	UserID          string             `validate:"required,uuid"`
 	DeviceID        string             `validate:"required,uuid"`
 }
 
 type SuggestTracksSignalArgs struct {
 	TracksToSuggest []string
+	Query           string
 	UserID          string
 	DeviceID        string
 }
@@ -570,6 +1165,7 @@ func NewSuggestTracksSignal(args SuggestTracksSignalArgs) SuggestTracksSignal {
 	return SuggestTracksSignal{
 		Route:           SignalRouteSuggestTracks,
 		TracksToSuggest: args.TracksToSuggest,
+		Query:           args.Query,
 		UserID:          args.UserID,
 		DeviceID:        args.DeviceID,
 	}
@@ -594,6 +1190,44 @@ func NewVoteForTrackSignal(args NewVoteForTrackSignalArgs) VoteForTrackSignal {
 	}
 }
 
+type UnvoteForTrackSignal struct {
+	Route   shared.SignalRoute `validate:"required"`
+	UserID  string             `validate:"required,uuid"`
+	TrackID string             `validate:"required"`
+}
+
+type NewUnvoteForTrackSignalArgs struct {
+	UserID  string `validate:"required,uuid"`
+	TrackID string `validate:"required"`
+}
+
+func NewUnvoteForTrackSignal(args NewUnvoteForTrackSignalArgs) UnvoteForTrackSignal {
+	return UnvoteForTrackSignal{
+		Route:   SignalRouteUnvoteForTrack,
+		TrackID: args.TrackID,
+		UserID:  args.UserID,
+	}
+}
+
+type DownvoteForTrackSignal struct {
+	Route   shared.SignalRoute `validate:"required"`
+	UserID  string             `validate:"required,uuid"`
+	TrackID string             `validate:"required"`
+}
+
+type NewDownvoteForTrackSignalArgs struct {
+	UserID  string `validate:"required,uuid"`
+	TrackID string `validate:"required"`
+}
+
+func NewDownvoteForTrackSignal(args NewDownvoteForTrackSignalArgs) DownvoteForTrackSignal {
+	return DownvoteForTrackSignal{
+		Route:   SignalRouteDownvoteForTrack,
+		TrackID: args.TrackID,
+		UserID:  args.UserID,
+	}
+}
+
 type UpdateUserFitsPositionConstraintSignal struct {
 	Route                      shared.SignalRoute `validate:"required"`
 	UserID                     string             `validate:"required,uuid"`
@@ -632,6 +1266,82 @@ func NewUpdateDelegationOwnerSignal(args NewUpdateDelegationOwnerSignalArgs) Upd
 	}
 }
 
+type KickUserSignal struct {
+	Route         shared.SignalRoute `validate:"required"`
+	KickedUserID  string             `validate:"required,uuid"`
+	EmitterUserID string             `validate:"required,uuid"`
+}
+
+type NewKickUserSignalArgs struct {
+	KickedUserID  string `validate:"required,uuid"`
+	EmitterUserID string `validate:"required,uuid"`
+}
+
+func NewKickUserSignal(args NewKickUserSignalArgs) KickUserSignal {
+	return KickUserSignal{
+		Route:         SignalRouteKickUser,
+		KickedUserID:  args.KickedUserID,
+		EmitterUserID: args.EmitterUserID,
+	}
+}
+
+type BanUserSignal struct {
+	Route         shared.SignalRoute `validate:"required"`
+	BannedUserID  string             `validate:"required,uuid"`
+	EmitterUserID string             `validate:"required,uuid"`
+}
+
+type NewBanUserSignalArgs struct {
+	BannedUserID  string `validate:"required,uuid"`
+	EmitterUserID string `validate:"required,uuid"`
+}
+
+func NewBanUserSignal(args NewBanUserSignalArgs) BanUserSignal {
+	return BanUserSignal{
+		Route:         SignalRouteBanUser,
+		BannedUserID:  args.BannedUserID,
+		EmitterUserID: args.EmitterUserID,
+	}
+}
+
+type RemoveTracksSignal struct {
+	Route     shared.SignalRoute `validate:"required"`
+	UserID    string             `validate:"required,uuid"`
+	TracksIDs []string           `validate:"required,min=1,dive,required"`
+}
+
+type NewRemoveTracksSignalArgs struct {
+	UserID    string   `validate:"required,uuid"`
+	TracksIDs []string `validate:"required,min=1,dive,required"`
+}
+
+func NewRemoveTracksSignal(args NewRemoveTracksSignalArgs) RemoveTracksSignal {
+	return RemoveTracksSignal{
+		Route:     SignalRouteRemoveTracks,
+		UserID:    args.UserID,
+		TracksIDs: args.TracksIDs,
+	}
+}
+
+type UpdateRoomSettingsSignal struct {
+	Route    shared.SignalRoute    `validate:"required"`
+	UserID   string                `validate:"required,uuid"`
+	Settings MtvRoomSettingsUpdate `validate:"required"`
+}
+
+type NewUpdateRoomSettingsSignalArgs struct {
+	UserID   string                `validate:"required,uuid"`
+	Settings MtvRoomSettingsUpdate `validate:"required"`
+}
+
+func NewUpdateRoomSettingsSignal(args NewUpdateRoomSettingsSignalArgs) UpdateRoomSettingsSignal {
+	return UpdateRoomSettingsSignal{
+		Route:    SignalRouteUpdateRoomSettings,
+		UserID:   args.UserID,
+		Settings: args.Settings,
+	}
+}
+
 type UpdateControlAndDelegationPermissionSignal struct {
 	Route                             shared.SignalRoute `validate:"required"`
 	ToUpdateUserID                    string             `validate:"required,uuid"`
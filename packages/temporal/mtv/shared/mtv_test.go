@@ -1,6 +1,9 @@
 package shared_mtv_test
 
 import (
+	"fmt"
+	"math/rand"
+	"sort"
 	"testing"
 
 	shared_mtv "github.com/AdonisEnProvence/MusicRoom/mtv/shared"
@@ -82,6 +85,263 @@ func (s *UnitTestSuite) Test_TracksMetadataWithScoreSetAllowsDeletion() {
 	s.NotSame(&set.Values()[0], &clone.Values()[0])
 }
 
+func (s *UnitTestSuite) Test_TracksMetadataWithScoreSetSortsByNetScore() {
+	var (
+		set       shared_mtv.TracksMetadataWithScoreSet
+		setValues = []shared_mtv.TrackMetadataWithScore{
+			{
+				TrackMetadata: shared.TrackMetadata{
+					ID:         faker.UUIDHyphenated(),
+					Title:      faker.Word(),
+					ArtistName: faker.Name(),
+					Duration:   random.GenerateRandomDuration(),
+				},
+
+				Score: 2,
+			},
+			{
+				TrackMetadata: shared.TrackMetadata{
+					ID:         faker.UUIDHyphenated(),
+					Title:      faker.Word(),
+					ArtistName: faker.Name(),
+					Duration:   random.GenerateRandomDuration(),
+				},
+
+				Score: 1,
+			},
+		}
+	)
+
+	set.Add(setValues[0])
+	set.Add(setValues[1])
+
+	set.DownvoteTrackAndSortTracks(setValues[0].ID)
+	set.DownvoteTrackAndSortTracks(setValues[0].ID)
+
+	firstTrack := set.GetByIndex(0)
+	s.Equal(setValues[1].ID, firstTrack.ID)
+
+	secondTrack := set.GetByIndex(1)
+	s.Equal(setValues[0].ID, secondTrack.ID)
+	s.Equal(0, secondTrack.Score)
+	s.Equal(2, secondTrack.Downvotes)
+	s.Equal(2, secondTrack.Upvotes())
+	s.False(set.FirstTrackIsReadyToBePlayed(2))
+
+	set.RemoveTrackDownvoteAndSortTracks(setValues[0].ID)
+
+	// Tracks with the same net score keep their relative order
+	s.Equal(setValues[1].ID, set.GetByIndex(0).ID)
+	s.Equal(setValues[0].ID, set.GetByIndex(1).ID)
+	s.Equal(1, set.GetByIndex(1).Downvotes)
+
+	set.RemoveTrackDownvoteAndSortTracks(setValues[0].ID)
+
+	s.Equal(setValues[0].ID, set.GetByIndex(0).ID)
+	s.Equal(0, set.GetByIndex(0).Downvotes)
+	s.True(set.FirstTrackIsReadyToBePlayed(2))
+}
+
+func (s *UnitTestSuite) Test_TracksSuggestedBySystemArePlayedOnceNoOtherTrackIsReady() {
+	var (
+		set         shared_mtv.TracksMetadataWithScoreSet
+		userTrack   = generateTrackMetadataWithScore(1)
+		systemTrack = generateTrackMetadataWithScore(0)
+	)
+	systemTrack.SuggestedBySystem = true
+
+	set.Add(userTrack)
+	s.False(set.NextTrackIsReadyToBePlayed(2))
+
+	_, shifted := set.ShiftNextTrackToPlay(2)
+	s.False(shifted)
+
+	set.Add(systemTrack)
+	s.True(set.NextTrackIsReadyToBePlayed(2))
+
+	// A track of the users reaching the minimum score comes first
+	set.IncrementTrackScoreAndSortTracks(userTrack.ID)
+
+	nextTrack, shifted := set.ShiftNextTrackToPlay(2)
+	s.True(shifted)
+	s.Equal(userTrack.ID, nextTrack.ID)
+
+	nextTrack, shifted = set.ShiftNextTrackToPlay(2)
+	s.True(shifted)
+	s.Equal(systemTrack.ID, nextTrack.ID)
+	s.Equal(0, set.Len())
+}
+
+func generateTrackMetadataWithScore(score int) shared_mtv.TrackMetadataWithScore {
+	return shared_mtv.TrackMetadataWithScore{
+		TrackMetadata: shared.TrackMetadata{
+			ID:         faker.UUIDHyphenated(),
+			Title:      faker.Word(),
+			ArtistName: faker.Name(),
+			Duration:   random.GenerateRandomDuration(),
+		},
+
+		Score: score,
+	}
+}
+
+func (s *UnitTestSuite) Test_TracksMetadataWithScoreSetKeepsStableSortOrder() {
+	var (
+		set         shared_mtv.TracksMetadataWithScoreSet
+		tracksCount = 50
+		operations  = 2000
+	)
+
+	expectedTracks := generateTracksMetadataWithScore(tracksCount)
+	for _, track := range expectedTracks {
+		set.Add(track)
+	}
+
+	random := rand.New(rand.NewSource(42))
+	for i := 0; i < operations; i++ {
+		trackID := expectedTracks[random.Intn(len(expectedTracks))].ID
+
+		expectedIndex := -1
+		for index, track := range expectedTracks {
+			if track.ID == trackID {
+				expectedIndex = index
+				break
+			}
+		}
+
+		switch operation := random.Intn(10); {
+		case operation < 4:
+			set.IncrementTrackScoreAndSortTracks(trackID)
+			expectedTracks[expectedIndex].Score++
+		case operation < 7:
+			set.DecrementTrackScoreAndSortTracks(trackID)
+			expectedTracks[expectedIndex].Score--
+		case operation < 8:
+			set.DownvoteTrackAndSortTracks(trackID)
+			expectedTracks[expectedIndex].Score--
+			expectedTracks[expectedIndex].Downvotes++
+		case operation < 9:
+			set.RemoveTrackDownvoteAndSortTracks(trackID)
+			expectedTracks[expectedIndex].Score++
+			expectedTracks[expectedIndex].Downvotes--
+		default:
+			// Replaces the first track by a new one as when a track starts being played,
+			// as before, added tracks are only sorted with the next score update
+			shiftedTrack, _ := set.Shift()
+			s.Equal(expectedTracks[0].ID, shiftedTrack.ID)
+			s.False(set.Has(shiftedTrack.ID))
+
+			newTrack := generateTracksMetadataWithScore(1)[0]
+			expectedTracks = append(expectedTracks[1:], newTrack)
+			set.Add(newTrack)
+
+			s.Equal(expectedTracks, set.Values())
+			continue
+		}
+
+		sort.SliceStable(expectedTracks, func(i, j int) bool { return expectedTracks[i].Score > expectedTracks[j].Score })
+
+		s.Equal(expectedTracks, set.Values())
+	}
+
+	for expectedIndex, track := range expectedTracks {
+		index, exists := set.IndexOf(track.ID)
+
+		s.True(exists)
+		s.Equal(expectedIndex, index)
+	}
+}
+
+func (s *UnitTestSuite) Test_TracksMetadataWithScoreSetDeepEqualComparesWholeMetadata() {
+	var set shared_mtv.TracksMetadataWithScoreSet
+
+	track := generateTracksMetadataWithScore(1)[0]
+	track.Thumbnails.Default = &shared.TrackThumbnail{
+		URL:    faker.URL(),
+		Width:  120,
+		Height: 90,
+	}
+	track.Restrictions.BlockedRegions = []string{"FR"}
+	set.Add(track)
+
+	clonedSet := set.Clone()
+	s.True(set.DeepEqual(clonedSet))
+
+	updatedTrack := track
+	updatedTrack.Thumbnails.Default = &shared.TrackThumbnail{
+		URL:    track.Thumbnails.Default.URL,
+		Width:  120,
+		Height: 90,
+	}
+	var updatedSet shared_mtv.TracksMetadataWithScoreSet
+	updatedSet.Add(updatedTrack)
+	s.True(set.DeepEqual(updatedSet))
+
+	updatedTrack.Restrictions.BlockedRegions = []string{"FR", "DE"}
+	updatedSet.Clear()
+	updatedSet.Add(updatedTrack)
+	s.False(set.DeepEqual(updatedSet))
+}
+
 func TestUnitTestSuite(t *testing.T) {
 	suite.Run(t, new(UnitTestSuite))
 }
+
+func generateTracksMetadataWithScore(count int) []shared_mtv.TrackMetadataWithScore {
+	tracks := make([]shared_mtv.TrackMetadataWithScore, 0, count)
+	for i := 0; i < count; i++ {
+		tracks = append(tracks, shared_mtv.TrackMetadataWithScore{
+			TrackMetadata: shared.TrackMetadata{
+				ID:         faker.UUIDHyphenated(),
+				Title:      faker.Word(),
+				ArtistName: faker.Name(),
+				Duration:   random.GenerateRandomDuration(),
+			},
+
+			Score: 1,
+		})
+	}
+
+	return tracks
+}
+
+var benchmarkedTracksCounts = []int{100, 1000, 10000}
+
+func BenchmarkTracksMetadataWithScoreSetHas(b *testing.B) {
+	for _, tracksCount := range benchmarkedTracksCounts {
+		b.Run(fmt.Sprintf("%d tracks", tracksCount), func(b *testing.B) {
+			var set shared_mtv.TracksMetadataWithScoreSet
+
+			tracks := generateTracksMetadataWithScore(tracksCount)
+			for _, track := range tracks {
+				set.Add(track)
+			}
+
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				set.Has(tracks[i%tracksCount].ID)
+			}
+		})
+	}
+}
+
+func BenchmarkTracksMetadataWithScoreSetVote(b *testing.B) {
+	for _, tracksCount := range benchmarkedTracksCounts {
+		b.Run(fmt.Sprintf("%d tracks", tracksCount), func(b *testing.B) {
+			var set shared_mtv.TracksMetadataWithScoreSet
+
+			tracks := generateTracksMetadataWithScore(tracksCount)
+			for _, track := range tracks {
+				set.Add(track)
+			}
+
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				trackID := tracks[i%tracksCount].ID
+
+				set.IncrementTrackScoreAndSortTracks(trackID)
+				set.DecrementTrackScoreAndSortTracks(trackID)
+			}
+		})
+	}
+}
@@ -3,6 +3,7 @@ package mtv
 import (
 	"errors"
 	"fmt"
+	"math"
 	"time"
 
 	"github.com/AdonisEnProvence/MusicRoom/activities"
@@ -32,6 +33,25 @@ type MtvRoomInternalState struct {
 	CurrentTrackCheckForVoteUpdateLastSave shared_mtv.CurrentTrack
 	timeConstraintIsValid                  *bool
 	DelegationOwnerUserID                  *string
+	// Users who voted to skip the current track, reset each time the current track changes.
+	currentTrackSkipVotesUserIDs []string
+	// Ordered from the first played track to the last one.
+	// Holds at most MtvPlayedTracksHistoryMaximumLength tracks.
+	playedTracks []shared_mtv.MtvRoomPlayedTrack
+	// Zero until the current track starts being played.
+	currentTrackStartedAt time.Time
+	// Sum of the jumps made by seeks in the current track, negative when seeking backward.
+	currentTrackSeekedDuration time.Duration
+	// Maps the tracks of the tracks list and the current track to the user who suggested them.
+	tracksSuggesterUserIDs map[string]string
+	// Banned users can not join the room anymore.
+	bannedUserIDs []string
+	// Times at which each user suggested tracks, one entry per suggested track.
+	// Only filled when the room limits the suggestions rate.
+	usersSuggestionsTimestamps map[string][]time.Time
+	// Set while the machine enters its initial state after a continue-as-new.
+	// Clients are already up to date, no activity must be sent to them.
+	isRestoringFromSnapshot bool
 }
 
 //This method will merge given params in the internalState
@@ -41,6 +61,8 @@ func (s *MtvRoomInternalState) FillWith(params shared_mtv.MtvRoomParameters) {
 	s.AddUser(*params.CreatorUserRelatedInformation)
 	s.DelegationOwnerUserID = nil
 	s.timeConstraintIsValid = nil
+	s.tracksSuggesterUserIDs = make(map[string]string)
+	s.usersSuggestionsTimestamps = make(map[string][]time.Time)
 
 	if params.PlayingMode == shared_mtv.MtvPlayingModeDirect {
 		s.DelegationOwnerUserID = &params.RoomCreatorUserID
@@ -51,6 +73,72 @@ func (s *MtvRoomInternalState) FillWith(params shared_mtv.MtvRoomParameters) {
 	}
 }
 
+//This method will restore the internalState as it was at the end of the previous workflow run
+func (s *MtvRoomInternalState) RestoreFrom(snapshot shared_mtv.MtvRoomStateSnapshot) {
+	s.Users = make(map[string]*shared_mtv.InternalStateUser)
+	for _, user := range snapshot.Users {
+		s.AddUser(*user)
+	}
+
+	s.Tracks.Clear()
+	for _, track := range snapshot.Tracks {
+		s.Tracks.Add(track)
+	}
+
+	s.CurrentTrack = snapshot.CurrentTrack.Restore()
+	s.Playing = snapshot.Playing
+	s.DelegationOwnerUserID = snapshot.DelegationOwnerUserID
+	s.timeConstraintIsValid = snapshot.TimeConstraintIsValid
+
+	s.TracksCheckForVoteUpdateLastSave.Clear()
+	for _, track := range snapshot.TracksCheckForVoteUpdateLastSave {
+		s.TracksCheckForVoteUpdateLastSave.Add(track)
+	}
+	s.CurrentTrackCheckForVoteUpdateLastSave = snapshot.CurrentTrackCheckForVoteUpdateLastSave.Restore()
+	s.currentTrackSkipVotesUserIDs = snapshot.CurrentTrackSkipVotesUserIDs
+	s.playedTracks = trimPlayedTracks(snapshot.PlayedTracks)
+	s.currentTrackStartedAt = snapshot.CurrentTrackStartedAt
+	s.currentTrackSeekedDuration = snapshot.CurrentTrackSeekedDuration
+	if snapshot.TracksSuggesterUserIDs != nil {
+		s.tracksSuggesterUserIDs = snapshot.TracksSuggesterUserIDs
+	}
+	s.bannedUserIDs = snapshot.BannedUserIDs
+	if snapshot.UsersSuggestionsTimestamps != nil {
+		s.usersSuggestionsTimestamps = snapshot.UsersSuggestionsTimestamps
+	}
+}
+
+// Snapshot returns the part of the internalState that has to be carried over to the next workflow run.
+// If the current track is being played, its elapsed time is computed against given now.
+func (s *MtvRoomInternalState) Snapshot(now time.Time) shared_mtv.MtvRoomStateSnapshot {
+	currentTrack := s.CurrentTrack
+	if s.Playing && !s.Timer.CreatedOn.IsZero() {
+		currentTrack.AlreadyElapsed += now.Sub(s.Timer.CreatedOn)
+
+		if currentTrack.AlreadyElapsed > currentTrack.Duration {
+			currentTrack.AlreadyElapsed = currentTrack.Duration
+		}
+	}
+
+	return shared_mtv.MtvRoomStateSnapshot{
+		Users:                                  s.Users,
+		Tracks:                                 s.Tracks.Values(),
+		CurrentTrack:                           currentTrack.Snapshot(),
+		Playing:                                s.Playing,
+		DelegationOwnerUserID:                  s.DelegationOwnerUserID,
+		TimeConstraintIsValid:                  s.timeConstraintIsValid,
+		TracksCheckForVoteUpdateLastSave:       s.TracksCheckForVoteUpdateLastSave.Values(),
+		CurrentTrackCheckForVoteUpdateLastSave: s.CurrentTrackCheckForVoteUpdateLastSave.Snapshot(),
+		CurrentTrackSkipVotesUserIDs:           s.currentTrackSkipVotesUserIDs,
+		PlayedTracks:                           s.playedTracks,
+		CurrentTrackStartedAt:                  s.currentTrackStartedAt,
+		CurrentTrackSeekedDuration:             s.currentTrackSeekedDuration,
+		TracksSuggesterUserIDs:                 s.tracksSuggesterUserIDs,
+		BannedUserIDs:                          s.bannedUserIDs,
+		UsersSuggestionsTimestamps:             s.recentUsersSuggestionsTimestamps(now),
+	}
+}
+
 // In the internalState.Export method we do not use workflow.sideEffect for at least two reasons:
 // 1- we cannot use workflow.sideEffect in the getState queryHandler
 // 2- we never update our internalState depending on internalState.Export() results this data aims to be sent to adonis.
@@ -93,11 +181,157 @@ func (s *MtvRoomInternalState) Export(RelatedUserID string) shared_mtv.MtvRoomEx
 		IsOpen:                            s.initialParams.IsOpen,
 		IsOpenOnlyInvitedUsersCanVotes:    s.initialParams.IsOpenOnlyInvitedUsersCanVote,
 		DelegationOwnerUserID:             s.DelegationOwnerUserID,
+		HasDownvotes:                      s.initialParams.HasDownvotes,
+		MinimumScoreToStayInQueue:         s.initialParams.MinimumScoreToStayInQueue,
+		CurrentTrackSkipVotes:             s.CountCurrentTrackSkipVotes(),
+		SkipVotesRequired:                 s.GetRequiredSkipVotes(),
 	}
 
 	return exposedState
 }
 
+// SeekCurrentTrack moves the current track from position to offset,
+// the jump is recorded so that it is not counted as played time.
+func (s *MtvRoomInternalState) SeekCurrentTrack(position time.Duration, offset time.Duration) {
+	s.currentTrackSeekedDuration += offset - position
+	s.CurrentTrack.AlreadyElapsed = offset
+}
+
+//The current track is considered as skipped when it has not been played until its end
+func (s *MtvRoomInternalState) AddCurrentTrackToPlayedTracks() {
+	position := s.CurrentTrack.AlreadyElapsed
+	if position > s.CurrentTrack.Duration {
+		position = s.CurrentTrack.Duration
+	}
+
+	playedDuration := position - s.currentTrackSeekedDuration
+	if playedDuration < 0 {
+		playedDuration = 0
+	}
+	if playedDuration > s.CurrentTrack.Duration {
+		playedDuration = s.CurrentTrack.Duration
+	}
+
+	playedTrack := shared_mtv.MtvRoomPlayedTrack{
+		TrackMetadataWithScore: s.CurrentTrack.TrackMetadataWithScore,
+		StartedAt:              s.currentTrackStartedAt,
+		PlayedDuration:         playedDuration,
+		SeekedDuration:         s.currentTrackSeekedDuration,
+		Skipped:                position < s.CurrentTrack.Duration,
+		SuggesterUserID:        s.tracksSuggesterUserIDs[s.CurrentTrack.ID],
+	}
+
+	s.playedTracks = trimPlayedTracks(append(s.playedTracks, playedTrack))
+	delete(s.tracksSuggesterUserIDs, s.CurrentTrack.ID)
+	s.currentTrackStartedAt = time.Time{}
+	s.currentTrackSeekedDuration = 0
+}
+
+//Drops the oldest played tracks exceeding the history maximum length
+func trimPlayedTracks(playedTracks []shared_mtv.MtvRoomPlayedTrack) []shared_mtv.MtvRoomPlayedTrack {
+	exceedingTracksCount := len(playedTracks) - shared_mtv.MtvPlayedTracksHistoryMaximumLength
+	if exceedingTracksCount <= 0 {
+		return playedTracks
+	}
+
+	return append([]shared_mtv.MtvRoomPlayedTrack(nil), playedTracks[exceedingTracksCount:]...)
+}
+
+// AutoDJNeedsTracks is true when the auto-DJ is enabled, no track is ready to be played
+// and the tracks list has room for system suggestions.
+func (s *MtvRoomInternalState) AutoDJNeedsTracks() bool {
+	if !s.initialParams.HasAutoDJ() {
+		return false
+	}
+
+	if s.Tracks.NextTrackIsReadyToBePlayed(s.initialParams.MinimumScoreToBePlayed) {
+		return false
+	}
+
+	tracksListIsFull := s.initialParams.MaximumTracksListLength > 0 && s.Tracks.Len() >= s.initialParams.MaximumTracksListLength
+
+	return !tracksListIsFull
+}
+
+func (s *MtvRoomInternalState) AutoDJTracksArgs() activities.FetchAutoDJTracksArgs {
+	queuedTracks := s.Tracks.Values()
+	queuedTracksIDs := make([]string, 0, len(queuedTracks))
+	for _, track := range queuedTracks {
+		queuedTracksIDs = append(queuedTracksIDs, track.ID)
+	}
+
+	playedTracksIDs := make([]string, 0, len(s.playedTracks))
+	for _, playedTrack := range s.playedTracks {
+		playedTracksIDs = append(playedTracksIDs, playedTrack.ID)
+	}
+
+	return activities.FetchAutoDJTracksArgs{
+		Options:         *s.initialParams.AutoDJ,
+		CurrentTrack:    s.CurrentTrack.TrackMetadata,
+		QueuedTracksIDs: queuedTracksIDs,
+		PlayedTracksIDs: playedTracksIDs,
+		Policy:          s.initialParams.PlayabilityPolicy,
+		CreatorUserID:   s.initialParams.RoomCreatorUserID,
+	}
+}
+
+// AddAutoDJTracks queues the tracks as system suggestions, they are attributed to no user
+// and nobody votes for them. It returns the number of added tracks.
+func (s *MtvRoomInternalState) AddAutoDJTracks(tracks []shared.TrackMetadata) int {
+	addedTracksCount := 0
+
+	for _, track := range tracks {
+		tracksListIsFull := s.initialParams.MaximumTracksListLength > 0 && s.Tracks.Len() >= s.initialParams.MaximumTracksListLength
+		if tracksListIsFull {
+			break
+		}
+
+		if s.CurrentTrack.ID == track.ID {
+			continue
+		}
+
+		added := s.Tracks.Add(shared_mtv.TrackMetadataWithScore{
+			TrackMetadata: track,
+
+			Score:             0,
+			SuggestedBySystem: true,
+		})
+		if added {
+			addedTracksCount++
+		}
+	}
+
+	return addedTracksCount
+}
+
+//Given page starts at 1, the most recently played track comes first
+func (s *MtvRoomInternalState) ExportPlayedTracksPage(page int) shared_mtv.MtvRoomPlayedTracksPage {
+	if page < 1 {
+		page = 1
+	}
+
+	totalEntries := len(s.playedTracks)
+	pageStart := (page - 1) * shared_mtv.MtvPlayedTracksPageSize
+	pageEnd := pageStart + shared_mtv.MtvPlayedTracksPageSize
+	if pageEnd > totalEntries {
+		pageEnd = totalEntries
+	}
+
+	data := make([]shared_mtv.ExposedMtvRoomPlayedTrack, 0, shared_mtv.MtvPlayedTracksPageSize)
+	for index := pageStart; index < pageEnd; index++ {
+		playedTrack := s.playedTracks[totalEntries-index-1]
+
+		data = append(data, playedTrack.Export())
+	}
+
+	return shared_mtv.MtvRoomPlayedTracksPage{
+		Page:         page,
+		HasMore:      pageEnd < totalEntries,
+		TotalEntries: totalEntries,
+		Data:         data,
+	}
+}
+
 func (s *MtvRoomInternalState) AddUser(user shared_mtv.InternalStateUser) {
 	//Do not override user if already exist
 	if _, ok := s.Users[user.UserID]; !ok {
@@ -109,16 +343,8 @@ func (s *MtvRoomInternalState) AddUser(user shared_mtv.InternalStateUser) {
 
 func (s *MtvRoomInternalState) RemoveTrackFromUserTracksVotedFor(trackID string) {
 	for _, user := range s.Users {
-		lastTracksVotedForElementIndex := len(user.TracksVotedFor) - 1
-
-		for index, trackVotedForID := range user.TracksVotedFor {
-			if trackVotedForID == trackID {
-				//remove element from slice
-				user.TracksVotedFor[index] = user.TracksVotedFor[lastTracksVotedForElementIndex]
-				user.TracksVotedFor = user.TracksVotedFor[:lastTracksVotedForElementIndex]
-				break
-			}
-		}
+		user.RemoveTrackVotedFor(trackID)
+		user.RemoveTrackDownvotedFor(trackID)
 	}
 }
 
@@ -140,20 +366,15 @@ func (s *MtvRoomInternalState) UpdateUserFitsPositionConstraint(userID string, u
 	return false
 }
 
-func (s *MtvRoomInternalState) UserVoteForTrack(userID string, trackID string) bool {
-
-	user, exists := s.Users[userID]
-	if !exists {
-		fmt.Println("vote aborted: couldnt find given userID in the users list")
-		return false
-	}
-
+//This method checks that the given user is allowed to vote regarding room constraints and invitations
+//operationName is used to prefix logs
+func (s *MtvRoomInternalState) userCanVote(user *shared_mtv.InternalStateUser, operationName string) bool {
 	if s.initialParams.HasPhysicalAndTimeConstraints {
 		timeConstraintIsNotValid := s.timeConstraintIsValid == nil || !*(s.timeConstraintIsValid)
 		userPositionConstraintIsNotValid := user.UserFitsPositionConstraint == nil || !*(user.UserFitsPositionConstraint)
 
 		if timeConstraintIsNotValid || userPositionConstraintIsNotValid {
-			fmt.Printf("\nvote aborted: user doesnt fit room constraint. timeConstraintIsNotValid=%t userPositionConstraintIsNotValid=%t \n", timeConstraintIsNotValid, userPositionConstraintIsNotValid)
+			fmt.Printf("\n%s aborted: user doesnt fit room constraint. timeConstraintIsNotValid=%t userPositionConstraintIsNotValid=%t \n", operationName, timeConstraintIsNotValid, userPositionConstraintIsNotValid)
 			return false
 		}
 	}
@@ -165,20 +386,35 @@ func (s *MtvRoomInternalState) UserVoteForTrack(userID string, trackID string) b
 
 		userIsNeitherInvitedOrCreator := userIsNotRoomCreator && userHasNotBeenInvited
 		if userIsNeitherInvitedOrCreator {
-			fmt.Println("vote aborted: room is open and only invited users can vote, voting user has not been invited")
+			fmt.Printf("%s aborted: room is open and only invited users can vote, voting user has not been invited\n", operationName)
 			return false
 		}
 	}
 
+	return true
+}
+
+func (s *MtvRoomInternalState) UserVoteForTrack(userID string, trackID string) bool {
+
+	user, exists := s.Users[userID]
+	if !exists {
+		fmt.Println("vote aborted: couldnt find given userID in the users list")
+		return false
+	}
+
+	if !s.userCanVote(user, "vote") {
+		return false
+	}
+
 	couldFindTrackInTracksList := s.Tracks.Has(trackID)
 	if !couldFindTrackInTracksList {
 		fmt.Println("vote aborted: couldnt find given trackID in the tracks list")
 		return false
 	}
 
-	userAlreadyVotedForTrack := user.HasVotedFor(trackID)
+	userAlreadyVotedForTrack := user.HasVotedFor(trackID) || user.HasDownvotedFor(trackID)
 	if userAlreadyVotedForTrack {
-		fmt.Println("vote aborted: given userID has already voted for given trackID")
+		fmt.Println("vote aborted: given userID has already voted or downvoted for given trackID")
 		return false
 	}
 
@@ -189,6 +425,180 @@ func (s *MtvRoomInternalState) UserVoteForTrack(userID string, trackID string) b
 	return true
 }
 
+func (s *MtvRoomInternalState) UserUnvoteForTrack(userID string, trackID string) bool {
+
+	user, exists := s.Users[userID]
+	if !exists {
+		fmt.Println("unvote aborted: couldnt find given userID in the users list")
+		return false
+	}
+
+	if !s.userCanVote(user, "unvote") {
+		return false
+	}
+
+	couldFindTrackInTracksList := s.Tracks.Has(trackID)
+	if !couldFindTrackInTracksList {
+		fmt.Println("unvote aborted: couldnt find given trackID in the tracks list")
+		return false
+	}
+
+	if userHasDownvotedForTrack := user.HasDownvotedFor(trackID); userHasDownvotedForTrack {
+		user.RemoveTrackDownvotedFor(trackID)
+
+		s.Tracks.RemoveTrackDownvoteAndSortTracks(trackID)
+
+		return true
+	}
+
+	userHasNotVotedForTrack := !user.HasVotedFor(trackID)
+	if userHasNotVotedForTrack {
+		fmt.Println("unvote aborted: given userID has not voted for given trackID")
+		return false
+	}
+
+	user.RemoveTrackVotedFor(trackID)
+
+	s.Tracks.DecrementTrackScoreAndSortTracks(trackID)
+
+	return true
+}
+
+func (s *MtvRoomInternalState) UserDownvoteForTrack(userID string, trackID string) bool {
+	if !s.initialParams.HasDownvotes {
+		fmt.Println("downvote aborted: downvotes are not enabled in the room")
+		return false
+	}
+
+	user, exists := s.Users[userID]
+	if !exists {
+		fmt.Println("downvote aborted: couldnt find given userID in the users list")
+		return false
+	}
+
+	if !s.userCanVote(user, "downvote") {
+		return false
+	}
+
+	couldFindTrackInTracksList := s.Tracks.Has(trackID)
+	if !couldFindTrackInTracksList {
+		fmt.Println("downvote aborted: couldnt find given trackID in the tracks list")
+		return false
+	}
+
+	userAlreadyVotedForTrack := user.HasVotedFor(trackID) || user.HasDownvotedFor(trackID)
+	if userAlreadyVotedForTrack {
+		fmt.Println("downvote aborted: given userID has already voted or downvoted for given trackID")
+		return false
+	}
+
+	user.TracksDownvotedFor = append(user.TracksDownvotedFor, trackID)
+
+	s.Tracks.DownvoteTrackAndSortTracks(trackID)
+
+	return true
+}
+
+//This method removes the given track from the tracks list if its net score
+//fell below the room minimum score to stay in queue.
+//Returns true if the track has been removed
+func (s *MtvRoomInternalState) RemoveTrackIfScoreIsBelowMinimumToStayInQueue(trackID string) bool {
+	track, exists := s.Tracks.Get(trackID)
+	if !exists {
+		return false
+	}
+
+	scoreIsBelowMinimum := track.Score < s.initialParams.MinimumScoreToStayInQueue
+	if !scoreIsBelowMinimum {
+		return false
+	}
+
+	s.removeTrack(trackID)
+
+	return true
+}
+
+//Returns the ids of the tracks that were in the tracks list and have been removed
+func (s *MtvRoomInternalState) RemoveTracks(tracksIDs []string) []string {
+	removedTracksIDs := make([]string, 0, len(tracksIDs))
+	for _, trackID := range tracksIDs {
+		if !s.Tracks.Has(trackID) {
+			continue
+		}
+
+		s.removeTrack(trackID)
+		removedTracksIDs = append(removedTracksIDs, trackID)
+	}
+
+	return removedTracksIDs
+}
+
+func (s *MtvRoomInternalState) removeTrack(trackID string) {
+	s.Tracks.Delete(trackID)
+	delete(s.tracksSuggesterUserIDs, trackID)
+	//As the track is not anymore in the tracks list, users can now suggest or vote for it again
+	s.RemoveTrackFromUserTracksVotedFor(trackID)
+}
+
+func (s *MtvRoomInternalState) UserCanVoteToSkipCurrentTrack(userID string) bool {
+	user, exists := s.Users[userID]
+	if !exists {
+		fmt.Println("skip vote aborted: couldnt find given userID in the users list")
+		return false
+	}
+
+	if !s.Playing {
+		fmt.Println("skip vote aborted: current track is not being played")
+		return false
+	}
+
+	if !s.userCanVote(user, "skip vote") {
+		return false
+	}
+
+	for _, skipVoteUserID := range s.currentTrackSkipVotesUserIDs {
+		if skipVoteUserID == userID {
+			fmt.Println("skip vote aborted: given userID has already voted to skip the current track")
+			return false
+		}
+	}
+
+	return true
+}
+
+func (s *MtvRoomInternalState) UserVoteToSkipCurrentTrack(userID string) bool {
+	if !s.UserCanVoteToSkipCurrentTrack(userID) {
+		return false
+	}
+
+	s.currentTrackSkipVotesUserIDs = append(s.currentTrackSkipVotesUserIDs, userID)
+
+	return true
+}
+
+//Only votes of users that are still in the room are counted
+func (s *MtvRoomInternalState) CountCurrentTrackSkipVotes() int {
+	skipVotes := 0
+
+	for _, skipVoteUserID := range s.currentTrackSkipVotesUserIDs {
+		if s.HasUser(skipVoteUserID) {
+			skipVotes++
+		}
+	}
+
+	return skipVotes
+}
+
+func (s *MtvRoomInternalState) GetRequiredSkipVotes() int {
+	requiredSkipVotes := int(math.Ceil(s.initialParams.GetSkipVotesRequiredShare() * float64(len(s.Users))))
+
+	if requiredSkipVotes < 1 {
+		return 1
+	}
+
+	return requiredSkipVotes
+}
+
 func (s *MtvRoomInternalState) UpdateUserDeviceID(user shared_mtv.InternalStateUser) {
 	if val, ok := s.Users[user.UserID]; ok {
 		val.DeviceID = user.DeviceID
@@ -214,6 +624,190 @@ func (s *MtvRoomInternalState) UserHasControlAndDelegationPermission(userID stri
 	return user.HasControlAndDelegationPermission
 }
 
+func (s *MtvRoomInternalState) UserIsCreatorOrHasControlAndDelegationPermission(userID string) bool {
+	userIsCreator := userID == s.initialParams.RoomCreatorUserID
+
+	return userIsCreator || s.UserHasControlAndDelegationPermission(userID)
+}
+
+//Only the creator and users with control and delegation permission can kick or ban other users
+//The creator can not be kicked nor banned
+func (s *MtvRoomInternalState) UserCanModerate(emitterUserID string, targetUserID string) bool {
+	emitterCanModerate := s.UserIsCreatorOrHasControlAndDelegationPermission(emitterUserID)
+	if !emitterCanModerate {
+		fmt.Println("moderation aborted: emitter is neither the creator nor has control and delegation permission")
+		return false
+	}
+
+	targetIsCreator := targetUserID == s.initialParams.RoomCreatorUserID
+	targetIsEmitter := targetUserID == emitterUserID
+	if targetIsCreator || targetIsEmitter {
+		fmt.Println("moderation aborted: the creator and the emitter can not be targeted")
+		return false
+	}
+
+	return true
+}
+
+func (s *MtvRoomInternalState) IsBanned(userID string) bool {
+	for _, bannedUserID := range s.bannedUserIDs {
+		if bannedUserID == userID {
+			return true
+		}
+	}
+
+	return false
+}
+
+//Removes given user votes and downvotes from the tracks list scores
+func (s *MtvRoomInternalState) ClearUserVotes(userID string) {
+	user, exists := s.Users[userID]
+	if !exists {
+		return
+	}
+
+	for _, trackID := range user.TracksVotedFor {
+		if s.Tracks.Has(trackID) {
+			s.Tracks.DecrementTrackScoreAndSortTracks(trackID)
+		}
+	}
+	for _, trackID := range user.TracksDownvotedFor {
+		if s.Tracks.Has(trackID) {
+			s.Tracks.RemoveTrackDownvoteAndSortTracks(trackID)
+		}
+	}
+
+	user.TracksVotedFor = make([]string, 0)
+	user.TracksDownvotedFor = nil
+}
+
+func (s *MtvRoomInternalState) giveBackDelegationToCreatorIfOwnedBy(userID string) {
+	userIsDelegationOwner := s.DelegationOwnerUserID != nil && *s.DelegationOwnerUserID == userID
+	if userIsDelegationOwner {
+		s.DelegationOwnerUserID = &(s.initialParams.RoomCreatorUserID)
+	}
+}
+
+func (s *MtvRoomInternalState) KickUser(userID string) bool {
+	if success := s.RemoveUser(userID); !success {
+		return false
+	}
+
+	s.giveBackDelegationToCreatorIfOwnedBy(userID)
+
+	return true
+}
+
+//A user can be banned even if they are not in the room
+func (s *MtvRoomInternalState) BanUser(userID string) bool {
+	if s.IsBanned(userID) {
+		fmt.Println("ban aborted: given userID is already banned")
+		return false
+	}
+
+	s.bannedUserIDs = append(s.bannedUserIDs, userID)
+
+	if s.HasUser(userID) {
+		s.ClearUserVotes(userID)
+		s.RemoveUser(userID)
+		s.giveBackDelegationToCreatorIfOwnedBy(userID)
+	}
+
+	return true
+}
+
+//Returns false and the reason of the rejection when suggesting suggestedTracksCount new tracks
+//would exceed one of the room suggestions limits.
+//pendingSuggestions are the suggestions whose tracks are still being fetched.
+func (s *MtvRoomInternalState) CheckTracksSuggestionLimits(
+	userID string,
+	suggestedTracksCount int,
+	pendingSuggestions []shared_mtv.MtvRoomPendingTracksSuggestion,
+	now time.Time,
+) (shared_mtv.MtvRoomTracksSuggestionFailReason, bool) {
+	pendingTracksCount := 0
+	userPendingTracksCount := 0
+	for _, suggestion := range pendingSuggestions {
+		pendingTracksCount += suggestion.TracksCount()
+		if suggestion.UserID == userID {
+			userPendingTracksCount += suggestion.TracksCount()
+		}
+	}
+
+	maximumTracksListLength := s.initialParams.MaximumTracksListLength
+	tracksListWouldBeTooLong := s.Tracks.Len()+pendingTracksCount+suggestedTracksCount > maximumTracksListLength
+	if maximumTracksListLength > 0 && tracksListWouldBeTooLong {
+		return shared_mtv.MtvRoomTracksSuggestionFailReasonTracksListIsFull, false
+	}
+
+	for _, track := range s.Tracks.Values() {
+		if s.tracksSuggesterUserIDs[track.ID] == userID {
+			userPendingTracksCount++
+		}
+	}
+
+	maximumPendingSuggestionsPerUser := s.initialParams.MaximumPendingSuggestionsPerUser
+	userWouldHaveTooManyPendingSuggestions := userPendingTracksCount+suggestedTracksCount > maximumPendingSuggestionsPerUser
+	if maximumPendingSuggestionsPerUser > 0 && userWouldHaveTooManyPendingSuggestions {
+		return shared_mtv.MtvRoomTracksSuggestionFailReasonTooManyPendingSuggestions, false
+	}
+
+	maximumSuggestionsPerUserPerMinute := s.initialParams.MaximumSuggestionsPerUserPerMinute
+	userWouldExceedSuggestionsRate := s.countUserRecentSuggestions(userID, now)+suggestedTracksCount > maximumSuggestionsPerUserPerMinute
+	if maximumSuggestionsPerUserPerMinute > 0 && userWouldExceedSuggestionsRate {
+		return shared_mtv.MtvRoomTracksSuggestionFailReasonRateLimited, false
+	}
+
+	return "", true
+}
+
+//Drops the suggestions timestamps that are out of the rate limit window
+func (s *MtvRoomInternalState) countUserRecentSuggestions(userID string, now time.Time) int {
+	windowStart := now.Add(-shared_mtv.SuggestionsRateLimitWindow)
+
+	recentSuggestionsTimestamps := make([]time.Time, 0, len(s.usersSuggestionsTimestamps[userID]))
+	for _, timestamp := range s.usersSuggestionsTimestamps[userID] {
+		if timestamp.After(windowStart) {
+			recentSuggestionsTimestamps = append(recentSuggestionsTimestamps, timestamp)
+		}
+	}
+
+	if len(recentSuggestionsTimestamps) == 0 {
+		delete(s.usersSuggestionsTimestamps, userID)
+		return 0
+	}
+
+	s.usersSuggestionsTimestamps[userID] = recentSuggestionsTimestamps
+	return len(recentSuggestionsTimestamps)
+}
+
+// recentUsersSuggestionsTimestamps returns the suggestions timestamps that are still in the rate limit window,
+// older ones will never be counted again and are not worth being carried over.
+func (s *MtvRoomInternalState) recentUsersSuggestionsTimestamps(now time.Time) map[string][]time.Time {
+	windowStart := now.Add(-shared_mtv.SuggestionsRateLimitWindow)
+
+	recentUsersSuggestionsTimestamps := make(map[string][]time.Time)
+	for userID, timestamps := range s.usersSuggestionsTimestamps {
+		for _, timestamp := range timestamps {
+			if timestamp.After(windowStart) {
+				recentUsersSuggestionsTimestamps[userID] = append(recentUsersSuggestionsTimestamps[userID], timestamp)
+			}
+		}
+	}
+
+	return recentUsersSuggestionsTimestamps
+}
+
+func (s *MtvRoomInternalState) RecordUserSuggestions(userID string, suggestedTracksCount int, now time.Time) {
+	if s.initialParams.MaximumSuggestionsPerUserPerMinute <= 0 {
+		return
+	}
+
+	for i := 0; i < suggestedTracksCount; i++ {
+		s.usersSuggestionsTimestamps[userID] = append(s.usersSuggestionsTimestamps[userID], now)
+	}
+}
+
 func (s *MtvRoomInternalState) HasUser(userID string) bool {
 	_, exists := s.Users[userID]
 
@@ -236,6 +830,7 @@ const (
 	MtvRoomTimerLaunchedEvent                     brainy.EventType = "TIMER_LAUNCHED"
 	MtvRoomTimerExpiredEvent                      brainy.EventType = "TIMER_EXPIRED"
 	MtvRoomInitialTracksFetched                   brainy.EventType = "INITIAL_TRACKS_FETCHED"
+	MtvRoomAutoDJTracksFetched                    brainy.EventType = "AUTO_DJ_TRACKS_FETCHED"
 	MtvRoomIsReady                                brainy.EventType = "MTV_ROOM_IS_READY"
 	MtvCheckForScoreUpdateIntervalExpirationEvent brainy.EventType = "VOTE_UPDATE_INTERVAL_EXPIRATION"
 	MtvHandlerTimeConstraintTimerExpirationEvent  brainy.EventType = "TIME_CONSTRAINT_TIMER_EXPIRATION"
@@ -243,12 +838,21 @@ const (
 	MtvRoomAddUserEvent                           brainy.EventType = "ADD_USER"
 	MtvRoomRemoveUserEvent                        brainy.EventType = "REMOVE_USER"
 	MtvRoomVoteForTrackEvent                      brainy.EventType = "VOTE_FOR_TRACK"
+	MtvRoomUnvoteForTrackEvent                    brainy.EventType = "UNVOTE_FOR_TRACK"
+	MtvRoomDownvoteForTrackEvent                  brainy.EventType = "DOWNVOTE_FOR_TRACK"
 	MtvRoomUpdateUserFitsPositionConstraint       brainy.EventType = "UPDATE_USER_FITS_POSITION_CONSTRAINT"
 	MtvRoomGoToNextTrack                          brainy.EventType = "GO_TO_NEXT_TRACK"
+	MtvRoomVoteToSkipCurrentTrack                 brainy.EventType = "VOTE_TO_SKIP_CURRENT_TRACK"
+	MtvRoomSeek                                   brainy.EventType = "SEEK"
+	MtvRoomKickUser                               brainy.EventType = "KICK_USER"
+	MtvRoomBanUser                                brainy.EventType = "BAN_USER"
+	MtvRoomUpdateRoomSettings                     brainy.EventType = "UPDATE_ROOM_SETTINGS"
+	MtvRoomRemoveTracks                           brainy.EventType = "REMOVE_TRACKS"
 	MtvRoomChangeUserEmittingDevice               brainy.EventType = "CHANGE_USER_EMITTING_DEVICE"
 	MtvRoomSuggestTracks                          brainy.EventType = "SUGGEST_TRACKS"
 	MtvRoomSuggestedTracksFetched                 brainy.EventType = "SUGGESTED_TRACKS_FETCHED"
 	MtvRoomTracksListScoreUpdate                  brainy.EventType = "TRACKS_LIST_SCORE_UPDATE"
+	MtvRoomSkipVotesUpdate                        brainy.EventType = "SKIP_VOTES_UPDATE"
 	MtvRoomUpdateDelegationOwner                  brainy.EventType = "UPDATE_DELEGATION_OWNER"
 	MtvRoomControlAndDelegationPermission         brainy.EventType = "UPDATE_CONTROL_AND_DELEGATION_PERMISSION"
 )
@@ -281,12 +885,20 @@ func MtvRoomWorkflow(ctx workflow.Context, params shared_mtv.MtvRoomParameters)
 
 	//Checking params
 	rootNow := getNowFromSideEffect(ctx)
-	if err := params.CheckParamsValidity(rootNow); err != nil {
-		logger.Info("Workflow creation failed", "Error", err)
-		return err
+	//A room restored from a snapshot has already been checked by the run that created it,
+	//its time constraint might even have ended since then
+	isContinuedAsNew := params.Snapshot != nil
+	if !isContinuedAsNew {
+		if err := params.CheckParamsValidity(rootNow); err != nil {
+			logger.Info("Workflow creation failed", "Error", err)
+			return err
+		}
 	}
 	///
 	internalState.FillWith(params)
+	if isContinuedAsNew {
+		internalState.RestoreFrom(*params.Snapshot)
+	}
 
 	if err := workflow.SetQueryHandler(
 		ctx,
@@ -355,6 +967,18 @@ func MtvRoomWorkflow(ctx workflow.Context, params shared_mtv.MtvRoomParameters)
 		return err
 	}
 
+	if err := workflow.SetQueryHandler(
+		ctx,
+		shared_mtv.MtvGetPlayedTracksQuery,
+		func(page int) (shared_mtv.MtvRoomPlayedTracksPage, error) {
+
+			return internalState.ExportPlayedTracksPage(page), nil
+		},
+	); err != nil {
+		logger.Info("SetQueryHandler for getPlayedTracks failed.", "Error", err)
+		return err
+	}
+
 	channel := workflow.GetSignalChannel(ctx, shared_mtv.SignalChannelName)
 
 	var (
@@ -362,15 +986,48 @@ func MtvRoomWorkflow(ctx workflow.Context, params shared_mtv.MtvRoomParameters)
 		workflowFatalError                       error
 		timerExpirationFuture                    workflow.Future
 		fetchedInitialTracksFuture               workflow.Future
-		fetchedSuggestedTracksInformationFutures []workflow.Future
+		initialTracksQuotaResetTimer             workflow.Future
+		fetchedSuggestedTracksInformationFutures []suggestedTracksInformationFetching
 		voteIntervalTimerFuture                  workflow.Future
+		autoDJTracksFuture                       workflow.Future
 
 		timeConstraintStartsAtTimer workflow.Future
 		timeConstraintEndsAtTimer   workflow.Future
+
+		handledEventsCount             = 0
+		continueAsNewGracePeriodTimer  workflow.Future
+		continueAsNewGracePeriodIsOver = false
 	)
 
+	fetchInitialTracks := func() {
+		fetchedInitialTracksFuture = sendFetchTracksInformationWithPolicyActivity(ctx, activities.FetchTracksInformationArgs{
+			TracksIDs: internalState.initialParams.InitialTracksIDsList,
+			UserID:    internalState.initialParams.RoomCreatorUserID,
+			Policy:    internalState.initialParams.PlayabilityPolicy,
+		})
+	}
+
+	// Only one auto-DJ fetch runs at a time, the tracks list is checked again once it ends
+	requestAutoDJTracks := func() {
+		if autoDJTracksFuture != nil || !internalState.AutoDJNeedsTracks() {
+			return
+		}
+
+		autoDJTracksFuture = sendFetchAutoDJTracksActivity(ctx, internalState.AutoDJTracksArgs())
+	}
+
+	initialState := MtvRoomFetchInitialTracks
+	if isContinuedAsNew {
+		if internalState.Playing {
+			initialState = MtvRoomPlayingState
+		} else {
+			initialState = MtvRoomPausedState
+		}
+	}
+
+	internalState.isRestoringFromSnapshot = isContinuedAsNew
 	internalState.Machine, err = brainy.NewMachine(brainy.StateNode{
-		Initial: MtvRoomFetchInitialTracks,
+		Initial: initialState,
 
 		States: brainy.StateNodes{
 
@@ -403,7 +1060,7 @@ func MtvRoomWorkflow(ctx workflow.Context, params shared_mtv.MtvRoomParameters)
 								timeConstraintEndsAtTimer = workflow.NewTimer(ctx, endLessNow)
 							}
 							///
-							fetchedInitialTracksFuture = sendFetchTracksInformationActivity(ctx, internalState.initialParams.InitialTracksIDsList)
+							fetchInitialTracks()
 
 							return nil
 						},
@@ -420,9 +1077,21 @@ func MtvRoomWorkflow(ctx workflow.Context, params shared_mtv.MtvRoomParameters)
 							),
 							brainy.ActionFn(
 								func(c brainy.Context, e brainy.Event) error {
+									requestAutoDJTracks()
+
+									return nil
+								},
+							),
+							brainy.ActionFn(
+								func(c brainy.Context, e brainy.Event) error {
+									event := e.(MtvRoomInitialTracksFetchedEvent)
+
 									if err := sendAcknowledgeRoomCreation(
 										ctx,
-										internalState.Export(internalState.initialParams.RoomCreatorUserID),
+										activities_mtv.CreationAcknowledgementArgs{
+											MtvRoomExposedState: internalState.Export(internalState.initialParams.RoomCreatorUserID),
+											RejectedTracks:      event.RejectedTracks,
+										},
 									); err != nil {
 										workflowFatalError = err
 									}
@@ -439,6 +1108,10 @@ func MtvRoomWorkflow(ctx workflow.Context, params shared_mtv.MtvRoomParameters)
 				OnEntry: brainy.Actions{
 					brainy.ActionFn(
 						func(c brainy.Context, e brainy.Event) error {
+							if internalState.isRestoringFromSnapshot {
+								return nil
+							}
+
 							exposedInternalState := internalState.Export(shared_mtv.NoRelatedUserID)
 							sendPauseActivity(ctx, exposedInternalState)
 
@@ -454,6 +1127,24 @@ func MtvRoomWorkflow(ctx workflow.Context, params shared_mtv.MtvRoomParameters)
 						Cond: checkUserPermissionAndCanPlayCurrentTrack(&internalState),
 					},
 
+					MtvRoomSeek: brainy.Transition{
+						Cond: userHasPermissionAndCanSeekCurrentTrack(&internalState),
+
+						Actions: brainy.Actions{
+							brainy.ActionFn(
+								func(c brainy.Context, e brainy.Event) error {
+									event := e.(MtvRoomSeekEvent)
+
+									internalState.SeekCurrentTrack(internalState.CurrentTrack.AlreadyElapsed, event.Offset)
+
+									sendSeekActivity(ctx, internalState.Export(shared_mtv.NoRelatedUserID))
+
+									return nil
+								},
+							),
+						},
+					},
+
 					MtvRoomTracksListScoreUpdate: brainy.Transition{
 						Target: MtvRoomPlayingState,
 
@@ -496,6 +1187,10 @@ func MtvRoomWorkflow(ctx workflow.Context, params shared_mtv.MtvRoomParameters)
 									})
 									encoded.Get(&createdOn)
 
+									if internalState.currentTrackStartedAt.IsZero() {
+										internalState.currentTrackStartedAt = createdOn
+									}
+
 									totalDuration := internalState.CurrentTrack.Duration - internalState.CurrentTrack.AlreadyElapsed
 
 									internalState.Timer = shared_mtv.MtvRoomTimer{
@@ -523,11 +1218,23 @@ func MtvRoomWorkflow(ctx workflow.Context, params shared_mtv.MtvRoomParameters)
 									exposedInternalState.Playing = true
 									internalState.Playing = true
 
+									if internalState.isRestoringFromSnapshot {
+										return nil
+									}
+
 									sendPlayActivity(ctx, exposedInternalState)
 
 									return nil
 								},
 							),
+							brainy.ActionFn(
+								func(c brainy.Context, e brainy.Event) error {
+									//Fetching while the current track is played lets the next one start right after it
+									requestAutoDJTracks()
+
+									return nil
+								},
+							),
 							brainy.Send(MtvRoomTimerLaunchedEvent),
 						},
 
@@ -537,13 +1244,29 @@ func MtvRoomWorkflow(ctx workflow.Context, params shared_mtv.MtvRoomParameters)
 					},
 
 					MtvRoomPlayingWaitingTimerEndState: &brainy.StateNode{
+						OnExit: brainy.Actions{
+							brainy.ActionFn(
+								func(c brainy.Context, e brainy.Event) error {
+									//When going to the next track while playing, the current track timer has not expired
+									//and the time it has been played for would be lost
+									switch e.(type) {
+									case MtvRoomGoToNextTrackEvent, MtvRoomUserVoteToSkipCurrentTrackEvent:
+										elapsed := GetElapsed(ctx, internalState.Timer.CreatedOn)
+										internalState.CurrentTrack.AlreadyElapsed += elapsed
+									}
+
+									return nil
+								},
+							),
+						},
+
 						On: brainy.Events{
 							MtvRoomTimerExpiredEvent: brainy.Transitions{
 								{
 									Cond: func(c brainy.Context, e brainy.Event) bool {
 										timerExpirationEvent := e.(MtvRoomTimerExpirationEvent)
 										currentTrackEnded := timerExpirationEvent.Reason == shared_mtv.MtvRoomTimerExpiredReasonFinished
-										nextTrackIsReadyToBePlayed := internalState.Tracks.FirstTrackIsReadyToBePlayed(internalState.initialParams.MinimumScoreToBePlayed)
+										nextTrackIsReadyToBePlayed := internalState.Tracks.NextTrackIsReadyToBePlayed(internalState.initialParams.MinimumScoreToBePlayed)
 										nextTrackIsNotReadyToBePlayed := !nextTrackIsReadyToBePlayed
 
 										return currentTrackEnded && nextTrackIsNotReadyToBePlayed
@@ -559,6 +1282,9 @@ func MtvRoomWorkflow(ctx workflow.Context, params shared_mtv.MtvRoomParameters)
 
 												internalState.CurrentTrack.AlreadyElapsed += event.Timer.Duration
 
+												//The room resumes playing once the auto-DJ tracks have been queued
+												requestAutoDJTracks()
+
 												return nil
 											},
 										),
@@ -569,7 +1295,7 @@ func MtvRoomWorkflow(ctx workflow.Context, params shared_mtv.MtvRoomParameters)
 									Cond: func(c brainy.Context, e brainy.Event) bool {
 										timerExpirationEvent := e.(MtvRoomTimerExpirationEvent)
 										currentTrackEnded := timerExpirationEvent.Reason == shared_mtv.MtvRoomTimerExpiredReasonFinished
-										nextTrackIsReadyToBePlayed := internalState.Tracks.FirstTrackIsReadyToBePlayed(internalState.initialParams.MinimumScoreToBePlayed)
+										nextTrackIsReadyToBePlayed := internalState.Tracks.NextTrackIsReadyToBePlayed(internalState.initialParams.MinimumScoreToBePlayed)
 
 										if currentTrackEnded {
 											fmt.Println("__TRACK IS FINISHED GOING TO THE NEXT ONE__")
@@ -581,6 +1307,15 @@ func MtvRoomWorkflow(ctx workflow.Context, params shared_mtv.MtvRoomParameters)
 									Target: MtvRoomPlayingLauchingTimerState,
 
 									Actions: brainy.Actions{
+										brainy.ActionFn(
+											func(c brainy.Context, e brainy.Event) error {
+												event := e.(MtvRoomTimerExpirationEvent)
+
+												internalState.CurrentTrack.AlreadyElapsed += event.Timer.Duration
+
+												return nil
+											},
+										),
 										brainy.ActionFn(
 											assignNextTrack(&internalState),
 										),
@@ -607,6 +1342,30 @@ func MtvRoomWorkflow(ctx workflow.Context, params shared_mtv.MtvRoomParameters)
 								},
 							},
 
+							//Entering launching timer state creates a timer for the remaining duration
+							//and broadcasts the new elapsed time to every device
+							MtvRoomSeek: brainy.Transition{
+								Target: MtvRoomPlayingLauchingTimerState,
+
+								Cond: userHasPermissionAndCanSeekCurrentTrack(&internalState),
+
+								Actions: brainy.Actions{
+									brainy.ActionFn(
+										func(c brainy.Context, e brainy.Event) error {
+											event := e.(MtvRoomSeekEvent)
+
+											if cancel := internalState.Timer.Cancel; cancel != nil {
+												cancel()
+											}
+											position := internalState.CurrentTrack.AlreadyElapsed + GetElapsed(ctx, internalState.Timer.CreatedOn)
+											internalState.SeekCurrentTrack(position, event.Offset)
+
+											return nil
+										},
+									),
+								},
+							},
+
 							MtvRoomPause: brainy.Transition{
 								Cond: userHasPermissionToPauseCurrentTrack(&internalState),
 
@@ -661,23 +1420,105 @@ func MtvRoomWorkflow(ctx workflow.Context, params shared_mtv.MtvRoomParameters)
 
 			// Isn't risky to listen those events while we're in the state `MtvRoomFetchInitialTracks` ?
 			// Shall we create a intermediate state between ? something like `workflowIsReady` ?
-			MtvRoomAddUserEvent: brainy.Transition{
+			MtvRoomAddUserEvent: brainy.Transitions{
+				{
+					Cond: userIsBanned(&internalState),
+
+					Actions: brainy.Actions{
+						brainy.ActionFn(
+							func(c brainy.Context, e brainy.Event) error {
+								event := e.(MtvRoomUserJoiningRoomEvent)
+
+								sendRejectBannedUserJoinActivity(ctx, activities_mtv.RejectBannedUserJoinArgs{
+									RoomID:   internalState.initialParams.RoomID,
+									UserID:   event.User.UserID,
+									DeviceID: event.User.DeviceID,
+								})
+
+								return nil
+							},
+						),
+					},
+				},
+				{
+					Actions: brainy.Actions{
+						brainy.ActionFn(
+							func(c brainy.Context, e brainy.Event) error {
+								event := e.(MtvRoomUserJoiningRoomEvent)
+
+								internalState.AddUser(event.User)
+
+								joinActivityArgs := activities_mtv.MtvJoinCallbackRequestBody{
+									State:         internalState.Export(event.User.UserID),
+									JoiningUserID: event.User.UserID,
+								}
+								sendJoinActivity(ctx, joinActivityArgs)
+								sendUserLengthUpdateActivity(ctx, internalState.Export(shared_mtv.NoRelatedUserID))
+								return nil
+							},
+						),
+					},
+				},
+			},
+
+			MtvRoomKickUser: brainy.Transition{
+				Cond: userCanModerate(&internalState),
+
 				Actions: brainy.Actions{
 					brainy.ActionFn(
 						func(c brainy.Context, e brainy.Event) error {
-							event := e.(MtvRoomUserJoiningRoomEvent)
+							event := e.(MtvRoomKickUserEvent)
+
+							if success := internalState.KickUser(event.KickedUserID); success {
+								sendAcknowledgeUserKickedActivity(ctx, activities_mtv.AcknowledgeUserKickedArgs{
+									State:        internalState.Export(shared_mtv.NoRelatedUserID),
+									KickedUserID: event.KickedUserID,
+								})
+								sendUserLengthUpdateActivity(ctx, internalState.Export(shared_mtv.NoRelatedUserID))
+							}
+
+							return nil
+						},
+					),
+					brainy.Send(
+						MtvRoomSkipVotesUpdate,
+					),
+				},
+			},
 
-							internalState.AddUser(event.User)
+			MtvRoomBanUser: brainy.Transition{
+				Cond: userCanModerate(&internalState),
 
-							joinActivityArgs := activities_mtv.MtvJoinCallbackRequestBody{
-								State:         internalState.Export(event.User.UserID),
-								JoiningUserID: event.User.UserID,
+				Actions: brainy.Actions{
+					brainy.ActionFn(
+						func(c brainy.Context, e brainy.Event) error {
+							event := e.(MtvRoomBanUserEvent)
+
+							bannedUserWasInRoom := internalState.HasUser(event.BannedUserID)
+							if success := internalState.BanUser(event.BannedUserID); !success {
+								return nil
+							}
+
+							sendAcknowledgeUserBannedActivity(ctx, activities_mtv.AcknowledgeUserBannedArgs{
+								State:        internalState.Export(shared_mtv.NoRelatedUserID),
+								BannedUserID: event.BannedUserID,
+							})
+
+							if bannedUserWasInRoom {
+								sendUserLengthUpdateActivity(ctx, internalState.Export(shared_mtv.NoRelatedUserID))
+
+								// Banned user votes have been removed from the tracks list
+								if voteIntervalTimerFuture == nil {
+									voteIntervalTimerFuture = workflow.NewTimer(ctx, shared_mtv.CheckForVoteUpdateIntervalDuration)
+								}
 							}
-							sendJoinActivity(ctx, joinActivityArgs)
-							sendUserLengthUpdateActivity(ctx, internalState.Export(shared_mtv.NoRelatedUserID))
+
 							return nil
 						},
 					),
+					brainy.Send(
+						MtvRoomSkipVotesUpdate,
+					),
 				},
 			},
 
@@ -706,6 +1547,93 @@ func MtvRoomWorkflow(ctx workflow.Context, params shared_mtv.MtvRoomParameters)
 				},
 			},
 
+			MtvRoomUnvoteForTrackEvent: brainy.Transition{
+				Actions: brainy.Actions{
+					brainy.ActionFn(
+						func(c brainy.Context, e brainy.Event) error {
+							event := e.(MtvRoomUserUnvoteForTrackEvent)
+
+							success := internalState.UserUnvoteForTrack(event.UserID, event.TrackID)
+							if success {
+
+								if voteIntervalTimerFuture == nil {
+									voteIntervalTimerFuture = workflow.NewTimer(ctx, shared_mtv.CheckForVoteUpdateIntervalDuration)
+								}
+
+								sendUserUnvoteForTrackAcknowledgementActivity(ctx, internalState.Export(event.UserID))
+							}
+
+							return nil
+						},
+					),
+					//Retracting a downvote increases the track score
+					brainy.Send(
+						MtvRoomTracksListScoreUpdate,
+					),
+				},
+			},
+
+			MtvRoomDownvoteForTrackEvent: brainy.Transition{
+				Actions: brainy.Actions{
+					brainy.ActionFn(
+						func(c brainy.Context, e brainy.Event) error {
+							event := e.(MtvRoomUserDownvoteForTrackEvent)
+
+							success := internalState.UserDownvoteForTrack(event.UserID, event.TrackID)
+							if success {
+
+								if voteIntervalTimerFuture == nil {
+									voteIntervalTimerFuture = workflow.NewTimer(ctx, shared_mtv.CheckForVoteUpdateIntervalDuration)
+								}
+
+								if removed := internalState.RemoveTrackIfScoreIsBelowMinimumToStayInQueue(event.TrackID); removed {
+									sendAcknowledgeDownvotedTrackRemovalActivity(ctx, activities_mtv.AcknowledgeDownvotedTrackRemovalArgs{
+										State:   internalState.Export(shared_mtv.NoRelatedUserID),
+										TrackID: event.TrackID,
+									})
+								}
+
+								sendUserVoteForTrackAcknowledgementActivity(ctx, internalState.Export(event.UserID))
+							}
+
+							return nil
+						},
+					),
+				},
+			},
+
+			MtvRoomRemoveTracks: brainy.Transition{
+				Cond: userCanRemoveTracks(&internalState),
+
+				Actions: brainy.Actions{
+					brainy.ActionFn(
+						func(c brainy.Context, e brainy.Event) error {
+							event := e.(MtvRoomRemoveTracksEvent)
+
+							removedTracksIDs := internalState.RemoveTracks(event.TracksIDs)
+							if len(removedTracksIDs) == 0 {
+								return nil
+							}
+
+							//The acknowledgement forwards the updated tracks list to every user right away,
+							//the vote interval must not send it again as it could not tell an emptied list apart
+							sendAcknowledgeTracksRemovedActivity(ctx, activities_mtv.AcknowledgeTracksRemovedArgs{
+								State:            internalState.Export(shared_mtv.NoRelatedUserID),
+								UserID:           event.UserID,
+								RemovedTracksIDs: removedTracksIDs,
+							})
+
+							if voteIntervalTimerFuture != nil {
+								internalState.TracksCheckForVoteUpdateLastSave = internalState.Tracks.Clone()
+								internalState.CurrentTrackCheckForVoteUpdateLastSave = internalState.CurrentTrack
+							}
+
+							return nil
+						},
+					),
+				},
+			},
+
 			MtvCheckForScoreUpdateIntervalExpirationEvent: brainy.Transition{
 				Actions: brainy.Actions{
 					brainy.ActionFn(
@@ -774,12 +1702,58 @@ func MtvRoomWorkflow(ctx workflow.Context, params shared_mtv.MtvRoomParameters)
 						func(c brainy.Context, e brainy.Event) error {
 							event := e.(MtvRoomUpdateDelegationOwnerEvent)
 
-							internalState.DelegationOwnerUserID = &event.NewDelegationOwnerUserID
-							sendAcknowledgeUpdateDelegationOwnerActivity(ctx, internalState.Export(shared_mtv.NoRelatedUserID))
+							internalState.DelegationOwnerUserID = &event.NewDelegationOwnerUserID
+							sendAcknowledgeUpdateDelegationOwnerActivity(ctx, internalState.Export(shared_mtv.NoRelatedUserID))
+
+							return nil
+						},
+					),
+				},
+			},
+
+			MtvRoomUpdateRoomSettings: brainy.Transitions{
+				{
+					Cond: userIsRoomCreatorAndSettingsUpdateIsValid(&internalState),
+
+					Actions: brainy.Actions{
+						brainy.ActionFn(
+							func(c brainy.Context, e brainy.Event) error {
+								event := e.(MtvRoomUpdateRoomSettingsEvent)
+
+								internalState.initialParams = internalState.initialParams.WithSettingsUpdate(event.Settings)
+								sendAcknowledgeUpdateRoomSettingsActivity(ctx, internalState.Export(shared_mtv.NoRelatedUserID))
+
+								return nil
+							},
+						),
+						//A lowered minimum score can make the next track ready to be played,
+						//the skip votes already cast or an ended current track can then move to it
+						brainy.Send(
+							MtvRoomSkipVotesUpdate,
+						),
+						brainy.Send(
+							MtvRoomTracksListScoreUpdate,
+						),
+					},
+				},
+				{
+					Cond: userIsRoomCreator(&internalState),
+
+					Actions: brainy.Actions{
+						brainy.ActionFn(
+							func(c brainy.Context, e brainy.Event) error {
+								event := e.(MtvRoomUpdateRoomSettingsEvent)
 
-							return nil
-						},
-					),
+								reason, _ := internalState.initialParams.CheckSettingsUpdateValidity(event.Settings)
+								sendAcknowledgeUpdateRoomSettingsFailActivity(ctx, activities_mtv.AcknowledgeUpdateRoomSettingsFailArgs{
+									UserID: event.UserID,
+									Reason: reason,
+								})
+
+								return nil
+							},
+						),
+					},
 				},
 			},
 
@@ -832,6 +1806,9 @@ func MtvRoomWorkflow(ctx workflow.Context, params shared_mtv.MtvRoomParameters)
 							return nil
 						},
 					),
+					brainy.Send(
+						MtvRoomSkipVotesUpdate,
+					),
 				},
 			},
 
@@ -847,6 +1824,52 @@ func MtvRoomWorkflow(ctx workflow.Context, params shared_mtv.MtvRoomParameters)
 				},
 			},
 
+			MtvRoomVoteToSkipCurrentTrack: brainy.Transitions{
+				{
+					Target: MtvRoomPlayingState,
+
+					Cond: skipVoteReachesRequiredSkipVotesAndHasNextTrackToPlay(&internalState),
+
+					Actions: brainy.Actions{
+						brainy.ActionFn(
+							assignNextTrack(&internalState),
+						),
+					},
+				},
+				{
+					Actions: brainy.Actions{
+						brainy.ActionFn(
+							func(c brainy.Context, e brainy.Event) error {
+								event := e.(MtvRoomUserVoteToSkipCurrentTrackEvent)
+
+								if success := internalState.UserVoteToSkipCurrentTrack(event.UserID); success {
+									sendNotifySkipVotesUpdateActivity(ctx, internalState.Export(shared_mtv.NoRelatedUserID))
+								}
+
+								return nil
+							},
+						),
+					},
+				},
+			},
+
+			MtvRoomSkipVotesUpdate: brainy.Transitions{
+				{
+					Target: MtvRoomPlayingState,
+
+					Cond: skipVotesReachRequiredSkipVotesAndHasNextTrackToPlay(&internalState),
+
+					Actions: brainy.Actions{
+						brainy.ActionFn(
+							assignNextTrack(&internalState),
+						),
+					},
+				},
+				//An event that no transition handles would stay queued
+				//in front of the next events sent to the machine
+				{},
+			},
+
 			MtvRoomSuggestTracks: brainy.Transition{
 				Actions: brainy.Actions{
 					brainy.ActionFn(
@@ -881,9 +1904,37 @@ func MtvRoomWorkflow(ctx workflow.Context, params shared_mtv.MtvRoomParameters)
 								acceptedSuggestedTracksIDs = append(acceptedSuggestedTracksIDs, suggestedTrackID)
 							}
 
-							hasNoTracksToFetch := len(acceptedSuggestedTracksIDs) == 0
+							// The track searched by a query is only known once fetched,
+							// it is checked against the current track and the queue at that time.
+							hasQuery := event.Query != ""
+							hasNoTracksToFetch := len(acceptedSuggestedTracksIDs) == 0 && !hasQuery
 							hasNoSuccessfullVoteForDuplicate := len(succesfullSuggestIntoVoteTracksIDs) == 0
 
+							if !hasNoTracksToFetch {
+								suggestedTracksCount := len(acceptedSuggestedTracksIDs)
+								if hasQuery {
+									suggestedTracksCount = 1
+								}
+
+								now := getNowFromSideEffect(ctx)
+
+								pendingSuggestions := make([]shared_mtv.MtvRoomPendingTracksSuggestion, 0, len(fetchedSuggestedTracksInformationFutures))
+								for _, fetching := range fetchedSuggestedTracksInformationFutures {
+									pendingSuggestions = append(pendingSuggestions, fetching.Request)
+								}
+
+								reason, ok := internalState.CheckTracksSuggestionLimits(event.UserID, suggestedTracksCount, pendingSuggestions, now)
+								if !ok {
+									sendAcknowledgeTracksSuggestionFailActivity(ctx, activities_mtv.AcknowledgeTracksSuggestionFailArgs{
+										DeviceID: event.DeviceID,
+										Reason:   reason,
+									})
+									return nil
+								}
+
+								internalState.RecordUserSuggestions(event.UserID, suggestedTracksCount, now)
+							}
+
 							if hasNoTracksToFetch {
 								if hasNoSuccessfullVoteForDuplicate {
 									sendAcknowledgeTracksSuggestionFailActivity(ctx, activities_mtv.AcknowledgeTracksSuggestionFailArgs{
@@ -899,9 +1950,14 @@ func MtvRoomWorkflow(ctx workflow.Context, params shared_mtv.MtvRoomParameters)
 								return nil
 							}
 
-							fetchingFuture := sendFetchTracksInformationActivityAndForwardInitiator(ctx, acceptedSuggestedTracksIDs, event.UserID, event.DeviceID)
+							fetching := sendSuggestedTracksInformationFetching(ctx, shared_mtv.MtvRoomPendingTracksSuggestion{
+								TracksIDs: acceptedSuggestedTracksIDs,
+								Query:     event.Query,
+								UserID:    event.UserID,
+								DeviceID:  event.DeviceID,
+							}, internalState.initialParams.PlayabilityPolicy)
 
-							fetchedSuggestedTracksInformationFutures = append(fetchedSuggestedTracksInformationFutures, fetchingFuture)
+							fetchedSuggestedTracksInformationFutures = append(fetchedSuggestedTracksInformationFutures, fetching)
 
 							return nil
 						},
@@ -918,7 +1974,35 @@ func MtvRoomWorkflow(ctx workflow.Context, params shared_mtv.MtvRoomParameters)
 						func(c brainy.Context, e brainy.Event) error {
 							event := e.(MtvRoomSuggestedTracksFetchedEvent)
 
+							if len(event.SuggestedTracksInformation) == 0 && len(event.RejectedTracks) > 0 {
+								sendAcknowledgeTracksSuggestionFailActivity(ctx, activities_mtv.AcknowledgeTracksSuggestionFailArgs{
+									DeviceID:       event.DeviceID,
+									Reason:         shared_mtv.MtvRoomTracksSuggestionFailReasonTracksRejected,
+									RejectedTracks: event.RejectedTracks,
+								})
+
+								return nil
+							}
+
+							addedOrVotedTracksCount := 0
 							for _, trackInformation := range event.SuggestedTracksInformation {
+								// A track found by a query can already be playing or be in the queue,
+								// as can a track suggested by another user while this one was fetched.
+								if internalState.CurrentTrack.ID == trackInformation.ID {
+									continue
+								}
+								if internalState.Tracks.Has(trackInformation.ID) {
+									if success := internalState.UserVoteForTrack(event.UserID, trackInformation.ID); success {
+										addedOrVotedTracksCount++
+
+										if voteIntervalTimerFuture == nil {
+											voteIntervalTimerFuture = workflow.NewTimer(ctx, shared_mtv.CheckForVoteUpdateIntervalDuration)
+										}
+									}
+									continue
+								}
+
+								addedOrVotedTracksCount++
 								suggestedTrackInformation := shared_mtv.TrackMetadataWithScore{
 									TrackMetadata: trackInformation,
 
@@ -926,6 +2010,7 @@ func MtvRoomWorkflow(ctx workflow.Context, params shared_mtv.MtvRoomParameters)
 								}
 
 								internalState.Tracks.Add(suggestedTrackInformation)
+								internalState.tracksSuggesterUserIDs[trackInformation.ID] = event.UserID
 								internalState.UserVoteForTrack(event.UserID, trackInformation.ID)
 
 								// We always try to schedule the vote interval timer as
@@ -936,9 +2021,18 @@ func MtvRoomWorkflow(ctx workflow.Context, params shared_mtv.MtvRoomParameters)
 								}
 							}
 
+							if addedOrVotedTracksCount == 0 && len(event.RejectedTracks) == 0 {
+								sendAcknowledgeTracksSuggestionFailActivity(ctx, activities_mtv.AcknowledgeTracksSuggestionFailArgs{
+									DeviceID: event.DeviceID,
+								})
+
+								return nil
+							}
+
 							sendAcknowledgeTracksSuggestionActivity(ctx, activities_mtv.AcknowledgeTracksSuggestionArgs{
-								DeviceID: event.DeviceID,
-								State:    internalState.Export(event.UserID),
+								DeviceID:       event.DeviceID,
+								State:          internalState.Export(event.UserID),
+								RejectedTracks: event.RejectedTracks,
 							})
 
 							return nil
@@ -949,19 +2043,86 @@ func MtvRoomWorkflow(ctx workflow.Context, params shared_mtv.MtvRoomParameters)
 					),
 				},
 			},
+
+			MtvRoomAutoDJTracksFetched: brainy.Transition{
+				Actions: brainy.Actions{
+					brainy.ActionFn(
+						func(c brainy.Context, e brainy.Event) error {
+							event := e.(MtvRoomAutoDJTracksFetchedEvent)
+
+							addedTracksCount := internalState.AddAutoDJTracks(event.Tracks)
+							if addedTracksCount == 0 {
+								return nil
+							}
+
+							// System suggestions are forwarded to every user as the ones of users are.
+							if voteIntervalTimerFuture == nil {
+								voteIntervalTimerFuture = workflow.NewTimer(ctx, shared_mtv.CheckForVoteUpdateIntervalDuration)
+							}
+
+							return nil
+						},
+					),
+					brainy.Send(
+						MtvRoomTracksListScoreUpdate,
+					),
+				},
+			},
 		},
 	})
+	internalState.isRestoringFromSnapshot = false
 	if err != nil {
 		fmt.Printf("machine error : %v\n", err)
 		return err
 	}
 
+	if isContinuedAsNew {
+		snapshot := params.Snapshot
+
+		roomHasConstraint := internalState.initialParams.HasPhysicalAndTimeConstraints && internalState.initialParams.PhysicalAndTimeConstraints != nil
+		if roomHasConstraint {
+			start := internalState.initialParams.PhysicalAndTimeConstraints.PhysicalConstraintStartsAt
+			end := internalState.initialParams.PhysicalAndTimeConstraints.PhysicalConstraintEndsAt
+
+			if startIsAfterNow := start.After(rootNow); startIsAfterNow {
+				timeConstraintStartsAtTimer = workflow.NewTimer(ctx, start.Sub(rootNow))
+			}
+			if endIsAfterNow := end.After(rootNow); endIsAfterNow {
+				timeConstraintEndsAtTimer = workflow.NewTimer(ctx, end.Sub(rootNow))
+			}
+		}
+
+		for _, pendingTracksSuggestion := range snapshot.PendingTracksSuggestions {
+			fetching := sendSuggestedTracksInformationFetching(ctx, pendingTracksSuggestion, internalState.initialParams.PlayabilityPolicy)
+
+			fetchedSuggestedTracksInformationFutures = append(fetchedSuggestedTracksInformationFutures, fetching)
+		}
+
+		if snapshot.VoteUpdateIntervalIsPending {
+			voteIntervalTimerFuture = workflow.NewTimer(ctx, shared_mtv.CheckForVoteUpdateIntervalDuration)
+		}
+
+		if snapshot.AutoDJFetchIsPending {
+			requestAutoDJTracks()
+		}
+	}
+
+	var idleTimers *shared.RoomIdleTimers
+	if isContinuedAsNew {
+		idleTimers = shared.RestoreRoomIdleTimers(ctx, params.IdlePolicy, params.Snapshot.IdleClocks)
+	} else {
+		idleTimers = shared.NewRoomIdleTimers(params.IdlePolicy)
+		idleTimers.RecordActivity(ctx)
+	}
+	var roomExpirationReason shared.RoomExpirationReason
+
 	for {
 		selector := workflow.NewSelector(ctx)
 
 		selector.AddReceive(channel, func(c workflow.ReceiveChannel, _ bool) {
 			var signal interface{}
 			c.Receive(ctx, &signal)
+			idleTimers.RecordActivity(ctx)
 
 			var routeSignal shared.GenericRouteSignal
 
@@ -1051,6 +2212,105 @@ func MtvRoomWorkflow(ctx workflow.Context, params shared_mtv.MtvRoomParameters)
 				}
 				internalState.Machine.Send(NewMtvRoomGoToNextTrackEvent(args))
 
+			case shared_mtv.SignalRouteKickUser:
+				var message shared_mtv.KickUserSignal
+
+				if err := shared.DecodeWithCustomMapStructure(signal, &message); err != nil {
+					logger.Error("Invalid signal type %v", err)
+					return
+				}
+				if err := Validate.Struct(message); err != nil {
+					logger.Error("Validation error: %v", err)
+					return
+				}
+
+				internalState.Machine.Send(
+					NewMtvRoomKickUserEvent(message.KickedUserID, message.EmitterUserID),
+				)
+
+			case shared_mtv.SignalRouteBanUser:
+				var message shared_mtv.BanUserSignal
+
+				if err := shared.DecodeWithCustomMapStructure(signal, &message); err != nil {
+					logger.Error("Invalid signal type %v", err)
+					return
+				}
+				if err := Validate.Struct(message); err != nil {
+					logger.Error("Validation error: %v", err)
+					return
+				}
+
+				internalState.Machine.Send(
+					NewMtvRoomBanUserEvent(message.BannedUserID, message.EmitterUserID),
+				)
+
+			case shared_mtv.SignalRouteRemoveTracks:
+				var message shared_mtv.RemoveTracksSignal
+
+				if err := shared.DecodeWithCustomMapStructure(signal, &message); err != nil {
+					logger.Error("Invalid signal type %v", err)
+					return
+				}
+				if err := Validate.Struct(message); err != nil {
+					logger.Error("Validation error: %v", err)
+					return
+				}
+
+				internalState.Machine.Send(
+					NewMtvRoomRemoveTracksEvent(message.UserID, message.TracksIDs),
+				)
+
+			case shared_mtv.SignalRouteUpdateRoomSettings:
+				var message shared_mtv.UpdateRoomSettingsSignal
+
+				if err := shared.DecodeWithCustomMapStructure(signal, &message); err != nil {
+					logger.Error("Invalid signal type %v", err)
+					return
+				}
+				if err := Validate.Struct(message); err != nil {
+					logger.Error("Validation error: %v", err)
+					return
+				}
+
+				internalState.Machine.Send(
+					NewMtvRoomUpdateRoomSettingsEvent(message.UserID, message.Settings),
+				)
+
+			case shared_mtv.SignalRouteSeek:
+				var message shared_mtv.SeekSignal
+
+				if err := shared.DecodeWithCustomMapStructure(signal, &message); err != nil {
+					logger.Error("Invalid signal type %v", err)
+					return
+				}
+				if err := Validate.Struct(message); err != nil {
+					logger.Error("Validation error: %v", err)
+					return
+				}
+
+				internalState.Machine.Send(
+					NewMtvRoomSeekEvent(NewMtvRoomSeekEventArgs{
+						UserID: message.UserID,
+						Offset: message.Offset,
+					}),
+				)
+
+			case shared_mtv.SignalRouteVoteToSkipCurrentTrack:
+				var message shared_mtv.VoteToSkipCurrentTrackSignal
+
+				if err := shared.DecodeWithCustomMapStructure(signal, &message); err != nil {
+					logger.Error("Invalid signal type %v", err)
+					return
+				}
+				if err := Validate.Struct(message); err != nil {
+					logger.Error("Validation error: %v", err)
+					return
+				}
+
+				internalState.Machine.Send(
+					NewMtvRoomUserVoteToSkipCurrentTrackEvent(message.UserID),
+				)
+
 			case shared_mtv.SignalRouteChangeUserEmittingDevice:
 				var message shared_mtv.ChangeUserEmittingDeviceSignal
 
@@ -1082,6 +2342,7 @@ func MtvRoomWorkflow(ctx workflow.Context, params shared_mtv.MtvRoomParameters)
 				internalState.Machine.Send(
 					NewMtvRoomSuggestTracksEvent(NewMtvRoomSuggestTracksEventArgs{
 						TracksToSuggest: message.TracksToSuggest,
+						Query:           message.Query,
 						UserID:          message.UserID,
 						DeviceID:        message.DeviceID,
 					}),
@@ -1119,6 +2380,38 @@ func MtvRoomWorkflow(ctx workflow.Context, params shared_mtv.MtvRoomParameters)
 					NewMtvRoomUserVoteForTrackEvent(message.UserID, message.TrackID),
 				)
 
+			case shared_mtv.SignalRouteUnvoteForTrack:
+				var message shared_mtv.UnvoteForTrackSignal
+
+				if err := shared.DecodeWithCustomMapStructure(signal, &message); err != nil {
+					logger.Error("Invalid signal type %v", err)
+					return
+				}
+				if err := Validate.Struct(message); err != nil {
+					logger.Error("Validation error: %v", err)
+					return
+				}
+
+				internalState.Machine.Send(
+					NewMtvRoomUserUnvoteForTrackEvent(message.UserID, message.TrackID),
+				)
+
+			case shared_mtv.SignalRouteDownvoteForTrack:
+				var message shared_mtv.DownvoteForTrackSignal
+
+				if err := shared.DecodeWithCustomMapStructure(signal, &message); err != nil {
+					logger.Error("Invalid signal type %v", err)
+					return
+				}
+				if err := Validate.Struct(message); err != nil {
+					logger.Error("Validation error: %v", err)
+					return
+				}
+
+				internalState.Machine.Send(
+					NewMtvRoomUserDownvoteForTrackEvent(message.UserID, message.TrackID),
+				)
+
 			case shared_mtv.SignalUpdateUserFitsPositionConstraint:
 				var message shared_mtv.UpdateUserFitsPositionConstraintSignal
 
@@ -1219,21 +2512,62 @@ func MtvRoomWorkflow(ctx workflow.Context, params shared_mtv.MtvRoomParameters)
 			selector.AddFuture(fetchedInitialTracksFuture, func(f workflow.Future) {
 				fetchedInitialTracksFuture = nil
 
-				var initialTracksActivityResult []shared.TrackMetadata
+				var initialTracksActivityResult activities.FetchedTracksInformationWithInitiator
 
 				if err := f.Get(ctx, &initialTracksActivityResult); err != nil {
 					logger.Error("error occured initialTracksActivityResult", err)
 
+					//The room creation can not be acknowledged before the initial tracks are known,
+					//so the fetching is tried again once the provider quota is reset
+					if resetsAt, isQuotaError := activities.QuotaResetsAt(err); isQuotaError {
+						initialTracksQuotaResetTimer = workflow.NewTimer(ctx, resetsAt.Sub(getNowFromSideEffect(ctx)))
+
+						return
+					}
+
+					internalState.Machine.Send(
+						NewMtvRoomInitialTracksFetchedEvent(
+							nil,
+							activities.RejectTracksOfFailedFetch(internalState.initialParams.InitialTracksIDsList, "", err),
+						),
+					)
+
 					return
 				}
 
 				internalState.Machine.Send(
-					NewMtvRoomInitialTracksFetchedEvent(initialTracksActivityResult),
+					NewMtvRoomInitialTracksFetchedEvent(initialTracksActivityResult.Metadata, initialTracksActivityResult.RejectedTracks),
 				)
 			})
 		}
+
+		if initialTracksQuotaResetTimer != nil {
+			selector.AddFuture(initialTracksQuotaResetTimer, func(f workflow.Future) {
+				initialTracksQuotaResetTimer = nil
+
+				fetchInitialTracks()
+			})
+		}
 		/////
 
+		if autoDJTracksFuture != nil {
+			selector.AddFuture(autoDJTracksFuture, func(f workflow.Future) {
+				autoDJTracksFuture = nil
+
+				var autoDJTracks []shared.TrackMetadata
+
+				if err := f.Get(ctx, &autoDJTracks); err != nil {
+					logger.Error("error occured autoDJTracks", err)
+
+					return
+				}
+
+				internalState.Machine.Send(
+					NewMtvRoomAutoDJTracksFetchedEvent(autoDJTracks),
+				)
+			})
+		}
+
 		if voteIntervalTimerFuture != nil {
 			//Set as null inside the state machine NewMtvRoomCheckForScoreUpdateIntervalExpirationEvent listener
 			selector.AddFuture(voteIntervalTimerFuture, func(f workflow.Future) {
@@ -1263,14 +2597,24 @@ func MtvRoomWorkflow(ctx workflow.Context, params shared_mtv.MtvRoomParameters)
 			})
 		}
 
-		for index, fetchedSuggestedTracksInformationFuture := range fetchedSuggestedTracksInformationFutures {
-			selector.AddFuture(fetchedSuggestedTracksInformationFuture, func(f workflow.Future) {
-				fetchedSuggestedTracksInformationFutures = removeFutureFromSlice(fetchedSuggestedTracksInformationFutures, index)
+		for index, fetchedSuggestedTracksInformation := range fetchedSuggestedTracksInformationFutures {
+			index, fetchedSuggestedTracksInformation := index, fetchedSuggestedTracksInformation
+			selector.AddFuture(fetchedSuggestedTracksInformation.Future, func(f workflow.Future) {
+				fetchedSuggestedTracksInformationFutures = removeFetchingFromSlice(fetchedSuggestedTracksInformationFutures, index)
 
 				var suggestedTracksInformationActivityResult activities.FetchedTracksInformationWithInitiator
 
 				if err := f.Get(ctx, &suggestedTracksInformationActivityResult); err != nil {
-					logger.Error("error occured initialTracksActivityResult", err)
+					logger.Error("error occured suggestedTracksInformationActivityResult", err)
+
+					request := fetchedSuggestedTracksInformation.Request
+					internalState.Machine.Send(
+						NewMtvRoomSuggestedTracksFetchedEvent(NewMtvRoomSuggestedTracksFetchedEventArgs{
+							RejectedTracks: activities.RejectTracksOfFailedFetch(request.TracksIDs, request.Query, err),
+							UserID:         request.UserID,
+							DeviceID:       request.DeviceID,
+						}),
+					)
 
 					return
 				}
@@ -1278,6 +2622,7 @@ func MtvRoomWorkflow(ctx workflow.Context, params shared_mtv.MtvRoomParameters)
 				internalState.Machine.Send(
 					NewMtvRoomSuggestedTracksFetchedEvent(NewMtvRoomSuggestedTracksFetchedEventArgs{
 						SuggestedTracksInformation: suggestedTracksInformationActivityResult.Metadata,
+						RejectedTracks:             suggestedTracksInformationActivityResult.RejectedTracks,
 						UserID:                     suggestedTracksInformationActivityResult.UserID,
 						DeviceID:                   suggestedTracksInformationActivityResult.DeviceID,
 					}),
@@ -1285,17 +2630,119 @@ func MtvRoomWorkflow(ctx workflow.Context, params shared_mtv.MtvRoomParameters)
 			})
 		}
 
+		if continueAsNewGracePeriodTimer != nil {
+			selector.AddFuture(continueAsNewGracePeriodTimer, func(f workflow.Future) {
+				continueAsNewGracePeriodTimer = nil
+				continueAsNewGracePeriodIsOver = true
+			})
+		}
+
+		idleTimers.AddToSelector(ctx, selector, func(reason shared.RoomExpirationReason) {
+			roomExpirationReason = reason
+		})
+
+		//Once the grace period is over we wait for a select round
+		//without any signal or future to handle before continuing as new.
+		//This way no activity scheduled by this run is left behind and
+		//no signal is lost during the handover.
+		readyToContinueAsNew := false
+		waitingForIdleRound := continueAsNewGracePeriodIsOver
+		if waitingForIdleRound {
+			selector.AddDefault(func() {
+				readyToContinueAsNew = true
+			})
+		}
+
 		selector.Select(ctx)
 
 		if terminated || workflowFatalError != nil {
 			break
 		}
+
+		if roomExpirationReason != "" {
+			if err := sendRoomExpiredActivity(ctx, activities_mtv.RoomExpiredActivityArgs{
+				RoomID: params.RoomID,
+				Reason: roomExpirationReason,
+			}); err != nil {
+				logger.Error("room expired activity failed", err)
+			}
+
+			break
+		}
+
+		idleTimers.UpdateRoomIsEmpty(ctx, len(internalState.Users) == 0)
+
+		if readyToContinueAsNew {
+			//Room settings might have been updated since the workflow started
+			return continueAsNew(ctx, internalState.initialParams, &internalState, fetchedSuggestedTracksInformationFutures, voteIntervalTimerFuture != nil, autoDJTracksFuture != nil, idleTimers.Clocks())
+		}
+
+		if waitingForIdleRound {
+			continueAsNewGracePeriodIsOver = false
+			continueAsNewGracePeriodTimer = workflow.NewTimer(ctx, shared_mtv.ContinueAsNewGracePeriodDuration)
+		}
+
+		handledEventsCount++
+		roomIsReady := !internalState.Machine.Current().Matches(MtvRoomFetchInitialTracks)
+		shouldContinueAsNew := handledEventsCount >= params.GetContinueAsNewEventsThreshold() && roomIsReady
+		if shouldContinueAsNew && continueAsNewGracePeriodTimer == nil {
+			continueAsNewGracePeriodTimer = workflow.NewTimer(ctx, shared_mtv.ContinueAsNewGracePeriodDuration)
+		}
 	}
 
 	return workflowFatalError
 }
 
-func removeFutureFromSlice(slice []workflow.Future, index int) []workflow.Future {
+// continueAsNew ends the current workflow run and starts a new one seeded with a snapshot of the room.
+func continueAsNew(
+	ctx workflow.Context,
+	params shared_mtv.MtvRoomParameters,
+	internalState *MtvRoomInternalState,
+	pendingFetchings []suggestedTracksInformationFetching,
+	voteUpdateIntervalIsPending bool,
+	autoDJFetchIsPending bool,
+	idleClocks shared.RoomIdleClocks,
+) error {
+	snapshot := internalState.Snapshot(getNowFromSideEffect(ctx))
+
+	snapshot.PendingTracksSuggestions = make([]shared_mtv.MtvRoomPendingTracksSuggestion, 0, len(pendingFetchings))
+	for _, fetching := range pendingFetchings {
+		snapshot.PendingTracksSuggestions = append(snapshot.PendingTracksSuggestions, fetching.Request)
+	}
+	snapshot.VoteUpdateIntervalIsPending = voteUpdateIntervalIsPending
+	snapshot.AutoDJFetchIsPending = autoDJFetchIsPending
+	snapshot.IdleClocks = idleClocks
+
+	params.Snapshot = &snapshot
+
+	return workflow.NewContinueAsNewError(ctx, MtvRoomWorkflow, params)
+}
+
+type suggestedTracksInformationFetching struct {
+	Future  workflow.Future
+	Request shared_mtv.MtvRoomPendingTracksSuggestion
+}
+
+func sendSuggestedTracksInformationFetching(ctx workflow.Context, request shared_mtv.MtvRoomPendingTracksSuggestion, policy shared.TrackPlayabilityPolicy) suggestedTracksInformationFetching {
+	if request.Query != "" {
+		return suggestedTracksInformationFetching{
+			Future:  sendSearchTopTrackActivityAndForwardInitiator(ctx, request.Query, request.UserID, request.DeviceID, policy),
+			Request: request,
+		}
+	}
+
+	return suggestedTracksInformationFetching{
+		Future: sendFetchTracksInformationWithPolicyActivity(ctx, activities.FetchTracksInformationArgs{
+			TracksIDs: request.TracksIDs,
+			UserID:    request.UserID,
+			DeviceID:  request.DeviceID,
+			Policy:    policy,
+		}),
+		Request: request,
+	}
+}
+
+func removeFetchingFromSlice(slice []suggestedTracksInformationFetching, index int) []suggestedTracksInformationFetching {
 	slice[index] = slice[len(slice)-1]
 	return slice[:len(slice)-1]
 }
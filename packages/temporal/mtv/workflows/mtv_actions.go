@@ -20,12 +20,13 @@ func assignInitialFetchedTracks(internalState *MtvRoomInternalState) brainy.Acti
 			}
 
 			internalState.Tracks.Add(trackWithScore)
+			internalState.tracksSuggesterUserIDs[fetchedTrack.ID] = internalState.initialParams.RoomCreatorUserID
 			internalState.UserVoteForTrack(internalState.initialParams.RoomCreatorUserID, fetchedTrack.ID)
 
 		}
 
-		if internalState.Tracks.FirstTrackIsReadyToBePlayed(internalState.initialParams.MinimumScoreToBePlayed) {
-			setFirstTrackAsCurrentTrack(internalState)
+		if internalState.Tracks.NextTrackIsReadyToBePlayed(internalState.initialParams.MinimumScoreToBePlayed) {
+			setNextTrackAsCurrentTrack(internalState)
 		} else {
 			internalState.Timer = shared_mtv.MtvRoomTimer{}
 		}
@@ -34,14 +35,18 @@ func assignInitialFetchedTracks(internalState *MtvRoomInternalState) brainy.Acti
 	}
 }
 
-func setFirstTrackAsCurrentTrack(internalState *MtvRoomInternalState) {
+func setNextTrackAsCurrentTrack(internalState *MtvRoomInternalState) {
 	//By calling this function you assume that next track is ready to be played
 	//This should not be called outside a brainy action+cond spec
 
-	firstTrack, _ := internalState.Tracks.Shift()
+	if internalState.CurrentTrack.ID != "" {
+		internalState.AddCurrentTrackToPlayedTracks()
+	}
+
+	nextTrack, _ := internalState.Tracks.ShiftNextTrackToPlay(internalState.initialParams.MinimumScoreToBePlayed)
 
 	internalState.CurrentTrack = shared_mtv.CurrentTrack{
-		TrackMetadataWithScore: firstTrack,
+		TrackMetadataWithScore: nextTrack,
 		AlreadyElapsed:         0,
 	}
 	internalState.Timer = shared_mtv.MtvRoomTimer{
@@ -50,14 +55,17 @@ func setFirstTrackAsCurrentTrack(internalState *MtvRoomInternalState) {
 		Cancel:    nil,
 	}
 
-	//As the first track is not anymore in the tracks list, users can now suggest or vote for this song again
-	internalState.RemoveTrackFromUserTracksVotedFor(firstTrack.ID)
+	//As the next track is not anymore in the tracks list, users can now suggest or vote for this song again
+	internalState.RemoveTrackFromUserTracksVotedFor(nextTrack.ID)
+
+	//Skip votes only apply to the track they have been emitted for
+	internalState.currentTrackSkipVotesUserIDs = nil
 }
 
 func assignNextTrack(internalState *MtvRoomInternalState) brainy.Action {
 
 	return func(c brainy.Context, e brainy.Event) error {
-		setFirstTrackAsCurrentTrack(internalState)
+		setNextTrackAsCurrentTrack(internalState)
 
 		return nil
 	}
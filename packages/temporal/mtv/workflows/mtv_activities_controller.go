@@ -6,10 +6,12 @@ import (
 	"github.com/AdonisEnProvence/MusicRoom/activities"
 	activities_mtv "github.com/AdonisEnProvence/MusicRoom/mtv/activities"
 	shared_mtv "github.com/AdonisEnProvence/MusicRoom/mtv/shared"
+	"github.com/AdonisEnProvence/MusicRoom/shared"
+	"go.temporal.io/sdk/temporal"
 	"go.temporal.io/sdk/workflow"
 )
 
-func sendAcknowledgeRoomCreation(ctx workflow.Context, state shared_mtv.MtvRoomExposedState) error {
+func sendAcknowledgeRoomCreation(ctx workflow.Context, args activities_mtv.CreationAcknowledgementArgs) error {
 	ao := workflow.ActivityOptions{
 		ScheduleToStartTimeout: time.Minute,
 		StartToCloseTimeout:    time.Minute,
@@ -20,7 +22,7 @@ func sendAcknowledgeRoomCreation(ctx workflow.Context, state shared_mtv.MtvRoomE
 	if err := workflow.ExecuteActivity(
 		ctx,
 		a.CreationAcknowledgementActivity,
-		state,
+		args,
 	).Get(ctx, nil); err != nil {
 		return err
 	}
@@ -106,6 +108,36 @@ func sendAcknowledgeUpdateControlAndDelegationPermissionActivity(ctx workflow.Co
 	)
 }
 
+func sendAcknowledgeUpdateRoomSettingsActivity(ctx workflow.Context, state shared_mtv.MtvRoomExposedState) {
+	options := workflow.ActivityOptions{
+		ScheduleToStartTimeout: time.Minute,
+		StartToCloseTimeout:    time.Minute,
+	}
+	ctx = workflow.WithActivityOptions(ctx, options)
+
+	var a *activities_mtv.Activities
+	workflow.ExecuteActivity(
+		ctx,
+		a.AcknowledgeUpdateRoomSettings,
+		state,
+	)
+}
+
+func sendAcknowledgeUpdateRoomSettingsFailActivity(ctx workflow.Context, args activities_mtv.AcknowledgeUpdateRoomSettingsFailArgs) {
+	options := workflow.ActivityOptions{
+		ScheduleToStartTimeout: time.Minute,
+		StartToCloseTimeout:    time.Minute,
+	}
+	ctx = workflow.WithActivityOptions(ctx, options)
+
+	var a *activities_mtv.Activities
+	workflow.ExecuteActivity(
+		ctx,
+		a.AcknowledgeUpdateRoomSettingsFail,
+		args,
+	)
+}
+
 func sendUserLengthUpdateActivity(ctx workflow.Context, state shared_mtv.MtvRoomExposedState) {
 
 	options := workflow.ActivityOptions{
@@ -153,34 +185,58 @@ func sendNotifySuggestOrVoteUpdateActivity(ctx workflow.Context, state shared_mt
 	)
 }
 
-func sendFetchTracksInformationActivityAndForwardInitiator(ctx workflow.Context, tracksIDs []string, userID string, deviceID string) workflow.Future {
+func sendSearchTopTrackActivityAndForwardInitiator(ctx workflow.Context, query string, userID string, deviceID string, policy shared.TrackPlayabilityPolicy) workflow.Future {
 	ao := workflow.ActivityOptions{
 		ScheduleToStartTimeout: time.Minute,
 		StartToCloseTimeout:    time.Minute,
+		RetryPolicy:            activities.FetchTracksRetryPolicy,
 	}
 	ctx = workflow.WithActivityOptions(ctx, ao)
 
 	return workflow.ExecuteActivity(
 		ctx,
-		activities.FetchTracksInformationActivityAndForwardInitiator,
-		tracksIDs,
+		activities.SearchTopTrackActivityAndForwardInitiator,
+		query,
 		userID,
 		deviceID,
+		policy,
 	)
 }
 
-func sendFetchTracksInformationActivity(ctx workflow.Context, tracksIDs []string) workflow.Future {
+// The auto-DJ is asked again the next time the tracks list runs dry,
+// an unreachable source must not retry forever meanwhile.
+const autoDJFetchMaximumAttempts = 3
 
+func sendFetchAutoDJTracksActivity(ctx workflow.Context, args activities.FetchAutoDJTracksArgs) workflow.Future {
 	ao := workflow.ActivityOptions{
 		ScheduleToStartTimeout: time.Minute,
 		StartToCloseTimeout:    time.Minute,
+		RetryPolicy: &temporal.RetryPolicy{
+			MaximumAttempts:        autoDJFetchMaximumAttempts,
+			NonRetryableErrorTypes: activities.FetchTracksRetryPolicy.NonRetryableErrorTypes,
+		},
 	}
 	ctx = workflow.WithActivityOptions(ctx, ao)
 
 	return workflow.ExecuteActivity(
 		ctx,
-		activities.FetchTracksInformationActivity,
-		tracksIDs,
+		activities.FetchAutoDJTracksActivity,
+		args,
+	)
+}
+
+func sendFetchTracksInformationWithPolicyActivity(ctx workflow.Context, args activities.FetchTracksInformationArgs) workflow.Future {
+	ao := workflow.ActivityOptions{
+		ScheduleToStartTimeout: time.Minute,
+		StartToCloseTimeout:    time.Minute,
+		RetryPolicy:            activities.FetchTracksRetryPolicy,
+	}
+	ctx = workflow.WithActivityOptions(ctx, ao)
+
+	return workflow.ExecuteActivity(
+		ctx,
+		activities.FetchTracksInformationWithPolicyActivity,
+		args,
 	)
 }
 
@@ -199,6 +255,51 @@ func sendUserVoteForTrackAcknowledgementActivity(ctx workflow.Context, state sha
 	)
 }
 
+func sendUserUnvoteForTrackAcknowledgementActivity(ctx workflow.Context, state shared_mtv.MtvRoomExposedState) {
+	options := workflow.ActivityOptions{
+		ScheduleToStartTimeout: time.Minute,
+		StartToCloseTimeout:    time.Minute,
+	}
+	ctx = workflow.WithActivityOptions(ctx, options)
+
+	var a *activities_mtv.Activities
+	workflow.ExecuteActivity(
+		ctx,
+		a.UserUnvoteForTrackAcknowledgement,
+		state,
+	)
+}
+
+func sendAcknowledgeDownvotedTrackRemovalActivity(ctx workflow.Context, args activities_mtv.AcknowledgeDownvotedTrackRemovalArgs) {
+	options := workflow.ActivityOptions{
+		ScheduleToStartTimeout: time.Minute,
+		StartToCloseTimeout:    time.Minute,
+	}
+	ctx = workflow.WithActivityOptions(ctx, options)
+
+	var a *activities_mtv.Activities
+	workflow.ExecuteActivity(
+		ctx,
+		a.AcknowledgeDownvotedTrackRemoval,
+		args,
+	)
+}
+
+func sendNotifySkipVotesUpdateActivity(ctx workflow.Context, state shared_mtv.MtvRoomExposedState) {
+	options := workflow.ActivityOptions{
+		ScheduleToStartTimeout: time.Minute,
+		StartToCloseTimeout:    time.Minute,
+	}
+	ctx = workflow.WithActivityOptions(ctx, options)
+
+	var a *activities_mtv.Activities
+	workflow.ExecuteActivity(
+		ctx,
+		a.NotifySkipVotesUpdateActivity,
+		state,
+	)
+}
+
 func sendJoinActivity(ctx workflow.Context, args activities_mtv.MtvJoinCallbackRequestBody) {
 	options := workflow.ActivityOptions{
 		ScheduleToStartTimeout: time.Minute,
@@ -244,6 +345,21 @@ func sendPauseActivity(ctx workflow.Context, state shared_mtv.MtvRoomExposedStat
 	)
 }
 
+func sendSeekActivity(ctx workflow.Context, state shared_mtv.MtvRoomExposedState) {
+	options := workflow.ActivityOptions{
+		ScheduleToStartTimeout: time.Minute,
+		StartToCloseTimeout:    time.Minute,
+	}
+	ctx = workflow.WithActivityOptions(ctx, options)
+
+	var a *activities_mtv.Activities
+	workflow.ExecuteActivity(
+		ctx,
+		a.SeekActivity,
+		state,
+	)
+}
+
 func sendPlayActivity(ctx workflow.Context, state shared_mtv.MtvRoomExposedState) {
 	options := workflow.ActivityOptions{
 		ScheduleToStartTimeout: time.Minute,
@@ -273,3 +389,79 @@ func sendAcknowledgeUpdateTimeConstraintActivity(ctx workflow.Context, state sha
 		state,
 	)
 }
+
+func sendAcknowledgeUserKickedActivity(ctx workflow.Context, args activities_mtv.AcknowledgeUserKickedArgs) {
+	options := workflow.ActivityOptions{
+		ScheduleToStartTimeout: time.Minute,
+		StartToCloseTimeout:    time.Minute,
+	}
+	ctx = workflow.WithActivityOptions(ctx, options)
+
+	var a *activities_mtv.Activities
+	workflow.ExecuteActivity(
+		ctx,
+		a.AcknowledgeUserKickedActivity,
+		args,
+	)
+}
+
+func sendAcknowledgeUserBannedActivity(ctx workflow.Context, args activities_mtv.AcknowledgeUserBannedArgs) {
+	options := workflow.ActivityOptions{
+		ScheduleToStartTimeout: time.Minute,
+		StartToCloseTimeout:    time.Minute,
+	}
+	ctx = workflow.WithActivityOptions(ctx, options)
+
+	var a *activities_mtv.Activities
+	workflow.ExecuteActivity(
+		ctx,
+		a.AcknowledgeUserBannedActivity,
+		args,
+	)
+}
+
+func sendAcknowledgeTracksRemovedActivity(ctx workflow.Context, args activities_mtv.AcknowledgeTracksRemovedArgs) {
+	options := workflow.ActivityOptions{
+		ScheduleToStartTimeout: time.Minute,
+		StartToCloseTimeout:    time.Minute,
+	}
+	ctx = workflow.WithActivityOptions(ctx, options)
+
+	var a *activities_mtv.Activities
+	workflow.ExecuteActivity(
+		ctx,
+		a.AcknowledgeTracksRemovedActivity,
+		args,
+	)
+}
+
+func sendRejectBannedUserJoinActivity(ctx workflow.Context, args activities_mtv.RejectBannedUserJoinArgs) {
+	options := workflow.ActivityOptions{
+		ScheduleToStartTimeout: time.Minute,
+		StartToCloseTimeout:    time.Minute,
+	}
+	ctx = workflow.WithActivityOptions(ctx, options)
+
+	var a *activities_mtv.Activities
+	workflow.ExecuteActivity(
+		ctx,
+		a.RejectBannedUserJoinActivity,
+		args,
+	)
+}
+
+//Waits for Adonis to be notified as the workflow terminates right after
+func sendRoomExpiredActivity(ctx workflow.Context, args activities_mtv.RoomExpiredActivityArgs) error {
+	options := workflow.ActivityOptions{
+		ScheduleToStartTimeout: time.Minute,
+		StartToCloseTimeout:    time.Minute,
+	}
+	ctx = workflow.WithActivityOptions(ctx, options)
+
+	var a *activities_mtv.Activities
+	return workflow.ExecuteActivity(
+		ctx,
+		a.RoomExpiredActivity,
+		args,
+	).Get(ctx, nil)
+}
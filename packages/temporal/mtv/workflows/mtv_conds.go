@@ -13,12 +13,42 @@ func userHasPermissionAndHasNextTrackToPlay(internalState *MtvRoomInternalState)
 		if userDoesNotHaveControlAndDelegationPermission {
 			return false
 		}
-		hasNextTrackToPlay := internalState.Tracks.FirstTrackIsReadyToBePlayed(internalState.initialParams.MinimumScoreToBePlayed)
+		hasNextTrackToPlay := internalState.Tracks.NextTrackIsReadyToBePlayed(internalState.initialParams.MinimumScoreToBePlayed)
 
 		return hasNextTrackToPlay
 	}
 }
 
+func skipVoteReachesRequiredSkipVotesAndHasNextTrackToPlay(internalState *MtvRoomInternalState) brainy.Cond {
+	return func(c brainy.Context, e brainy.Event) bool {
+		event := e.(MtvRoomUserVoteToSkipCurrentTrackEvent)
+
+		if !internalState.UserCanVoteToSkipCurrentTrack(event.UserID) {
+			return false
+		}
+
+		skipVotesWithUserVote := internalState.CountCurrentTrackSkipVotes() + 1
+		skipVoteReachesRequiredSkipVotes := skipVotesWithUserVote >= internalState.GetRequiredSkipVotes()
+		hasNextTrackToPlay := internalState.Tracks.NextTrackIsReadyToBePlayed(internalState.initialParams.MinimumScoreToBePlayed)
+
+		return skipVoteReachesRequiredSkipVotes && hasNextTrackToPlay
+	}
+}
+
+//Users leaving the room can make the skip votes already cast reach the required skip votes
+func skipVotesReachRequiredSkipVotesAndHasNextTrackToPlay(internalState *MtvRoomInternalState) brainy.Cond {
+	return func(c brainy.Context, e brainy.Event) bool {
+		if !internalState.Playing {
+			return false
+		}
+
+		skipVotesReachRequiredSkipVotes := internalState.CountCurrentTrackSkipVotes() >= internalState.GetRequiredSkipVotes()
+		hasNextTrackToPlay := internalState.Tracks.NextTrackIsReadyToBePlayed(internalState.initialParams.MinimumScoreToBePlayed)
+
+		return skipVotesReachRequiredSkipVotes && hasNextTrackToPlay
+	}
+}
+
 func checkUserPermissionAndCanPlayCurrentTrack(internalState *MtvRoomInternalState) brainy.Cond {
 	return func(c brainy.Context, e brainy.Event) bool {
 		event := e.(MtvRoomPlayEvent)
@@ -29,7 +59,7 @@ func checkUserPermissionAndCanPlayCurrentTrack(internalState *MtvRoomInternalSta
 		}
 
 		hasReachedEndOfCurrentTrack := internalState.CurrentTrack.AlreadyElapsed == internalState.CurrentTrack.Duration
-		hasNextTrackToPlay := internalState.Tracks.FirstTrackIsReadyToBePlayed(internalState.initialParams.MinimumScoreToBePlayed)
+		hasNextTrackToPlay := internalState.Tracks.NextTrackIsReadyToBePlayed(internalState.initialParams.MinimumScoreToBePlayed)
 		hasNoNextTrackToPlay := !hasNextTrackToPlay
 		canNotPlayCurrentTrack := hasReachedEndOfCurrentTrack && hasNoNextTrackToPlay
 		canPlayCurrentTrack := !canNotPlayCurrentTrack
@@ -46,10 +76,80 @@ func userHasPermissionToPauseCurrentTrack(internalState *MtvRoomInternalState) b
 	}
 }
 
+func userHasPermissionAndCanSeekCurrentTrack(internalState *MtvRoomInternalState) brainy.Cond {
+	return func(c brainy.Context, e brainy.Event) bool {
+		event := e.(MtvRoomSeekEvent)
+
+		userDoesNotHaveControlAndDelegationPermission := !internalState.UserHasControlAndDelegationPermission(event.UserID)
+		if userDoesNotHaveControlAndDelegationPermission {
+			return false
+		}
+
+		hasNoCurrentTrack := internalState.CurrentTrack.ID == ""
+		if hasNoCurrentTrack {
+			return false
+		}
+
+		offsetIsInCurrentTrack := event.Offset >= 0 && event.Offset < internalState.CurrentTrack.Duration
+
+		return offsetIsInCurrentTrack
+	}
+}
+
+func userIsRoomCreator(internalState *MtvRoomInternalState) brainy.Cond {
+	return func(c brainy.Context, e brainy.Event) bool {
+		event := e.(MtvRoomUpdateRoomSettingsEvent)
+
+		return event.UserID == internalState.initialParams.RoomCreatorUserID
+	}
+}
+
+func userIsRoomCreatorAndSettingsUpdateIsValid(internalState *MtvRoomInternalState) brainy.Cond {
+	return func(c brainy.Context, e brainy.Event) bool {
+		event := e.(MtvRoomUpdateRoomSettingsEvent)
+
+		if event.UserID != internalState.initialParams.RoomCreatorUserID {
+			return false
+		}
+		_, settingsUpdateIsValid := internalState.initialParams.CheckSettingsUpdateValidity(event.Settings)
+
+		return settingsUpdateIsValid
+	}
+}
+
+func userCanRemoveTracks(internalState *MtvRoomInternalState) brainy.Cond {
+	return func(c brainy.Context, e brainy.Event) bool {
+		event := e.(MtvRoomRemoveTracksEvent)
+
+		return internalState.UserIsCreatorOrHasControlAndDelegationPermission(event.UserID)
+	}
+}
+
+func userIsBanned(internalState *MtvRoomInternalState) brainy.Cond {
+	return func(c brainy.Context, e brainy.Event) bool {
+		event := e.(MtvRoomUserJoiningRoomEvent)
+
+		return internalState.IsBanned(event.User.UserID)
+	}
+}
+
+func userCanModerate(internalState *MtvRoomInternalState) brainy.Cond {
+	return func(c brainy.Context, e brainy.Event) bool {
+		switch event := e.(type) {
+		case MtvRoomKickUserEvent:
+			return internalState.UserCanModerate(event.EmitterUserID, event.KickedUserID)
+		case MtvRoomBanUserEvent:
+			return internalState.UserCanModerate(event.EmitterUserID, event.BannedUserID)
+		default:
+			return false
+		}
+	}
+}
+
 func currentTrackEndedAndNextTrackIsReadyToBePlayed(internalState *MtvRoomInternalState) brainy.Cond {
 	return func(c brainy.Context, e brainy.Event) bool {
 		//We might need a delta ? between elapsed and maxDuration
-		nextTrackIsReadyToBePlayed := internalState.Tracks.FirstTrackIsReadyToBePlayed(internalState.initialParams.MinimumScoreToBePlayed)
+		nextTrackIsReadyToBePlayed := internalState.Tracks.NextTrackIsReadyToBePlayed(internalState.initialParams.MinimumScoreToBePlayed)
 		//Remark:
 		//If of all initials tracks fetching fails or not initial tracks are eligible to be
 		//load as currentTrack during room creation, we expect the room to autoplay
@@ -1,6 +1,8 @@
 package mtv
 
 import (
+	"time"
+
 	shared_mtv "github.com/AdonisEnProvence/MusicRoom/mtv/shared"
 	"github.com/AdonisEnProvence/MusicRoom/shared"
 	"github.com/Devessier/brainy"
@@ -28,13 +30,31 @@ type MtvRoomInitialTracksFetchedEvent struct {
 	brainy.EventWithType
 
 	Tracks []shared.TrackMetadata
+	// Initial tracks that could not be fetched or that can not be played in the room
+	RejectedTracks []shared.RejectedTrack
 }
 
-func NewMtvRoomInitialTracksFetchedEvent(tracks []shared.TrackMetadata) MtvRoomInitialTracksFetchedEvent {
+func NewMtvRoomInitialTracksFetchedEvent(tracks []shared.TrackMetadata, rejectedTracks []shared.RejectedTrack) MtvRoomInitialTracksFetchedEvent {
 	return MtvRoomInitialTracksFetchedEvent{
 		EventWithType: brainy.EventWithType{
 			Event: MtvRoomInitialTracksFetched,
 		},
+		Tracks:         tracks,
+		RejectedTracks: rejectedTracks,
+	}
+}
+
+type MtvRoomAutoDJTracksFetchedEvent struct {
+	brainy.EventWithType
+
+	Tracks []shared.TrackMetadata
+}
+
+func NewMtvRoomAutoDJTracksFetchedEvent(tracks []shared.TrackMetadata) MtvRoomAutoDJTracksFetchedEvent {
+	return MtvRoomAutoDJTracksFetchedEvent{
+		EventWithType: brainy.EventWithType{
+			Event: MtvRoomAutoDJTracksFetched,
+		},
 		Tracks: tracks,
 	}
 }
@@ -73,6 +93,42 @@ func NewMtvRoomUserVoteForTrackEvent(userID string, trackID string) MtvRoomUserV
 	}
 }
 
+type MtvRoomUserUnvoteForTrackEvent struct {
+	brainy.EventWithType
+
+	UserID  string
+	TrackID string
+}
+
+type MtvRoomUserDownvoteForTrackEvent struct {
+	brainy.EventWithType
+
+	UserID  string
+	TrackID string
+}
+
+func NewMtvRoomUserDownvoteForTrackEvent(userID string, trackID string) MtvRoomUserDownvoteForTrackEvent {
+	return MtvRoomUserDownvoteForTrackEvent{
+		EventWithType: brainy.EventWithType{
+			Event: MtvRoomDownvoteForTrackEvent,
+		},
+
+		UserID:  userID,
+		TrackID: trackID,
+	}
+}
+
+func NewMtvRoomUserUnvoteForTrackEvent(userID string, trackID string) MtvRoomUserUnvoteForTrackEvent {
+	return MtvRoomUserUnvoteForTrackEvent{
+		EventWithType: brainy.EventWithType{
+			Event: MtvRoomUnvoteForTrackEvent,
+		},
+
+		UserID:  userID,
+		TrackID: trackID,
+	}
+}
+
 type MtvRoomUserJoiningRoomEvent struct {
 	brainy.EventWithType
 
@@ -111,12 +167,14 @@ type MtvRoomSuggestTracksEvent struct {
 	brainy.EventWithType
 
 	TracksToSuggest []string
+	Query           string
 	UserID          string
 	DeviceID        string
 }
 
 type NewMtvRoomSuggestTracksEventArgs struct {
 	TracksToSuggest []string
+	Query           string
 	UserID          string
 	DeviceID        string
 }
@@ -128,6 +186,7 @@ func NewMtvRoomSuggestTracksEvent(args NewMtvRoomSuggestTracksEventArgs) MtvRoom
 		},
 
 		TracksToSuggest: args.TracksToSuggest,
+		Query:           args.Query,
 		UserID:          args.UserID,
 		DeviceID:        args.DeviceID,
 	}
@@ -137,12 +196,14 @@ type MtvRoomSuggestedTracksFetchedEvent struct {
 	brainy.EventWithType
 
 	SuggestedTracksInformation []shared.TrackMetadata
+	RejectedTracks             []shared.RejectedTrack
 	UserID                     string
 	DeviceID                   string
 }
 
 type NewMtvRoomSuggestedTracksFetchedEventArgs struct {
 	SuggestedTracksInformation []shared.TrackMetadata
+	RejectedTracks             []shared.RejectedTrack
 	UserID                     string
 	DeviceID                   string
 }
@@ -154,6 +215,7 @@ func NewMtvRoomSuggestedTracksFetchedEvent(args NewMtvRoomSuggestedTracksFetched
 		},
 
 		SuggestedTracksInformation: args.SuggestedTracksInformation,
+		RejectedTracks:             args.RejectedTracks,
 		UserID:                     args.UserID,
 		DeviceID:                   args.DeviceID,
 	}
@@ -207,6 +269,78 @@ func NewMtvRoomUpdateDelegationOwnerEvent(newDelegationOwnerUserID string, emitt
 	}
 }
 
+type MtvRoomKickUserEvent struct {
+	brainy.EventWithType
+
+	KickedUserID  string
+	EmitterUserID string
+}
+
+func NewMtvRoomKickUserEvent(kickedUserID string, emitterUserID string) MtvRoomKickUserEvent {
+	return MtvRoomKickUserEvent{
+		EventWithType: brainy.EventWithType{
+			Event: MtvRoomKickUser,
+		},
+
+		KickedUserID:  kickedUserID,
+		EmitterUserID: emitterUserID,
+	}
+}
+
+type MtvRoomBanUserEvent struct {
+	brainy.EventWithType
+
+	BannedUserID  string
+	EmitterUserID string
+}
+
+func NewMtvRoomBanUserEvent(bannedUserID string, emitterUserID string) MtvRoomBanUserEvent {
+	return MtvRoomBanUserEvent{
+		EventWithType: brainy.EventWithType{
+			Event: MtvRoomBanUser,
+		},
+
+		BannedUserID:  bannedUserID,
+		EmitterUserID: emitterUserID,
+	}
+}
+
+type MtvRoomRemoveTracksEvent struct {
+	brainy.EventWithType
+
+	UserID    string
+	TracksIDs []string
+}
+
+func NewMtvRoomRemoveTracksEvent(userID string, tracksIDs []string) MtvRoomRemoveTracksEvent {
+	return MtvRoomRemoveTracksEvent{
+		EventWithType: brainy.EventWithType{
+			Event: MtvRoomRemoveTracks,
+		},
+
+		UserID:    userID,
+		TracksIDs: tracksIDs,
+	}
+}
+
+type MtvRoomUpdateRoomSettingsEvent struct {
+	brainy.EventWithType
+
+	UserID   string
+	Settings shared_mtv.MtvRoomSettingsUpdate
+}
+
+func NewMtvRoomUpdateRoomSettingsEvent(userID string, settings shared_mtv.MtvRoomSettingsUpdate) MtvRoomUpdateRoomSettingsEvent {
+	return MtvRoomUpdateRoomSettingsEvent{
+		EventWithType: brainy.EventWithType{
+			Event: MtvRoomUpdateRoomSettings,
+		},
+
+		UserID:   userID,
+		Settings: settings,
+	}
+}
+
 type MtvRoomUpdateControlAndDelegationPermissionEvent struct {
 	brainy.EventWithType
 
@@ -270,6 +404,22 @@ func NewMtvRoomPauseEvent(args NewMtvRoomPauseEventArgs) MtvRoomPauseEvent {
 	}
 }
 
+type MtvRoomUserVoteToSkipCurrentTrackEvent struct {
+	brainy.EventWithType
+
+	UserID string
+}
+
+func NewMtvRoomUserVoteToSkipCurrentTrackEvent(userID string) MtvRoomUserVoteToSkipCurrentTrackEvent {
+	return MtvRoomUserVoteToSkipCurrentTrackEvent{
+		EventWithType: brainy.EventWithType{
+			Event: MtvRoomVoteToSkipCurrentTrack,
+		},
+
+		UserID: userID,
+	}
+}
+
 type MtvRoomGoToNextTrackEvent struct {
 	brainy.EventWithType
 
@@ -290,6 +440,29 @@ func NewMtvRoomGoToNextTrackEvent(args NewMtvRoomGoToNextTrackEventArgs) MtvRoom
 	}
 }
 
+type MtvRoomSeekEvent struct {
+	brainy.EventWithType
+
+	UserID string
+	Offset time.Duration
+}
+
+type NewMtvRoomSeekEventArgs struct {
+	UserID string
+	Offset time.Duration
+}
+
+func NewMtvRoomSeekEvent(args NewMtvRoomSeekEventArgs) MtvRoomSeekEvent {
+	return MtvRoomSeekEvent{
+		EventWithType: brainy.EventWithType{
+			Event: MtvRoomSeek,
+		},
+
+		UserID: args.UserID,
+		Offset: args.Offset,
+	}
+}
+
 type MtvRoomTimeConstraintTimerExpirationEvent struct {
 	brainy.EventWithType
 
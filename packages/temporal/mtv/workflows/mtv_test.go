@@ -16,6 +16,7 @@ import (
 	"github.com/bxcodec/faker/v3"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/suite"
+	"go.temporal.io/sdk/converter"
 	"go.temporal.io/sdk/temporal"
 	"go.temporal.io/sdk/testsuite"
 	"go.temporal.io/sdk/workflow"
@@ -72,6 +73,18 @@ func (s *UnitTestSuite) getUsersList() []shared_mtv.ExposedInternalStateUserList
 	return usersList
 }
 
+func (s *UnitTestSuite) getPlayedTracks(page int) shared_mtv.MtvRoomPlayedTracksPage {
+	var playedTracksPage shared_mtv.MtvRoomPlayedTracksPage
+
+	res, err := s.env.QueryWorkflow(shared_mtv.MtvGetPlayedTracksQuery, page)
+	s.NoError(err)
+
+	err = res.Get(&playedTracksPage)
+	s.NoError(err)
+
+	return playedTracksPage
+}
+
 func (s *UnitTestSuite) emitUnkownSignal() {
 	fmt.Println("-----EMIT UNKOWN SIGNAL CALLED IN TEST-----")
 	unkownSignal := struct {
@@ -121,6 +134,27 @@ func (s *UnitTestSuite) emitVoteSignal(args shared_mtv.NewVoteForTrackSignalArgs
 	s.env.SignalWorkflow(shared_mtv.SignalChannelName, voteForTrackSignal)
 }
 
+func (s *UnitTestSuite) emitUnvoteSignal(args shared_mtv.NewUnvoteForTrackSignalArgs) {
+	fmt.Println("-----EMIT UNVOTE FOR TRACK CALLED IN TEST-----")
+	signal := shared_mtv.NewUnvoteForTrackSignal(args)
+
+	s.env.SignalWorkflow(shared_mtv.SignalChannelName, signal)
+}
+
+func (s *UnitTestSuite) emitDownvoteSignal(args shared_mtv.NewDownvoteForTrackSignalArgs) {
+	fmt.Println("-----EMIT DOWNVOTE FOR TRACK CALLED IN TEST-----")
+	signal := shared_mtv.NewDownvoteForTrackSignal(args)
+
+	s.env.SignalWorkflow(shared_mtv.SignalChannelName, signal)
+}
+
+func (s *UnitTestSuite) emitVoteToSkipCurrentTrackSignal(args shared_mtv.NewVoteToSkipCurrentTrackSignalArgs) {
+	fmt.Println("-----EMIT VOTE TO SKIP CURRENT TRACK CALLED IN TEST-----")
+	signal := shared_mtv.NewVoteToSkipCurrentTrackSignal(args)
+
+	s.env.SignalWorkflow(shared_mtv.SignalChannelName, signal)
+}
+
 func (s *UnitTestSuite) emitJoinSignal(args shared_mtv.NewJoinSignalArgs) {
 	fmt.Println("-----EMIT JOIN CALLED IN TEST-----")
 	signal := shared_mtv.NewJoinSignal(shared_mtv.NewJoinSignalArgs{
@@ -162,11 +196,9 @@ func (s *UnitTestSuite) mockOnceSuggest(userID string, deviceID string, roomID s
 	var a *activities_mtv.Activities
 
 	s.env.OnActivity(
-		activities.FetchTracksInformationActivityAndForwardInitiator,
-		mock.Anything,
+		activities.FetchTracksInformationWithPolicyActivity,
 		mock.Anything,
-		userID,
-		deviceID,
+		fetchTracksInformationArgsForInitiator(nil, userID, deviceID),
 	).Return(activities.FetchedTracksInformationWithInitiator{
 		Metadata: tracks,
 		UserID:   userID,
@@ -194,6 +226,41 @@ func (s *UnitTestSuite) emitGoToNextTrackSignal(args shared_mtv.NewGoToNextTrack
 	s.env.SignalWorkflow(shared_mtv.SignalChannelName, goToNextTrackSignal)
 }
 
+func (s *UnitTestSuite) emitSeekSignal(args shared_mtv.NewSeekSignalArgs) {
+	fmt.Println("-----EMIT SEEK CALLED IN TEST-----")
+	signal := shared_mtv.NewSeekSignal(args)
+
+	s.env.SignalWorkflow(shared_mtv.SignalChannelName, signal)
+}
+
+func (s *UnitTestSuite) emitKickUserSignal(args shared_mtv.NewKickUserSignalArgs) {
+	fmt.Println("-----EMIT KICK USER CALLED IN TEST-----")
+	signal := shared_mtv.NewKickUserSignal(args)
+
+	s.env.SignalWorkflow(shared_mtv.SignalChannelName, signal)
+}
+
+func (s *UnitTestSuite) emitBanUserSignal(args shared_mtv.NewBanUserSignalArgs) {
+	fmt.Println("-----EMIT BAN USER CALLED IN TEST-----")
+	signal := shared_mtv.NewBanUserSignal(args)
+
+	s.env.SignalWorkflow(shared_mtv.SignalChannelName, signal)
+}
+
+func (s *UnitTestSuite) emitUpdateRoomSettingsSignal(args shared_mtv.NewUpdateRoomSettingsSignalArgs) {
+	fmt.Println("-----EMIT UPDATE ROOM SETTINGS CALLED IN TEST-----")
+	signal := shared_mtv.NewUpdateRoomSettingsSignal(args)
+
+	s.env.SignalWorkflow(shared_mtv.SignalChannelName, signal)
+}
+
+func (s *UnitTestSuite) emitRemoveTracksSignal(args shared_mtv.NewRemoveTracksSignalArgs) {
+	fmt.Println("-----EMIT REMOVE TRACKS CALLED IN TEST-----")
+	signal := shared_mtv.NewRemoveTracksSignal(args)
+
+	s.env.SignalWorkflow(shared_mtv.SignalChannelName, signal)
+}
+
 func (s *UnitTestSuite) initTestEnv() (func(), func(callback func(), durationToAdd time.Duration)) {
 	var temporalTemporality time.Duration
 	now := time.Now()
@@ -225,6 +292,40 @@ func (s *UnitTestSuite) initTestEnv() (func(), func(callback func(), durationToA
 		}
 }
 
+// fetchTracksInformationArgs matches the arguments of FetchTracksInformationWithPolicyActivity
+// requesting tracksIDs, whoever the initiator and whatever the policy are.
+func fetchTracksInformationArgs(tracksIDs []string) interface{} {
+	return mock.MatchedBy(func(args activities.FetchTracksInformationArgs) bool {
+		return sameTracksIDs(args.TracksIDs, tracksIDs)
+	})
+}
+
+// fetchTracksInformationArgsForInitiator also checks the initiator of the request.
+// Any tracks IDs are matched when tracksIDs is nil.
+func fetchTracksInformationArgsForInitiator(tracksIDs []string, userID string, deviceID string) interface{} {
+	return mock.MatchedBy(func(args activities.FetchTracksInformationArgs) bool {
+		if tracksIDs != nil && !sameTracksIDs(args.TracksIDs, tracksIDs) {
+			return false
+		}
+
+		return args.UserID == userID && args.DeviceID == deviceID
+	})
+}
+
+func sameTracksIDs(a []string, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	for index := range a {
+		if a[index] != b[index] {
+			return false
+		}
+	}
+
+	return true
+}
+
 func getWorkflowInitParams(tracksIDs []string, minimumScoreToBePlayed int) (shared_mtv.MtvRoomParameters, string) {
 	var (
 		workflowID          = faker.UUIDHyphenated()
@@ -288,10 +389,10 @@ func (s *UnitTestSuite) Test_PlayThenPauseTrack() {
 	params, _ := getWorkflowInitParams(tracksIDs, 1)
 
 	s.env.OnActivity(
-		activities.FetchTracksInformationActivity,
+		activities.FetchTracksInformationWithPolicyActivity,
 		mock.Anything,
-		tracksIDs,
-	).Return(tracks, nil).Once()
+		fetchTracksInformationArgs(tracksIDs),
+	).Return(activities.FetchedTracksInformationWithInitiator{Metadata: tracks}, nil).Once()
 	s.env.OnActivity(
 		a.CreationAcknowledgementActivity,
 		mock.Anything,
@@ -480,10 +581,10 @@ func (s *UnitTestSuite) Test_JoinCreatedRoom() {
 	defer resetMock()
 
 	s.env.OnActivity(
-		activities.FetchTracksInformationActivity,
+		activities.FetchTracksInformationWithPolicyActivity,
 		mock.Anything,
-		tracksIDs,
-	).Return(tracks, nil).Once()
+		fetchTracksInformationArgs(tracksIDs),
+	).Return(activities.FetchedTracksInformationWithInitiator{Metadata: tracks}, nil).Once()
 	s.env.OnActivity(
 		a.CreationAcknowledgementActivity,
 		mock.Anything,
@@ -647,10 +748,10 @@ func (s *UnitTestSuite) Test_ChangeUserEmittingDevice() {
 	defer resetMock()
 
 	s.env.OnActivity(
-		activities.FetchTracksInformationActivity,
+		activities.FetchTracksInformationWithPolicyActivity,
 		mock.Anything,
-		tracksIDs,
-	).Return(tracks, nil).Once()
+		fetchTracksInformationArgs(tracksIDs),
+	).Return(activities.FetchedTracksInformationWithInitiator{Metadata: tracks}, nil).Once()
 	s.env.OnActivity(
 		a.ChangeUserEmittingDeviceActivity,
 		mock.Anything,
@@ -826,10 +927,10 @@ func (s *UnitTestSuite) Test_GoToNextTrack() {
 	defer resetMock()
 
 	s.env.OnActivity(
-		activities.FetchTracksInformationActivity,
+		activities.FetchTracksInformationWithPolicyActivity,
 		mock.Anything,
-		tracksIDs,
-	).Return(tracks, nil).Once()
+		fetchTracksInformationArgs(tracksIDs),
+	).Return(activities.FetchedTracksInformationWithInitiator{Metadata: tracks}, nil).Once()
 	s.env.OnActivity(
 		a.CreationAcknowledgementActivity,
 		mock.Anything,
@@ -936,6 +1037,251 @@ func (s *UnitTestSuite) Test_GoToNextTrack() {
 	s.ErrorIs(err, workflow.ErrDeadlineExceeded, "The workflow ran on an infinite loop")
 }
 
+func (s *UnitTestSuite) Test_PlayedTracksHistory() {
+	var (
+		a *activities_mtv.Activities
+
+		defaultDuration = 1 * time.Millisecond
+	)
+
+	tracks := []shared.TrackMetadata{
+		{
+			ID:         faker.UUIDHyphenated(),
+			Title:      faker.Word(),
+			ArtistName: faker.Name(),
+			Duration:   random.GenerateRandomDuration(),
+		},
+		{
+			ID:         faker.UUIDHyphenated(),
+			Title:      faker.Word(),
+			ArtistName: faker.Name(),
+			Duration:   random.GenerateRandomDuration(),
+		},
+		{
+			ID:         faker.UUIDHyphenated(),
+			Title:      faker.Word(),
+			ArtistName: faker.Name(),
+			Duration:   random.GenerateRandomDuration(),
+		},
+	}
+	tracksIDs := []string{tracks[0].ID, tracks[1].ID, tracks[2].ID}
+	params, _ := getWorkflowInitParams(tracksIDs, 1)
+
+	resetMock, registerDelayedCallbackWrapper := s.initTestEnv()
+
+	defer resetMock()
+
+	s.env.OnActivity(
+		activities.FetchTracksInformationWithPolicyActivity,
+		mock.Anything,
+		fetchTracksInformationArgs(tracksIDs),
+	).Return(activities.FetchedTracksInformationWithInitiator{Metadata: tracks}, nil).Once()
+	s.env.OnActivity(
+		a.CreationAcknowledgementActivity,
+		mock.Anything,
+		mock.Anything,
+	).Return(nil).Once()
+	s.env.OnActivity(
+		a.PlayActivity,
+		mock.Anything,
+		mock.Anything,
+	).Return(nil).Times(3)
+
+	// 1. Nothing has been played yet.
+	checkHistoryIsEmpty := defaultDuration
+	registerDelayedCallbackWrapper(func() {
+		playedTracksPage := s.getPlayedTracks(1)
+
+		s.Equal(0, playedTracksPage.TotalEntries)
+		s.False(playedTracksPage.HasMore)
+		s.Empty(playedTracksPage.Data)
+	}, checkHistoryIsEmpty)
+
+	play := defaultDuration
+	registerDelayedCallbackWrapper(func() {
+		s.emitPlaySignal(shared_mtv.NewPlaySignalArgs{
+			UserID: params.RoomCreatorUserID,
+		})
+	}, play)
+
+	// 2. The first track is skipped after having been played for one second.
+	goToNextTrack := time.Second
+	registerDelayedCallbackWrapper(func() {
+		s.emitGoToNextTrackSignal(shared_mtv.NewGoToNextTrackSignalArgs{
+			UserID: params.RoomCreatorUserID,
+		})
+	}, goToNextTrack)
+
+	// 3. The second track is played until its end.
+	checkHistory := tracks[1].Duration + defaultDuration
+	registerDelayedCallbackWrapper(func() {
+		mtvState := s.getMtvState(shared_mtv.NoRelatedUserID)
+		s.Equal(tracks[2].ID, mtvState.CurrentTrack.ID)
+
+		playedTracksPage := s.getPlayedTracks(1)
+
+		s.Equal(1, playedTracksPage.Page)
+		s.Equal(2, playedTracksPage.TotalEntries)
+		s.False(playedTracksPage.HasMore)
+		s.Len(playedTracksPage.Data, 2)
+
+		lastPlayedTrack := playedTracksPage.Data[0]
+		s.Equal(tracks[1].ID, lastPlayedTrack.ID)
+		s.Equal(tracks[1].Duration.Milliseconds(), lastPlayedTrack.PlayedDuration)
+		s.False(lastPlayedTrack.Skipped)
+		s.NotNil(lastPlayedTrack.StartedAt)
+		s.Equal(params.RoomCreatorUserID, lastPlayedTrack.SuggesterUserID)
+
+		firstPlayedTrack := playedTracksPage.Data[1]
+		s.Equal(tracks[0].ID, firstPlayedTrack.ID)
+		s.Equal(time.Second.Milliseconds(), firstPlayedTrack.PlayedDuration)
+		s.True(firstPlayedTrack.Skipped)
+		s.NotNil(firstPlayedTrack.StartedAt)
+		s.Equal(params.RoomCreatorUserID, firstPlayedTrack.SuggesterUserID)
+
+		s.Empty(s.getPlayedTracks(2).Data)
+	}, checkHistory)
+
+	s.env.ExecuteWorkflow(MtvRoomWorkflow, params)
+
+	s.True(s.env.IsWorkflowCompleted())
+	err := s.env.GetWorkflowError()
+	s.ErrorIs(err, workflow.ErrDeadlineExceeded, "The workflow ran on an infinite loop")
+}
+
+func (s *UnitTestSuite) Test_SeekInCurrentTrack() {
+	var (
+		a *activities_mtv.Activities
+
+		defaultDuration = 1 * time.Millisecond
+	)
+
+	tracks := []shared.TrackMetadata{
+		{
+			ID:         faker.UUIDHyphenated(),
+			Title:      faker.Word(),
+			ArtistName: faker.Name(),
+			Duration:   random.GenerateRandomDuration(),
+		},
+		{
+			ID:         faker.UUIDHyphenated(),
+			Title:      faker.Word(),
+			ArtistName: faker.Name(),
+			Duration:   random.GenerateRandomDuration(),
+		},
+	}
+	tracksIDs := []string{tracks[0].ID, tracks[1].ID}
+	params, _ := getWorkflowInitParams(tracksIDs, 1)
+
+	resetMock, registerDelayedCallbackWrapper := s.initTestEnv()
+
+	defer resetMock()
+
+	s.env.OnActivity(
+		activities.FetchTracksInformationWithPolicyActivity,
+		mock.Anything,
+		fetchTracksInformationArgs(tracksIDs),
+	).Return(activities.FetchedTracksInformationWithInitiator{Metadata: tracks}, nil).Once()
+	s.env.OnActivity(
+		a.CreationAcknowledgementActivity,
+		mock.Anything,
+		mock.Anything,
+	).Return(nil).Once()
+	s.env.OnActivity(
+		a.PauseActivity,
+		mock.Anything,
+		mock.Anything,
+	).Return(nil)
+	s.env.OnActivity(
+		a.SeekActivity,
+		mock.Anything,
+		mock.Anything,
+	).Return(nil).Once()
+	s.env.OnActivity(
+		a.PlayActivity,
+		mock.Anything,
+		mock.Anything,
+	).Return(nil).Times(3)
+
+	// 1. Seeking in a paused room updates the elapsed time and resyncs the devices.
+	seekWhilePaused := defaultDuration
+	registerDelayedCallbackWrapper(func() {
+		s.emitSeekSignal(shared_mtv.NewSeekSignalArgs{
+			UserID: params.RoomCreatorUserID,
+			Offset: 2 * time.Second,
+		})
+	}, seekWhilePaused)
+
+	// 2. Offsets outside of the current track are rejected.
+	seekAfterCurrentTrackEnd := defaultDuration
+	registerDelayedCallbackWrapper(func() {
+		s.emitSeekSignal(shared_mtv.NewSeekSignalArgs{
+			UserID: params.RoomCreatorUserID,
+			Offset: tracks[0].Duration,
+		})
+	}, seekAfterCurrentTrackEnd)
+
+	checkSeekWhilePaused := defaultDuration
+	registerDelayedCallbackWrapper(func() {
+		mtvState := s.getMtvState(shared_mtv.NoRelatedUserID)
+
+		s.False(mtvState.Playing)
+		s.Equal(tracks[0].ID, mtvState.CurrentTrack.ID)
+		s.Equal((2 * time.Second).Milliseconds(), mtvState.CurrentTrack.Elapsed)
+	}, checkSeekWhilePaused)
+
+	play := defaultDuration
+	registerDelayedCallbackWrapper(func() {
+		s.emitPlaySignal(shared_mtv.NewPlaySignalArgs{
+			UserID: params.RoomCreatorUserID,
+		})
+	}, play)
+
+	// 3. Seeking in a playing room re-arms the current track timer.
+	seekWhilePlaying := time.Second
+	registerDelayedCallbackWrapper(func() {
+		s.emitSeekSignal(shared_mtv.NewSeekSignalArgs{
+			UserID: params.RoomCreatorUserID,
+			Offset: 5 * time.Second,
+		})
+	}, seekWhilePlaying)
+
+	checkSeekWhilePlaying := defaultDuration
+	registerDelayedCallbackWrapper(func() {
+		mtvState := s.getMtvState(shared_mtv.NoRelatedUserID)
+
+		s.True(mtvState.Playing)
+		s.Equal(tracks[0].ID, mtvState.CurrentTrack.ID)
+		s.Equal((5*time.Second + defaultDuration).Milliseconds(), mtvState.CurrentTrack.Elapsed)
+	}, checkSeekWhilePlaying)
+
+	// 4. The current track ends according to the new elapsed time.
+	// It has been played for one second before the seek and from the seek offset to its end,
+	// the seeks moved it two seconds forward while paused and two seconds forward while playing.
+	checkCurrentTrackEnded := tracks[0].Duration - 5*time.Second
+	registerDelayedCallbackWrapper(func() {
+		mtvState := s.getMtvState(shared_mtv.NoRelatedUserID)
+
+		s.True(mtvState.Playing)
+		s.Equal(tracks[1].ID, mtvState.CurrentTrack.ID)
+
+		playedTracksPage := s.getPlayedTracks(1)
+		s.Len(playedTracksPage.Data, 1)
+
+		playedTrack := playedTracksPage.Data[0]
+		s.Equal(tracks[0].ID, playedTrack.ID)
+		s.Equal((4 * time.Second).Milliseconds(), playedTrack.SeekedDuration)
+		s.Equal((tracks[0].Duration - 4*time.Second).Milliseconds(), playedTrack.PlayedDuration)
+		s.False(playedTrack.Skipped)
+	}, checkCurrentTrackEnded)
+
+	s.env.ExecuteWorkflow(MtvRoomWorkflow, params)
+
+	s.True(s.env.IsWorkflowCompleted())
+	err := s.env.GetWorkflowError()
+	s.ErrorIs(err, workflow.ErrDeadlineExceeded, "The workflow ran on an infinite loop")
+}
+
 func (s *UnitTestSuite) Test_UserLeaveRoom() {
 	var (
 		a *activities_mtv.Activities
@@ -967,10 +1313,10 @@ func (s *UnitTestSuite) Test_UserLeaveRoom() {
 	defer resetMock()
 
 	s.env.OnActivity(
-		activities.FetchTracksInformationActivity,
+		activities.FetchTracksInformationWithPolicyActivity,
 		mock.Anything,
 		mock.Anything,
-	).Return(tracks, nil).Once()
+	).Return(activities.FetchedTracksInformationWithInitiator{Metadata: tracks}, nil).Once()
 	s.env.OnActivity(
 		a.CreationAcknowledgementActivity,
 		mock.Anything,
@@ -1072,10 +1418,10 @@ func (s *UnitTestSuite) Test_PlayActivityIsNotCalledWhenTryingToPlayTheLastTrack
 	defer resetMock()
 
 	s.env.OnActivity(
-		activities.FetchTracksInformationActivity,
+		activities.FetchTracksInformationWithPolicyActivity,
 		mock.Anything,
-		tracksIDs,
-	).Return(tracks, nil).Once()
+		fetchTracksInformationArgs(tracksIDs),
+	).Return(activities.FetchedTracksInformationWithInitiator{Metadata: tracks}, nil).Once()
 	s.env.OnActivity(
 		a.CreationAcknowledgementActivity,
 		mock.Anything,
@@ -1209,17 +1555,15 @@ func (s *UnitTestSuite) Test_CanSuggestTracks() {
 
 	// Mock first tracks information fetching
 	s.env.OnActivity(
-		activities.FetchTracksInformationActivity,
+		activities.FetchTracksInformationWithPolicyActivity,
 		mock.Anything,
-		tracksIDs,
-	).Return(tracks, nil).Once()
+		fetchTracksInformationArgs(tracksIDs),
+	).Return(activities.FetchedTracksInformationWithInitiator{Metadata: tracks}, nil).Once()
 	// Mock suggested and accepted tracks information fetching
 	s.env.OnActivity(
-		activities.FetchTracksInformationActivityAndForwardInitiator,
+		activities.FetchTracksInformationWithPolicyActivity,
 		mock.Anything,
-		tracksIDsToSuggest,
-		suggesterUserID,
-		suggesterDeviceID,
+		fetchTracksInformationArgsForInitiator(tracksIDsToSuggest, suggesterUserID, suggesterDeviceID),
 	).Return(activities.FetchedTracksInformationWithInitiator{
 		Metadata: tracksToSuggestMetadata,
 		UserID:   suggesterUserID,
@@ -1584,31 +1928,27 @@ func (s *UnitTestSuite) Test_TracksSuggestedBeforePreviousSuggestedTracksInforma
 
 	// Mock first tracks information fetching
 	s.env.OnActivity(
-		activities.FetchTracksInformationActivity,
+		activities.FetchTracksInformationWithPolicyActivity,
 		mock.Anything,
-		tracksIDs,
-	).Return(tracks, nil).Once()
+		fetchTracksInformationArgs(tracksIDs),
+	).Return(activities.FetchedTracksInformationWithInitiator{Metadata: tracks}, nil).Once()
 
 	// Mock suggested and accepted tracks information fetching
 	// Make the first mock of the activity return a long time after the next one
 	// to simulate a race condition.
 	s.env.OnActivity(
-		activities.FetchTracksInformationActivityAndForwardInitiator,
+		activities.FetchTracksInformationWithPolicyActivity,
 		mock.Anything,
-		firstTracksIDsToSuggest,
-		suggesterUserID,
-		suggesterDeviceID,
+		fetchTracksInformationArgsForInitiator(firstTracksIDsToSuggest, suggesterUserID, suggesterDeviceID),
 	).Return(activities.FetchedTracksInformationWithInitiator{
 		Metadata: firstTracksToSuggestMetadata,
 		UserID:   suggesterUserID,
 		DeviceID: suggesterDeviceID,
 	}, nil).Once().After(10 * time.Second)
 	s.env.OnActivity(
-		activities.FetchTracksInformationActivityAndForwardInitiator,
+		activities.FetchTracksInformationWithPolicyActivity,
 		mock.Anything,
-		secondTracksIDsToSuggest,
-		suggesterUserID,
-		suggesterDeviceID,
+		fetchTracksInformationArgsForInitiator(secondTracksIDsToSuggest, suggesterUserID, suggesterDeviceID),
 	).Return(activities.FetchedTracksInformationWithInitiator{
 		Metadata: secondTracksToSuggestMetadata,
 		UserID:   suggesterUserID,
@@ -1741,10 +2081,10 @@ func (s *UnitTestSuite) Test_VoteForTrack() {
 	defer resetMock()
 
 	s.env.OnActivity(
-		activities.FetchTracksInformationActivity,
+		activities.FetchTracksInformationWithPolicyActivity,
 		mock.Anything,
-		tracksIDs,
-	).Return(tracks, nil).Once()
+		fetchTracksInformationArgs(tracksIDs),
+	).Return(activities.FetchedTracksInformationWithInitiator{Metadata: tracks}, nil).Once()
 	s.env.OnActivity(
 		a.CreationAcknowledgementActivity,
 		mock.Anything,
@@ -2111,7 +2451,18 @@ func (s *UnitTestSuite) Test_VoteForTrack() {
 	s.ErrorIs(err, workflow.ErrDeadlineExceeded, "The workflow ran on an infinite loop")
 }
 
-func (s *UnitTestSuite) Test_EmptyCurrentTrackAutoPlayAfterOneGetReadyToBePlayed() {
+// Test_UnvoteForTrack scenario:
+//
+// 1. A user joins the room and votes for the second track,
+// which becomes the first one of the list.
+//
+// 2. The user unvotes for the track, its score is decremented
+// and the track is removed from the user's voted tracks.
+//
+// 3. Unvoting for a track the user has not voted for is ignored.
+//
+// 4. The list update is sent only once through the vote update debounce.
+func (s *UnitTestSuite) Test_UnvoteForTrack() {
 	var (
 		a *activities_mtv.Activities
 
@@ -2132,16 +2483,9 @@ func (s *UnitTestSuite) Test_EmptyCurrentTrackAutoPlayAfterOneGetReadyToBePlayed
 			ArtistName: faker.Name(),
 			Duration:   random.GenerateRandomDuration(),
 		},
-		{
-			ID:         faker.UUIDHyphenated(),
-			Title:      faker.Word(),
-			ArtistName: faker.Name(),
-			Duration:   random.GenerateRandomDuration(),
-		},
 	}
-
-	tracksIDs := []string{tracks[0].ID, tracks[1].ID, tracks[2].ID}
-	params, creatorDeviceID := getWorkflowInitParams(tracksIDs, 2)
+	tracksIDs := []string{tracks[0].ID, tracks[1].ID}
+	params, _ := getWorkflowInitParams(tracksIDs, 10)
 
 	defaultDuration := 1 * time.Millisecond
 	resetMock, registerDelayedCallbackWrapper := s.initTestEnv()
@@ -2149,119 +2493,101 @@ func (s *UnitTestSuite) Test_EmptyCurrentTrackAutoPlayAfterOneGetReadyToBePlayed
 	defer resetMock()
 
 	s.env.OnActivity(
-		activities.FetchTracksInformationActivity,
+		activities.FetchTracksInformationWithPolicyActivity,
 		mock.Anything,
-		tracksIDs,
-	).Return(tracks, nil).Once()
+		fetchTracksInformationArgs(tracksIDs),
+	).Return(activities.FetchedTracksInformationWithInitiator{Metadata: tracks}, nil).Once()
 	s.env.OnActivity(
 		a.CreationAcknowledgementActivity,
 		mock.Anything,
 		mock.Anything,
 	).Return(nil).Once()
+	s.env.OnActivity(
+		a.JoinActivity,
+		mock.Anything,
+		mock.Anything,
+	).Return(nil).Once()
+	s.env.OnActivity(
+		a.UserVoteForTrackAcknowledgement,
+		mock.Anything,
+		mock.Anything,
+	).Return(nil).Once()
+	s.env.OnActivity(
+		a.UserUnvoteForTrackAcknowledgement,
+		mock.Anything,
+		mock.Anything,
+	).Return(nil).Once()
+	s.env.OnActivity(
+		a.NotifySuggestOrVoteUpdateActivity,
+		mock.Anything,
+		mock.Anything,
+	).Return(nil).Once()
 
-	init := defaultDuration
+	joinRoom := defaultDuration
 	registerDelayedCallbackWrapper(func() {
-		mtvState := s.getMtvState(params.RoomCreatorUserID)
-
-		expectedCreator := &shared_mtv.InternalStateUser{
-			UserID:                            params.RoomCreatorUserID,
-			DeviceID:                          creatorDeviceID,
-			TracksVotedFor:                    tracksIDs,
-			HasControlAndDelegationPermission: true,
-		}
-
-		s.False(mtvState.Playing)
-		s.Equal(2, mtvState.MinimumScoreToBePlayed)
-		s.Equal(expectedCreator, mtvState.UserRelatedInformation)
-	}, init)
+		s.emitJoinSignal(shared_mtv.NewJoinSignalArgs{
+			UserID:             joiningUserID,
+			DeviceID:           joiningDeviceID,
+			UserHasBeenInvited: false,
+		})
+	}, joinRoom)
 
-	emitGoToNextTrack := defaultDuration
+	voteForSecondTrack := defaultDuration
 	registerDelayedCallbackWrapper(func() {
-		s.emitGoToNextTrackSignal(shared_mtv.NewGoToNextTrackSignalArgs{
-			UserID: params.RoomCreatorUserID,
+		s.emitVoteSignal(shared_mtv.NewVoteForTrackSignalArgs{
+			UserID:  joiningUserID,
+			TrackID: tracks[1].ID,
 		})
-	}, emitGoToNextTrack)
+	}, voteForSecondTrack)
 
-	checkNothingHappened := defaultDuration
+	checkVoteWorked := defaultDuration
 	registerDelayedCallbackWrapper(func() {
-		mtvState := s.getMtvState(params.RoomCreatorUserID)
-		expectedCreator := &shared_mtv.InternalStateUser{
-			UserID:                            params.RoomCreatorUserID,
-			HasControlAndDelegationPermission: true,
-			DeviceID:                          creatorDeviceID,
-			TracksVotedFor:                    tracksIDs,
-		}
-
-		s.False(mtvState.Playing)
-		s.Equal(2, mtvState.MinimumScoreToBePlayed)
-		s.Equal(expectedCreator, mtvState.UserRelatedInformation)
-	}, checkNothingHappened)
+		mtvState := s.getMtvState(joiningUserID)
 
-	emitJoinSignal := defaultDuration
-	registerDelayedCallbackWrapper(func() {
-		args := shared_mtv.NewJoinSignalArgs{
-			DeviceID:           joiningDeviceID,
-			UserID:             joiningUserID,
-			UserHasBeenInvited: false,
-		}
-		s.emitJoinSignal(args)
-	}, emitJoinSignal)
+		s.Equal([]string{tracks[1].ID}, mtvState.UserRelatedInformation.TracksVotedFor)
+		s.Equal(tracks[1].ID, mtvState.Tracks[0].ID)
+		s.Equal(2, mtvState.Tracks[0].Score)
+	}, checkVoteWorked)
 
-	joiningUserVoteForTrack := defaultDuration
+	unvoteForSecondTrack := defaultDuration
 	registerDelayedCallbackWrapper(func() {
-		s.emitVoteSignal(shared_mtv.NewVoteForTrackSignalArgs{
-			TrackID: tracksIDs[2],
+		s.emitUnvoteSignal(shared_mtv.NewUnvoteForTrackSignalArgs{
 			UserID:  joiningUserID,
+			TrackID: tracks[1].ID,
 		})
-	}, joiningUserVoteForTrack)
+	}, unvoteForSecondTrack)
 
-	//Here we test the auto play after a track from not ready to be played
-	//one finally get the required score amount
-	checkJoinAndVoteWorkedAlsoRoomIsPlaying := defaultDuration
+	checkUnvoteWorked := defaultDuration
 	registerDelayedCallbackWrapper(func() {
 		mtvState := s.getMtvState(joiningUserID)
 
-		expectedJoiningUserRelatedInformation := &shared_mtv.InternalStateUser{
-			UserID:                            joiningUserID,
-			DeviceID:                          joiningDeviceID,
-			TracksVotedFor:                    []string{},
-			HasControlAndDelegationPermission: false,
+		s.Empty(mtvState.UserRelatedInformation.TracksVotedFor)
+		s.Len(mtvState.Tracks, 2)
+		for _, track := range mtvState.Tracks {
+			s.Equal(1, track.Score)
 		}
+	}, checkUnvoteWorked)
 
-		expectedTracks := []shared_mtv.TrackMetadataWithScoreWithDuration{
+	unvoteForNotVotedTrack := defaultDuration
+	registerDelayedCallbackWrapper(func() {
+		s.emitUnvoteSignal(shared_mtv.NewUnvoteForTrackSignalArgs{
+			UserID:  joiningUserID,
+			TrackID: tracks[0].ID,
+		})
+	}, unvoteForNotVotedTrack)
 
-			{
-				TrackMetadataWithScore: shared_mtv.TrackMetadataWithScore{
-					TrackMetadata: shared.TrackMetadata{
-						ID:         tracks[0].ID,
-						Title:      tracks[0].Title,
-						ArtistName: tracks[0].ArtistName,
-						Duration:   0,
-					},
-					Score: 1,
-				},
-				Duration: tracks[0].Duration.Milliseconds(),
-			},
-			{
-				TrackMetadataWithScore: shared_mtv.TrackMetadataWithScore{
-					TrackMetadata: shared.TrackMetadata{
-						ID:         tracks[1].ID,
-						Title:      tracks[1].Title,
-						ArtistName: tracks[1].ArtistName,
-						Duration:   0,
-					},
-					Score: 1,
-				},
-				Duration: tracks[1].Duration.Milliseconds(),
-			},
+	checkUnvoteWasIgnored := defaultDuration
+	registerDelayedCallbackWrapper(func() {
+		mtvState := s.getMtvState(joiningUserID)
+
+		s.Empty(mtvState.UserRelatedInformation.TracksVotedFor)
+		for _, track := range mtvState.Tracks {
+			s.Equal(1, track.Score)
 		}
+	}, checkUnvoteWasIgnored)
 
-		s.Equal(tracks[2].ID, mtvState.CurrentTrack.ID)
-		s.Equal(2, mtvState.UsersLength)
-		s.True(mtvState.Playing)
-		s.Equal(expectedJoiningUserRelatedInformation, mtvState.UserRelatedInformation)
-		s.Equal(expectedTracks, mtvState.Tracks)
-	}, checkJoinAndVoteWorkedAlsoRoomIsPlaying)
+	waitForVoteUpdateDebounce := shared_mtv.CheckForVoteUpdateIntervalDuration * 3
+	registerDelayedCallbackWrapper(func() {}, waitForVoteUpdateDebounce)
 
 	s.env.ExecuteWorkflow(MtvRoomWorkflow, params)
 
@@ -2270,12 +2596,25 @@ func (s *UnitTestSuite) Test_EmptyCurrentTrackAutoPlayAfterOneGetReadyToBePlayed
 	s.ErrorIs(err, workflow.ErrDeadlineExceeded, "The workflow ran on an infinite loop")
 }
 
-func (s *UnitTestSuite) Test_LoadedCurrentTrackAndReadyToBePlayedListNoAutoPlayAfterOneGetReadyToBePlayed() {
+// Test_DownvotedTrackIsRemovedBelowMinimumScoreToStayInQueue scenario:
+//
+// 1. Two users join a room with downvotes enabled.
+//
+// 2. The creator tries to downvote a track he has already voted for,
+// nothing happens.
+//
+// 3. The first user downvotes the second track, its net score reaches
+// the minimum score to stay in queue, the track stays in the list.
+//
+// 4. The second user downvotes the same track, its net score goes below
+// the minimum score to stay in queue, the track is removed from the list
+// and Adonis is notified.
+func (s *UnitTestSuite) Test_DownvotedTrackIsRemovedBelowMinimumScoreToStayInQueue() {
 	var (
 		a *activities_mtv.Activities
 
-		joiningUserID   = faker.UUIDHyphenated()
-		joiningDeviceID = faker.UUIDHyphenated()
+		firstUserID  = faker.UUIDHyphenated()
+		secondUserID = faker.UUIDHyphenated()
 	)
 
 	tracks := []shared.TrackMetadata{
@@ -2291,16 +2630,11 @@ func (s *UnitTestSuite) Test_LoadedCurrentTrackAndReadyToBePlayedListNoAutoPlayA
 			ArtistName: faker.Name(),
 			Duration:   random.GenerateRandomDuration(),
 		},
-		{
-			ID:         faker.UUIDHyphenated(),
-			Title:      faker.Word(),
-			ArtistName: faker.Name(),
-			Duration:   random.GenerateRandomDuration(),
-		},
 	}
-
-	tracksIDs := []string{tracks[0].ID, tracks[1].ID, tracks[2].ID}
-	params, creatorDeviceID := getWorkflowInitParams(tracksIDs, 1)
+	tracksIDs := []string{tracks[0].ID, tracks[1].ID}
+	params, _ := getWorkflowInitParams(tracksIDs, 10)
+	params.HasDownvotes = true
+	params.MinimumScoreToStayInQueue = 0
 
 	defaultDuration := 1 * time.Millisecond
 	resetMock, registerDelayedCallbackWrapper := s.initTestEnv()
@@ -2308,100 +2642,105 @@ func (s *UnitTestSuite) Test_LoadedCurrentTrackAndReadyToBePlayedListNoAutoPlayA
 	defer resetMock()
 
 	s.env.OnActivity(
-		activities.FetchTracksInformationActivity,
+		activities.FetchTracksInformationWithPolicyActivity,
 		mock.Anything,
-		tracksIDs,
-	).Return(tracks, nil).Once()
+		fetchTracksInformationArgs(tracksIDs),
+	).Return(activities.FetchedTracksInformationWithInitiator{Metadata: tracks}, nil).Once()
 	s.env.OnActivity(
 		a.CreationAcknowledgementActivity,
 		mock.Anything,
 		mock.Anything,
 	).Return(nil).Once()
+	s.env.OnActivity(
+		a.JoinActivity,
+		mock.Anything,
+		mock.Anything,
+	).Return(nil).Times(2)
+	s.env.OnActivity(
+		a.UserLengthUpdateActivity,
+		mock.Anything,
+		mock.Anything,
+	).Return(nil).Times(2)
+	s.env.OnActivity(
+		a.UserVoteForTrackAcknowledgement,
+		mock.Anything,
+		mock.Anything,
+	).Return(nil).Times(2)
+	s.env.OnActivity(
+		a.AcknowledgeDownvotedTrackRemoval,
+		mock.Anything,
+		mock.MatchedBy(func(args activities_mtv.AcknowledgeDownvotedTrackRemovalArgs) bool {
+			return args.TrackID == tracks[1].ID && len(args.State.Tracks) == 1
+		}),
+	).Return(nil).Once()
+	s.env.OnActivity(
+		a.NotifySuggestOrVoteUpdateActivity,
+		mock.Anything,
+		mock.Anything,
+	).Return(nil).Once()
 
-	init := defaultDuration
+	usersJoin := defaultDuration
 	registerDelayedCallbackWrapper(func() {
-		mtvState := s.getMtvState(params.RoomCreatorUserID)
-
-		expectedCreator := &shared_mtv.InternalStateUser{
-			UserID:   params.RoomCreatorUserID,
-			DeviceID: creatorDeviceID,
-			TracksVotedFor: []string{
-				//reversed because we remove tracksVotedFor element using unordered method
-				tracks[2].ID,
-				tracks[1].ID,
-			},
-			HasControlAndDelegationPermission: true,
+		for _, userID := range []string{firstUserID, secondUserID} {
+			s.emitJoinSignal(shared_mtv.NewJoinSignalArgs{
+				UserID:             userID,
+				DeviceID:           faker.UUIDHyphenated(),
+				UserHasBeenInvited: false,
+			})
 		}
+	}, usersJoin)
 
-		s.False(mtvState.Playing)
-		s.Equal(1, mtvState.MinimumScoreToBePlayed)
-		s.Equal(expectedCreator, mtvState.UserRelatedInformation)
-	}, init)
+	creatorDownvotes := defaultDuration
+	registerDelayedCallbackWrapper(func() {
+		s.emitDownvoteSignal(shared_mtv.NewDownvoteForTrackSignalArgs{
+			UserID:  params.RoomCreatorUserID,
+			TrackID: tracks[1].ID,
+		})
+	}, creatorDownvotes)
 
-	emitJoinSignal := defaultDuration
+	firstUserDownvotes := defaultDuration
 	registerDelayedCallbackWrapper(func() {
-		args := shared_mtv.NewJoinSignalArgs{
-			DeviceID:           joiningDeviceID,
-			UserID:             joiningUserID,
-			UserHasBeenInvited: false,
-		}
-		s.emitJoinSignal(args)
-	}, emitJoinSignal)
+		s.emitDownvoteSignal(shared_mtv.NewDownvoteForTrackSignalArgs{
+			UserID:  firstUserID,
+			TrackID: tracks[1].ID,
+		})
+	}, firstUserDownvotes)
 
-	joiningUserVoteForTrack := defaultDuration
+	checkTrackIsStillInQueue := defaultDuration
 	registerDelayedCallbackWrapper(func() {
-		s.emitVoteSignal(shared_mtv.NewVoteForTrackSignalArgs{
-			TrackID: tracksIDs[2],
-			UserID:  joiningUserID,
+		mtvState := s.getMtvState(firstUserID)
+
+		s.True(mtvState.HasDownvotes)
+		s.Equal([]string{tracks[1].ID}, mtvState.UserRelatedInformation.TracksDownvotedFor)
+		s.Len(mtvState.Tracks, 2)
+		s.Equal(tracks[0].ID, mtvState.Tracks[0].ID)
+		s.Equal(tracks[1].ID, mtvState.Tracks[1].ID)
+		s.Equal(0, mtvState.Tracks[1].Score)
+		s.Equal(1, mtvState.Tracks[1].Downvotes)
+	}, checkTrackIsStillInQueue)
+
+	secondUserDownvotes := defaultDuration
+	registerDelayedCallbackWrapper(func() {
+		s.emitDownvoteSignal(shared_mtv.NewDownvoteForTrackSignalArgs{
+			UserID:  secondUserID,
+			TrackID: tracks[1].ID,
 		})
-	}, joiningUserVoteForTrack)
+	}, secondUserDownvotes)
 
-	checkJoinAndVoteWorkedAlsoRoomIsNotPlaying := defaultDuration
+	checkTrackHasBeenRemoved := defaultDuration
 	registerDelayedCallbackWrapper(func() {
-		mtvState := s.getMtvState(joiningUserID)
+		mtvState := s.getMtvState(params.RoomCreatorUserID)
 
-		expectedJoiningUserRelatedInformation := &shared_mtv.InternalStateUser{
-			UserID:   joiningUserID,
-			DeviceID: joiningDeviceID,
-			TracksVotedFor: []string{
-				tracksIDs[2],
-			},
-			HasControlAndDelegationPermission: false,
-		}
+		s.Len(mtvState.Tracks, 1)
+		s.Equal(tracks[0].ID, mtvState.Tracks[0].ID)
+		s.Equal([]string{tracks[0].ID}, mtvState.UserRelatedInformation.TracksVotedFor)
 
-		expectedTracks := []shared_mtv.TrackMetadataWithScoreWithDuration{
-			{
-				TrackMetadataWithScore: shared_mtv.TrackMetadataWithScore{
-					TrackMetadata: shared.TrackMetadata{
-						ID:         tracks[2].ID,
-						Title:      tracks[2].Title,
-						ArtistName: tracks[2].ArtistName,
-						Duration:   0,
-					},
-					Score: 2,
-				},
-				Duration: tracks[2].Duration.Milliseconds(),
-			},
-			{
-				TrackMetadataWithScore: shared_mtv.TrackMetadataWithScore{
-					TrackMetadata: shared.TrackMetadata{
-						ID:         tracks[1].ID,
-						Title:      tracks[1].Title,
-						ArtistName: tracks[1].ArtistName,
-						Duration:   0,
-					},
-					Score: 1,
-				},
-				Duration: tracks[1].Duration.Milliseconds(),
-			},
-		}
+		mtvState = s.getMtvState(firstUserID)
+		s.Empty(mtvState.UserRelatedInformation.TracksDownvotedFor)
+	}, checkTrackHasBeenRemoved)
 
-		s.Equal(tracks[0].ID, mtvState.CurrentTrack.ID)
-		s.Equal(2, mtvState.UsersLength)
-		s.False(mtvState.Playing)
-		s.Equal(expectedJoiningUserRelatedInformation, mtvState.UserRelatedInformation)
-		s.Equal(expectedTracks, mtvState.Tracks)
-	}, checkJoinAndVoteWorkedAlsoRoomIsNotPlaying)
+	waitForVoteUpdateDebounce := shared_mtv.CheckForVoteUpdateIntervalDuration * 3
+	registerDelayedCallbackWrapper(func() {}, waitForVoteUpdateDebounce)
 
 	s.env.ExecuteWorkflow(MtvRoomWorkflow, params)
 
@@ -2410,12 +2749,12 @@ func (s *UnitTestSuite) Test_LoadedCurrentTrackAndReadyToBePlayedListNoAutoPlayA
 	s.ErrorIs(err, workflow.ErrDeadlineExceeded, "The workflow ran on an infinite loop")
 }
 
-func (s *UnitTestSuite) Test_LoadedAndEndedCurrentTrackAndNoTrackReadyToBePlayedAutoPlayAfterOneGetReadyToBePlayed() {
+func (s *UnitTestSuite) Test_VoteToSkipCurrentTrack() {
 	var (
 		a *activities_mtv.Activities
 
-		joiningUserID   = faker.UUIDHyphenated()
-		joiningDeviceID = faker.UUIDHyphenated()
+		firstJoiningUserID  = faker.UUIDHyphenated()
+		secondJoiningUserID = faker.UUIDHyphenated()
 	)
 
 	tracks := []shared.TrackMetadata{
@@ -2425,10 +2764,15 @@ func (s *UnitTestSuite) Test_LoadedAndEndedCurrentTrackAndNoTrackReadyToBePlayed
 			ArtistName: faker.Name(),
 			Duration:   random.GenerateRandomDuration(),
 		},
+		{
+			ID:         faker.UUIDHyphenated(),
+			Title:      faker.Word(),
+			ArtistName: faker.Name(),
+			Duration:   random.GenerateRandomDuration(),
+		},
 	}
-
-	tracksIDs := []string{tracks[0].ID}
-	params, creatorDeviceID := getWorkflowInitParams(tracksIDs, 2)
+	tracksIDs := []string{tracks[0].ID, tracks[1].ID}
+	params, _ := getWorkflowInitParams(tracksIDs, 1)
 
 	defaultDuration := 1 * time.Millisecond
 	resetMock, registerDelayedCallbackWrapper := s.initTestEnv()
@@ -2436,186 +2780,109 @@ func (s *UnitTestSuite) Test_LoadedAndEndedCurrentTrackAndNoTrackReadyToBePlayed
 	defer resetMock()
 
 	s.env.OnActivity(
-		activities.FetchTracksInformationActivity,
+		activities.FetchTracksInformationWithPolicyActivity,
 		mock.Anything,
-		tracksIDs,
-	).Return(tracks, nil).Once()
+		fetchTracksInformationArgs(tracksIDs),
+	).Return(activities.FetchedTracksInformationWithInitiator{Metadata: tracks}, nil).Once()
 	s.env.OnActivity(
 		a.CreationAcknowledgementActivity,
 		mock.Anything,
 		mock.Anything,
 	).Return(nil).Once()
+	s.env.OnActivity(
+		a.JoinActivity,
+		mock.Anything,
+		mock.Anything,
+	).Return(nil).Times(2)
+	s.env.OnActivity(
+		a.PlayActivity,
+		mock.Anything,
+		mock.Anything,
+	).Return(nil).Times(2)
+	s.env.OnActivity(
+		a.NotifySkipVotesUpdateActivity,
+		mock.Anything,
+		mock.Anything,
+	).Return(nil).Once()
 
-	init := defaultDuration
+	joinRoom := defaultDuration
 	registerDelayedCallbackWrapper(func() {
-		mtvState := s.getMtvState(params.RoomCreatorUserID)
-
-		expectedCreator := &shared_mtv.InternalStateUser{
-			UserID:   params.RoomCreatorUserID,
-			DeviceID: creatorDeviceID,
-			TracksVotedFor: []string{
-				tracks[0].ID,
-			},
-			HasControlAndDelegationPermission: true,
-		}
-
-		s.False(mtvState.Playing)
-		s.Equal(2, mtvState.MinimumScoreToBePlayed)
-		s.Equal(expectedCreator, mtvState.UserRelatedInformation)
-	}, init)
-
-	emitJoinSignal := defaultDuration
-	registerDelayedCallbackWrapper(func() {
-		args := shared_mtv.NewJoinSignalArgs{
-			DeviceID:           joiningDeviceID,
-			UserID:             joiningUserID,
+		s.emitJoinSignal(shared_mtv.NewJoinSignalArgs{
+			UserID:             firstJoiningUserID,
+			DeviceID:           faker.UUIDHyphenated(),
 			UserHasBeenInvited: false,
-		}
-		s.emitJoinSignal(args)
-	}, emitJoinSignal)
+		})
+		s.emitJoinSignal(shared_mtv.NewJoinSignalArgs{
+			UserID:             secondJoiningUserID,
+			DeviceID:           faker.UUIDHyphenated(),
+			UserHasBeenInvited: false,
+		})
+	}, joinRoom)
 
-	joiningUserVoteForTrack := defaultDuration
+	// Skip votes are ignored while the room is paused.
+	voteToSkipWhilePaused := defaultDuration
 	registerDelayedCallbackWrapper(func() {
-		s.emitVoteSignal(shared_mtv.NewVoteForTrackSignalArgs{
-			TrackID: tracksIDs[0],
-			UserID:  joiningUserID,
+		s.emitVoteToSkipCurrentTrackSignal(shared_mtv.NewVoteToSkipCurrentTrackSignalArgs{
+			UserID: firstJoiningUserID,
 		})
-	}, joiningUserVoteForTrack)
+	}, voteToSkipWhilePaused)
 
-	checkJoinAndVoteWorkedAlsoRoomIsPlaying := defaultDuration
+	checkSkipVoteWhilePausedWasIgnored := defaultDuration
 	registerDelayedCallbackWrapper(func() {
-		mtvState := s.getMtvState(joiningUserID)
-
-		expectedJoiningUserRelatedInformation := &shared_mtv.InternalStateUser{
-			UserID:                            joiningUserID,
-			DeviceID:                          joiningDeviceID,
-			TracksVotedFor:                    []string{},
-			HasControlAndDelegationPermission: false,
-		}
-
-		expectedTracks := []shared_mtv.TrackMetadataWithScoreWithDuration{}
+		mtvState := s.getMtvState(shared_mtv.NoRelatedUserID)
 
-		s.Equal(tracks[0].ID, mtvState.CurrentTrack.ID)
-		s.Equal(2, mtvState.UsersLength)
-		s.True(mtvState.Playing)
-		s.Equal(expectedJoiningUserRelatedInformation, mtvState.UserRelatedInformation)
-		s.Equal(expectedTracks, mtvState.Tracks)
-	}, checkJoinAndVoteWorkedAlsoRoomIsPlaying)
+		s.Equal(0, mtvState.CurrentTrackSkipVotes)
+		s.Equal(2, mtvState.SkipVotesRequired)
+	}, checkSkipVoteWhilePausedWasIgnored)
 
-	justWaitEndOfCurrentTrack := tracks[0].Duration
+	play := defaultDuration
 	registerDelayedCallbackWrapper(func() {
-	}, justWaitEndOfCurrentTrack)
+		s.emitPlaySignal(shared_mtv.NewPlaySignalArgs{
+			UserID: params.RoomCreatorUserID,
+		})
+	}, play)
 
-	checkCurrentTrack := defaultDuration * 10
+	firstVoteToSkip := defaultDuration
 	registerDelayedCallbackWrapper(func() {
-		mtvState := s.getMtvState(params.RoomCreatorUserID)
-
-		expectedExposedCurrentTrack := &shared_mtv.ExposedCurrentTrack{
-			CurrentTrack: shared_mtv.CurrentTrack{
-				TrackMetadataWithScore: shared_mtv.TrackMetadataWithScore{
-					TrackMetadata: shared.TrackMetadata{
-						ID:         tracks[0].ID,
-						ArtistName: tracks[0].ArtistName,
-						Title:      tracks[0].Title,
-						Duration:   0,
-					},
-
-					Score: 2,
-				},
-				AlreadyElapsed: 0,
-			},
-			Duration: tracks[0].Duration.Milliseconds(),
-			Elapsed:  tracks[0].Duration.Milliseconds(),
-		}
-
-		s.False(mtvState.Playing)
-		s.Equal(mtvState.CurrentTrack, expectedExposedCurrentTrack)
-	}, checkCurrentTrack)
-
-	trackToSuggest := shared.TrackMetadata{
-		ID:         faker.UUIDHyphenated(),
-		Title:      faker.Word(),
-		ArtistName: faker.Name(),
-		Duration:   random.GenerateRandomDuration(),
-	}
+		s.emitVoteToSkipCurrentTrackSignal(shared_mtv.NewVoteToSkipCurrentTrackSignalArgs{
+			UserID: firstJoiningUserID,
+		})
+	}, firstVoteToSkip)
 
-	creatorSuggestSong := defaultDuration
+	// A user can only vote once per current track.
+	duplicatedVoteToSkip := defaultDuration
 	registerDelayedCallbackWrapper(func() {
-		s.emitSuggestTrackSignal(shared_mtv.SuggestTracksSignalArgs{
-			DeviceID:        creatorDeviceID,
-			UserID:          params.RoomCreatorUserID,
-			TracksToSuggest: []string{trackToSuggest.ID},
+		s.emitVoteToSkipCurrentTrackSignal(shared_mtv.NewVoteToSkipCurrentTrackSignalArgs{
+			UserID: firstJoiningUserID,
 		})
-	}, creatorSuggestSong)
+	}, duplicatedVoteToSkip)
 
-	s.mockOnceSuggest(params.RoomCreatorUserID, creatorDeviceID, params.RoomID, []shared.TrackMetadata{trackToSuggest})
-	checkSuggestWorked := defaultDuration
+	checkFirstVoteToSkipWasCounted := defaultDuration
 	registerDelayedCallbackWrapper(func() {
 		mtvState := s.getMtvState(shared_mtv.NoRelatedUserID)
 
-		expectedTracks := []shared_mtv.TrackMetadataWithScoreWithDuration{
-			{
-				TrackMetadataWithScore: shared_mtv.TrackMetadataWithScore{
-					TrackMetadata: shared.TrackMetadata{
-						ID:         trackToSuggest.ID,
-						Title:      trackToSuggest.Title,
-						ArtistName: trackToSuggest.ArtistName,
-						Duration:   0,
-					},
-					Score: 1,
-				},
-				Duration: trackToSuggest.Duration.Milliseconds(),
-			},
-		}
-
-		s.False(mtvState.Playing)
-		s.Equal(expectedTracks, mtvState.Tracks)
-	}, checkSuggestWorked)
+		s.True(mtvState.Playing)
+		s.Equal(tracks[0].ID, mtvState.CurrentTrack.ID)
+		s.Equal(1, mtvState.CurrentTrackSkipVotes)
+		s.Equal(2, mtvState.SkipVotesRequired)
+	}, checkFirstVoteToSkipWasCounted)
 
-	joiningUserVoteForSuggestedTrack := defaultDuration
+	secondVoteToSkip := defaultDuration
 	registerDelayedCallbackWrapper(func() {
-		s.emitVoteSignal(shared_mtv.NewVoteForTrackSignalArgs{
-			TrackID: trackToSuggest.ID,
-			UserID:  joiningUserID,
+		s.emitVoteToSkipCurrentTrackSignal(shared_mtv.NewVoteToSkipCurrentTrackSignalArgs{
+			UserID: secondJoiningUserID,
 		})
-	}, joiningUserVoteForSuggestedTrack)
+	}, secondVoteToSkip)
 
-	checkThatRoomAutoPlayed := defaultDuration
+	checkCurrentTrackHasBeenSkipped := defaultDuration
 	registerDelayedCallbackWrapper(func() {
-		mtvState := s.getMtvState(joiningUserID)
-
-		expectedJoiningUser := &shared_mtv.InternalStateUser{
-			UserID:                            joiningUserID,
-			DeviceID:                          joiningDeviceID,
-			TracksVotedFor:                    []string{},
-			HasControlAndDelegationPermission: false,
-		}
-
-		expectedExposedCurrentTrack := &shared_mtv.ExposedCurrentTrack{
-			CurrentTrack: shared_mtv.CurrentTrack{
-				TrackMetadataWithScore: shared_mtv.TrackMetadataWithScore{
-					TrackMetadata: shared.TrackMetadata{
-						ID:         trackToSuggest.ID,
-						ArtistName: trackToSuggest.ArtistName,
-						Title:      trackToSuggest.Title,
-						Duration:   0,
-					},
-
-					Score: 2,
-				},
-				AlreadyElapsed: 0,
-			},
-			Duration: trackToSuggest.Duration.Milliseconds(),
-			Elapsed:  1,
-		}
-
-		expectedTracks := []shared_mtv.TrackMetadataWithScoreWithDuration{}
+		mtvState := s.getMtvState(shared_mtv.NoRelatedUserID)
 
 		s.True(mtvState.Playing)
-		s.Equal(expectedJoiningUser, mtvState.UserRelatedInformation)
-		s.Equal(mtvState.CurrentTrack, expectedExposedCurrentTrack)
-		s.Equal(mtvState.Tracks, expectedTracks)
-	}, checkThatRoomAutoPlayed)
+		s.Equal(tracks[1].ID, mtvState.CurrentTrack.ID)
+		s.Equal(0, mtvState.CurrentTrackSkipVotes)
+		s.Empty(mtvState.Tracks)
+	}, checkCurrentTrackHasBeenSkipped)
 
 	s.env.ExecuteWorkflow(MtvRoomWorkflow, params)
 
@@ -2624,11 +2891,14 @@ func (s *UnitTestSuite) Test_LoadedAndEndedCurrentTrackAndNoTrackReadyToBePlayed
 	s.ErrorIs(err, workflow.ErrDeadlineExceeded, "The workflow ran on an infinite loop")
 }
 
-func (s *UnitTestSuite) Test_CreateRoomWithPositionAndTimeConstraintAndTestPositionConstraint() {
-	var a *activities_mtv.Activities
+func (s *UnitTestSuite) Test_SkipVotesAreCheckedAgainAfterUserLeaves() {
+	var (
+		a *activities_mtv.Activities
+
+		firstJoiningUserID  = faker.UUIDHyphenated()
+		secondJoiningUserID = faker.UUIDHyphenated()
+	)
 
-	falseValue := false
-	trueValue := true
 	tracks := []shared.TrackMetadata{
 		{
 			ID:         faker.UUIDHyphenated(),
@@ -2643,143 +2913,101 @@ func (s *UnitTestSuite) Test_CreateRoomWithPositionAndTimeConstraintAndTestPosit
 			Duration:   random.GenerateRandomDuration(),
 		},
 	}
-
 	tracksIDs := []string{tracks[0].ID, tracks[1].ID}
-	params, creatorDeviceID := getWorkflowInitParams(tracksIDs, 1)
-	defaultDuration := 1 * time.Millisecond
-
-	start := time.Now()
-
-	end := start.Add(defaultDuration * 5000)
-
-	physicalAndTimeConstraints := shared_mtv.MtvRoomPhysicalAndTimeConstraints{
-		PhysicalConstraintPosition: shared_mtv.MtvRoomCoords{
-			Lat: 42,
-			Lng: 42,
-		},
-		PhysicalConstraintRadius:   5000,
-		PhysicalConstraintEndsAt:   end,
-		PhysicalConstraintStartsAt: start,
-	}
-
-	params.HasPhysicalAndTimeConstraints = true
-	params.PhysicalAndTimeConstraints = &physicalAndTimeConstraints
-	params.CreatorUserRelatedInformation.UserFitsPositionConstraint = &falseValue
+	params, _ := getWorkflowInitParams(tracksIDs, 1)
 
+	defaultDuration := 1 * time.Millisecond
 	resetMock, registerDelayedCallbackWrapper := s.initTestEnv()
 
 	defer resetMock()
 
 	s.env.OnActivity(
-		activities.FetchTracksInformationActivity,
+		activities.FetchTracksInformationWithPolicyActivity,
 		mock.Anything,
-		tracksIDs,
-	).Return(tracks, nil).Once()
+		fetchTracksInformationArgs(tracksIDs),
+	).Return(activities.FetchedTracksInformationWithInitiator{Metadata: tracks}, nil).Once()
 	s.env.OnActivity(
 		a.CreationAcknowledgementActivity,
 		mock.Anything,
 		mock.Anything,
 	).Return(nil).Once()
 	s.env.OnActivity(
-		a.AcknowledgeUpdateUserFitsPositionConstraint,
+		a.JoinActivity,
+		mock.Anything,
+		mock.Anything,
+	).Return(nil).Times(2)
+	s.env.OnActivity(
+		a.PlayActivity,
+		mock.Anything,
+		mock.Anything,
+	).Return(nil).Times(2)
+	s.env.OnActivity(
+		a.NotifySkipVotesUpdateActivity,
+		mock.Anything,
+		mock.Anything,
+	).Return(nil).Once()
+	s.env.OnActivity(
+		a.LeaveActivity,
 		mock.Anything,
 		mock.Anything,
 	).Return(nil).Once()
+	s.env.OnActivity(
+		a.UserLengthUpdateActivity,
+		mock.Anything,
+		mock.Anything,
+	).Return(nil).Times(3)
 
-	init := defaultDuration
+	joinRoom := defaultDuration
 	registerDelayedCallbackWrapper(func() {
-		mtvState := s.getMtvState(params.RoomCreatorUserID)
-		fmt.Printf("\n STATE =\n%+v\n", mtvState)
-		expectedCreator := &shared_mtv.InternalStateUser{
-			UserID:                            params.RoomCreatorUserID,
-			DeviceID:                          creatorDeviceID,
-			TracksVotedFor:                    []string{},
-			UserFitsPositionConstraint:        &falseValue,
-			HasControlAndDelegationPermission: true,
-		}
-
-		expectedTracks := []shared_mtv.TrackMetadataWithScoreWithDuration{
-			{
-				TrackMetadataWithScore: shared_mtv.TrackMetadataWithScore{
-					TrackMetadata: shared.TrackMetadata{
-						ID:         tracks[0].ID,
-						Title:      tracks[0].Title,
-						ArtistName: tracks[0].ArtistName,
-						Duration:   0,
-					},
-					Score: 0,
-				},
-				Duration: tracks[0].Duration.Milliseconds(),
-			},
-			{
-				TrackMetadataWithScore: shared_mtv.TrackMetadataWithScore{
-					TrackMetadata: shared.TrackMetadata{
-						ID:         tracks[1].ID,
-						Title:      tracks[1].Title,
-						ArtistName: tracks[1].ArtistName,
-						Duration:   0,
-					},
-					Score: 0,
-				},
-				Duration: tracks[1].Duration.Milliseconds(),
-			},
-		}
-
-		s.False(mtvState.Playing)
-		s.Equal(1, mtvState.MinimumScoreToBePlayed)
-		s.Equal(expectedCreator, mtvState.UserRelatedInformation)
-		s.Equal(expectedTracks, mtvState.Tracks) //here
-		s.True(mtvState.RoomHasTimeAndPositionConstraints)
-	}, init)
+		s.emitJoinSignal(shared_mtv.NewJoinSignalArgs{
+			UserID:             firstJoiningUserID,
+			DeviceID:           faker.UUIDHyphenated(),
+			UserHasBeenInvited: false,
+		})
+		s.emitJoinSignal(shared_mtv.NewJoinSignalArgs{
+			UserID:             secondJoiningUserID,
+			DeviceID:           faker.UUIDHyphenated(),
+			UserHasBeenInvited: false,
+		})
+	}, joinRoom)
 
-	updateCreatorAbilityToVoteForTime := defaultDuration
+	play := defaultDuration
 	registerDelayedCallbackWrapper(func() {
-		s.emitUpdateUserPositionPermissionSignal(shared_mtv.NewUpdateUserFitsPositionConstraintSignalArgs{
-			UserID:                     params.RoomCreatorUserID,
-			UserFitsPositionConstraint: true,
+		s.emitPlaySignal(shared_mtv.NewPlaySignalArgs{
+			UserID: params.RoomCreatorUserID,
 		})
-	}, updateCreatorAbilityToVoteForTime)
+	}, play)
 
-	checkPositionUpdateWorked := defaultDuration
+	voteToSkip := defaultDuration
 	registerDelayedCallbackWrapper(func() {
-		mtvState := s.getMtvState(params.RoomCreatorUserID)
+		s.emitVoteToSkipCurrentTrackSignal(shared_mtv.NewVoteToSkipCurrentTrackSignalArgs{
+			UserID: firstJoiningUserID,
+		})
+	}, voteToSkip)
 
-		expectedCreator := &shared_mtv.InternalStateUser{
-			UserID:                            params.RoomCreatorUserID,
-			DeviceID:                          creatorDeviceID,
-			TracksVotedFor:                    []string{},
-			UserFitsPositionConstraint:        &trueValue,
-			HasControlAndDelegationPermission: true,
-		}
+	checkVoteToSkipWasCounted := defaultDuration
+	registerDelayedCallbackWrapper(func() {
+		mtvState := s.getMtvState(shared_mtv.NoRelatedUserID)
 
-		s.False(mtvState.Playing)
-		s.Equal(expectedCreator, mtvState.UserRelatedInformation)
-	}, checkPositionUpdateWorked)
+		s.Equal(tracks[0].ID, mtvState.CurrentTrack.ID)
+		s.Equal(1, mtvState.CurrentTrackSkipVotes)
+		s.Equal(2, mtvState.SkipVotesRequired)
+	}, checkVoteToSkipWasCounted)
 
-	voteForAnExistingTrack := defaultDuration
+	// Fewer skip votes are required once a user has left.
+	userLeaves := defaultDuration
 	registerDelayedCallbackWrapper(func() {
-		s.emitVoteSignal(shared_mtv.NewVoteForTrackSignalArgs{
-			UserID:  params.RoomCreatorUserID,
-			TrackID: tracks[0].ID,
-		})
-	}, voteForAnExistingTrack)
+		s.emitLeaveSignal(secondJoiningUserID)
+	}, userLeaves)
 
-	checkVoteWorked := defaultDuration
+	checkCurrentTrackHasBeenSkipped := defaultDuration
 	registerDelayedCallbackWrapper(func() {
-		mtvState := s.getMtvState(params.RoomCreatorUserID)
-
-		expectedCreator := &shared_mtv.InternalStateUser{
-			UserID:                            params.RoomCreatorUserID,
-			DeviceID:                          creatorDeviceID,
-			TracksVotedFor:                    []string{},
-			UserFitsPositionConstraint:        &trueValue,
-			HasControlAndDelegationPermission: true,
-		}
+		mtvState := s.getMtvState(shared_mtv.NoRelatedUserID)
 
-		s.Equal(expectedCreator, mtvState.UserRelatedInformation)
 		s.True(mtvState.Playing)
-		s.Equal(tracks[0].ID, mtvState.CurrentTrack.ID)
-	}, checkVoteWorked)
+		s.Equal(tracks[1].ID, mtvState.CurrentTrack.ID)
+		s.Equal(0, mtvState.CurrentTrackSkipVotes)
+	}, checkCurrentTrackHasBeenSkipped)
 
 	s.env.ExecuteWorkflow(MtvRoomWorkflow, params)
 
@@ -2788,11 +3016,13 @@ func (s *UnitTestSuite) Test_CreateRoomWithPositionAndTimeConstraintAndTestPosit
 	s.ErrorIs(err, workflow.ErrDeadlineExceeded, "The workflow ran on an infinite loop")
 }
 
-func (s *UnitTestSuite) Test_CreateRoomWithPositionAndTimeConstraintAndTestTimeConstraint() {
-	var a *activities_mtv.Activities
+func (s *UnitTestSuite) Test_SkipVotesAreCheckedAgainAfterMinimumScoreIsLowered() {
+	var (
+		a *activities_mtv.Activities
+
+		joiningUserID = faker.UUIDHyphenated()
+	)
 
-	falseValue := false
-	trueValue := true
 	tracks := []shared.TrackMetadata{
 		{
 			ID:         faker.UUIDHyphenated(),
@@ -2807,164 +3037,108 @@ func (s *UnitTestSuite) Test_CreateRoomWithPositionAndTimeConstraintAndTestTimeC
 			Duration:   random.GenerateRandomDuration(),
 		},
 	}
-
 	tracksIDs := []string{tracks[0].ID, tracks[1].ID}
-	params, creatorDeviceID := getWorkflowInitParams(tracksIDs, 1)
-	defaultDuration := 1 * time.Millisecond
-
-	start := time.Now()
-
-	end := start.Add(defaultDuration * 5000)
-
-	physicalAndTimeConstraints := shared_mtv.MtvRoomPhysicalAndTimeConstraints{
-		PhysicalConstraintPosition: shared_mtv.MtvRoomCoords{
-			Lat: 42,
-			Lng: 42,
-		},
-		PhysicalConstraintRadius:   5000,
-		PhysicalConstraintEndsAt:   end,
-		PhysicalConstraintStartsAt: start,
-	}
-
-	params.HasPhysicalAndTimeConstraints = true
-	params.PhysicalAndTimeConstraints = &physicalAndTimeConstraints
-	params.CreatorUserRelatedInformation.UserFitsPositionConstraint = &falseValue
+	params, _ := getWorkflowInitParams(tracksIDs, 1)
+	raisedMinimumScoreToBePlayed := 2
+	loweredMinimumScoreToBePlayed := 1
 
+	defaultDuration := 1 * time.Millisecond
 	resetMock, registerDelayedCallbackWrapper := s.initTestEnv()
 
 	defer resetMock()
 
 	s.env.OnActivity(
-		activities.FetchTracksInformationActivity,
+		activities.FetchTracksInformationWithPolicyActivity,
 		mock.Anything,
-		tracksIDs,
-	).Return(tracks, nil).Once()
+		fetchTracksInformationArgs(tracksIDs),
+	).Return(activities.FetchedTracksInformationWithInitiator{Metadata: tracks}, nil).Once()
 	s.env.OnActivity(
 		a.CreationAcknowledgementActivity,
 		mock.Anything,
 		mock.Anything,
 	).Return(nil).Once()
+	s.env.OnActivity(
+		a.JoinActivity,
+		mock.Anything,
+		mock.Anything,
+	).Return(nil).Once()
+	s.env.OnActivity(
+		a.PlayActivity,
+		mock.Anything,
+		mock.Anything,
+	).Return(nil).Times(2)
+	s.env.OnActivity(
+		a.NotifySkipVotesUpdateActivity,
+		mock.Anything,
+		mock.Anything,
+	).Return(nil).Once()
+	s.env.OnActivity(
+		a.AcknowledgeUpdateRoomSettings,
+		mock.Anything,
+		mock.Anything,
+	).Return(nil).Times(2)
 
-	init := defaultDuration
+	joinRoom := defaultDuration
 	registerDelayedCallbackWrapper(func() {
-		mtvState := s.getMtvState(params.RoomCreatorUserID)
+		s.emitJoinSignal(shared_mtv.NewJoinSignalArgs{
+			UserID:             joiningUserID,
+			DeviceID:           faker.UUIDHyphenated(),
+			UserHasBeenInvited: false,
+		})
+	}, joinRoom)
 
-		expectedCreator := &shared_mtv.InternalStateUser{
-			UserID:                            params.RoomCreatorUserID,
-			DeviceID:                          creatorDeviceID,
-			TracksVotedFor:                    []string{},
-			UserFitsPositionConstraint:        &falseValue,
-			HasControlAndDelegationPermission: true,
-		}
+	play := defaultDuration
+	registerDelayedCallbackWrapper(func() {
+		s.emitPlaySignal(shared_mtv.NewPlaySignalArgs{
+			UserID: params.RoomCreatorUserID,
+		})
+	}, play)
 
-		expectedTracks := []shared_mtv.TrackMetadataWithScoreWithDuration{
-			{
-				TrackMetadataWithScore: shared_mtv.TrackMetadataWithScore{
-					TrackMetadata: shared.TrackMetadata{
-						ID:         tracks[0].ID,
-						Title:      tracks[0].Title,
-						ArtistName: tracks[0].ArtistName,
-						Duration:   0,
-					},
-					Score: 0,
-				},
-				Duration: tracks[0].Duration.Milliseconds(),
-			},
-			{
-				TrackMetadataWithScore: shared_mtv.TrackMetadataWithScore{
-					TrackMetadata: shared.TrackMetadata{
-						ID:         tracks[1].ID,
-						Title:      tracks[1].Title,
-						ArtistName: tracks[1].ArtistName,
-						Duration:   0,
-					},
-					Score: 0,
-				},
-				Duration: tracks[1].Duration.Milliseconds(),
+	raiseMinimumScore := defaultDuration
+	registerDelayedCallbackWrapper(func() {
+		s.emitUpdateRoomSettingsSignal(shared_mtv.NewUpdateRoomSettingsSignalArgs{
+			UserID: params.RoomCreatorUserID,
+			Settings: shared_mtv.MtvRoomSettingsUpdate{
+				MinimumScoreToBePlayed: &raisedMinimumScoreToBePlayed,
 			},
-		}
-
-		s.False(mtvState.Playing)
-		s.Equal(1, mtvState.MinimumScoreToBePlayed)
-		s.Equal(expectedCreator, mtvState.UserRelatedInformation)
-		s.Equal(expectedTracks, mtvState.Tracks)
-	}, init)
+		})
+	}, raiseMinimumScore)
 
-	updateCreatorAbilityToVoteForTime := defaultDuration
+	// The required skip votes are reached but the next track is not ready to be played.
+	voteToSkip := defaultDuration
 	registerDelayedCallbackWrapper(func() {
-		s.emitUpdateUserPositionPermissionSignal(shared_mtv.NewUpdateUserFitsPositionConstraintSignalArgs{
-			UserID:                     params.RoomCreatorUserID,
-			UserFitsPositionConstraint: true,
+		s.emitVoteToSkipCurrentTrackSignal(shared_mtv.NewVoteToSkipCurrentTrackSignalArgs{
+			UserID: joiningUserID,
 		})
-	}, updateCreatorAbilityToVoteForTime)
+	}, voteToSkip)
 
-	checkPositionUpdateWorked := defaultDuration
+	checkCurrentTrackHasNotBeenSkipped := defaultDuration
 	registerDelayedCallbackWrapper(func() {
-		mtvState := s.getMtvState(params.RoomCreatorUserID)
-
-		expectedCreator := &shared_mtv.InternalStateUser{
-			UserID:                            params.RoomCreatorUserID,
-			DeviceID:                          creatorDeviceID,
-			TracksVotedFor:                    []string{},
-			UserFitsPositionConstraint:        &trueValue,
-			HasControlAndDelegationPermission: true,
-		}
+		mtvState := s.getMtvState(shared_mtv.NoRelatedUserID)
 
-		s.False(mtvState.Playing)
-		s.Equal(expectedCreator, mtvState.UserRelatedInformation)
-	}, checkPositionUpdateWorked)
+		s.Equal(tracks[0].ID, mtvState.CurrentTrack.ID)
+		s.Equal(1, mtvState.CurrentTrackSkipVotes)
+		s.Equal(1, mtvState.SkipVotesRequired)
+	}, checkCurrentTrackHasNotBeenSkipped)
 
-	voteForAnExistingTrack := 5001 * defaultDuration
+	lowerMinimumScore := defaultDuration
 	registerDelayedCallbackWrapper(func() {
-		s.emitVoteSignal(shared_mtv.NewVoteForTrackSignalArgs{
-			UserID:  params.RoomCreatorUserID,
-			TrackID: tracks[0].ID,
+		s.emitUpdateRoomSettingsSignal(shared_mtv.NewUpdateRoomSettingsSignalArgs{
+			UserID: params.RoomCreatorUserID,
+			Settings: shared_mtv.MtvRoomSettingsUpdate{
+				MinimumScoreToBePlayed: &loweredMinimumScoreToBePlayed,
+			},
 		})
-	}, voteForAnExistingTrack)
+	}, lowerMinimumScore)
 
-	checkVoteDidntWorked := defaultDuration
+	checkCurrentTrackHasBeenSkipped := defaultDuration
 	registerDelayedCallbackWrapper(func() {
-		mtvState := s.getMtvState(params.RoomCreatorUserID)
-
-		expectedCreator := &shared_mtv.InternalStateUser{
-			UserID:                            params.RoomCreatorUserID,
-			DeviceID:                          creatorDeviceID,
-			TracksVotedFor:                    []string{},
-			UserFitsPositionConstraint:        &trueValue,
-			HasControlAndDelegationPermission: true,
-		}
-
-		expectedTracks := []shared_mtv.TrackMetadataWithScoreWithDuration{
-			{
-				TrackMetadataWithScore: shared_mtv.TrackMetadataWithScore{
-					TrackMetadata: shared.TrackMetadata{
-						ID:         tracks[0].ID,
-						Title:      tracks[0].Title,
-						ArtistName: tracks[0].ArtistName,
-						Duration:   0,
-					},
-					Score: 0,
-				},
-				Duration: tracks[0].Duration.Milliseconds(),
-			},
-			{
-				TrackMetadataWithScore: shared_mtv.TrackMetadataWithScore{
-					TrackMetadata: shared.TrackMetadata{
-						ID:         tracks[1].ID,
-						Title:      tracks[1].Title,
-						ArtistName: tracks[1].ArtistName,
-						Duration:   0,
-					},
-					Score: 0,
-				},
-				Duration: tracks[1].Duration.Milliseconds(),
-			},
-		}
+		mtvState := s.getMtvState(shared_mtv.NoRelatedUserID)
 
-		s.False(mtvState.Playing)
-		s.Equal(expectedCreator, mtvState.UserRelatedInformation)
-		s.Equal(expectedTracks, mtvState.Tracks)
-	}, checkVoteDidntWorked)
+		s.True(mtvState.Playing)
+		s.Equal(tracks[1].ID, mtvState.CurrentTrack.ID)
+		s.Equal(0, mtvState.CurrentTrackSkipVotes)
+	}, checkCurrentTrackHasBeenSkipped)
 
 	s.env.ExecuteWorkflow(MtvRoomWorkflow, params)
 
@@ -2973,12 +3147,12 @@ func (s *UnitTestSuite) Test_CreateRoomWithPositionAndTimeConstraintAndTestTimeC
 	s.ErrorIs(err, workflow.ErrDeadlineExceeded, "The workflow ran on an infinite loop")
 }
 
-func (s *UnitTestSuite) Test_CreateDirectRoomAndUpdateDelegationOwner() {
+func (s *UnitTestSuite) Test_EmptyCurrentTrackAutoPlayAfterOneGetReadyToBePlayed() {
 	var (
 		a *activities_mtv.Activities
 
-		joiningUserID       = faker.UUIDHyphenated()
-		joiningUserDeviceID = faker.UUIDHyphenated()
+		joiningUserID   = faker.UUIDHyphenated()
+		joiningDeviceID = faker.UUIDHyphenated()
 	)
 
 	tracks := []shared.TrackMetadata{
@@ -2988,32 +3162,38 @@ func (s *UnitTestSuite) Test_CreateDirectRoomAndUpdateDelegationOwner() {
 			ArtistName: faker.Name(),
 			Duration:   random.GenerateRandomDuration(),
 		},
+		{
+			ID:         faker.UUIDHyphenated(),
+			Title:      faker.Word(),
+			ArtistName: faker.Name(),
+			Duration:   random.GenerateRandomDuration(),
+		},
+		{
+			ID:         faker.UUIDHyphenated(),
+			Title:      faker.Word(),
+			ArtistName: faker.Name(),
+			Duration:   random.GenerateRandomDuration(),
+		},
 	}
 
-	tracksIDs := []string{tracks[0].ID}
-	params, creatorDeviceID := getWorkflowInitParams(tracksIDs, 1)
-	defaultDuration := 1 * time.Millisecond
+	tracksIDs := []string{tracks[0].ID, tracks[1].ID, tracks[2].ID}
+	params, creatorDeviceID := getWorkflowInitParams(tracksIDs, 2)
 
-	params.PlayingMode = shared_mtv.MtvPlayingModeDirect
+	defaultDuration := 1 * time.Millisecond
 	resetMock, registerDelayedCallbackWrapper := s.initTestEnv()
 
 	defer resetMock()
 
 	s.env.OnActivity(
-		activities.FetchTracksInformationActivity,
+		activities.FetchTracksInformationWithPolicyActivity,
 		mock.Anything,
-		tracksIDs,
-	).Return(tracks, nil).Once()
+		fetchTracksInformationArgs(tracksIDs),
+	).Return(activities.FetchedTracksInformationWithInitiator{Metadata: tracks}, nil).Once()
 	s.env.OnActivity(
 		a.CreationAcknowledgementActivity,
 		mock.Anything,
 		mock.Anything,
 	).Return(nil).Once()
-	s.env.OnActivity(
-		a.AcknowledgeUpdateDelegationOwner,
-		mock.Anything,
-		mock.Anything,
-	).Return(nil).Once()
 
 	init := defaultDuration
 	registerDelayedCallbackWrapper(func() {
@@ -3022,139 +3202,102 @@ func (s *UnitTestSuite) Test_CreateDirectRoomAndUpdateDelegationOwner() {
 		expectedCreator := &shared_mtv.InternalStateUser{
 			UserID:                            params.RoomCreatorUserID,
 			DeviceID:                          creatorDeviceID,
-			TracksVotedFor:                    []string{},
-			UserFitsPositionConstraint:        nil,
+			TracksVotedFor:                    tracksIDs,
 			HasControlAndDelegationPermission: true,
 		}
-		s.Equal(expectedCreator, mtvState.UserRelatedInformation)
+
 		s.False(mtvState.Playing)
-		s.Equal(shared_mtv.MtvPlayingModeDirect, mtvState.PlayingMode)
-		s.Equal(&params.RoomCreatorUserID, mtvState.DelegationOwnerUserID)
+		s.Equal(2, mtvState.MinimumScoreToBePlayed)
+		s.Equal(expectedCreator, mtvState.UserRelatedInformation)
 	}, init)
 
-	updateDelegationOwnerWithEmptyNewDelegationOwner := defaultDuration
+	emitGoToNextTrack := defaultDuration
 	registerDelayedCallbackWrapper(func() {
-		s.emitUpdateDelegationOwnerSignal(shared_mtv.NewUpdateDelegationOwnerSignalArgs{
-			NewDelegationOwnerUserID: "",
-			EmitterUserID:            params.RoomCreatorUserID,
-		})
-	}, updateDelegationOwnerWithEmptyNewDelegationOwner)
-
-	updateDelegationOwnerWithEmptyEmitter := defaultDuration
-	registerDelayedCallbackWrapper(func() {
-		s.emitUpdateDelegationOwnerSignal(shared_mtv.NewUpdateDelegationOwnerSignalArgs{
-			NewDelegationOwnerUserID: faker.UUIDHyphenated(),
-			EmitterUserID:            "",
-		})
-	}, updateDelegationOwnerWithEmptyEmitter)
-
-	updateDelegationOwnerWithNunExistingUser := defaultDuration
-	registerDelayedCallbackWrapper(func() {
-		s.emitUpdateDelegationOwnerSignal(shared_mtv.NewUpdateDelegationOwnerSignalArgs{
-			NewDelegationOwnerUserID: joiningUserID,
-			EmitterUserID:            params.RoomCreatorUserID,
+		s.emitGoToNextTrackSignal(shared_mtv.NewGoToNextTrackSignalArgs{
+			UserID: params.RoomCreatorUserID,
 		})
-	}, updateDelegationOwnerWithNunExistingUser)
+	}, emitGoToNextTrack)
 
-	checkNothingWorked := defaultDuration
+	checkNothingHappened := defaultDuration
 	registerDelayedCallbackWrapper(func() {
 		mtvState := s.getMtvState(params.RoomCreatorUserID)
-
 		expectedCreator := &shared_mtv.InternalStateUser{
 			UserID:                            params.RoomCreatorUserID,
-			DeviceID:                          creatorDeviceID,
-			TracksVotedFor:                    []string{},
-			UserFitsPositionConstraint:        nil,
 			HasControlAndDelegationPermission: true,
+			DeviceID:                          creatorDeviceID,
+			TracksVotedFor:                    tracksIDs,
 		}
-		s.Equal(expectedCreator, mtvState.UserRelatedInformation)
+
 		s.False(mtvState.Playing)
-		s.Equal(shared_mtv.MtvPlayingModeDirect, mtvState.PlayingMode)
-		s.Equal(&params.RoomCreatorUserID, mtvState.DelegationOwnerUserID)
-	}, checkNothingWorked)
+		s.Equal(2, mtvState.MinimumScoreToBePlayed)
+		s.Equal(expectedCreator, mtvState.UserRelatedInformation)
+	}, checkNothingHappened)
 
 	emitJoinSignal := defaultDuration
 	registerDelayedCallbackWrapper(func() {
 		args := shared_mtv.NewJoinSignalArgs{
-			DeviceID:           joiningUserDeviceID,
+			DeviceID:           joiningDeviceID,
 			UserID:             joiningUserID,
 			UserHasBeenInvited: false,
 		}
 		s.emitJoinSignal(args)
 	}, emitJoinSignal)
 
-	updateDelegationOwnerWithExistingUserWithoutPermissions := defaultDuration
+	joiningUserVoteForTrack := defaultDuration
 	registerDelayedCallbackWrapper(func() {
-		s.emitUpdateDelegationOwnerSignal(shared_mtv.NewUpdateDelegationOwnerSignalArgs{
-			NewDelegationOwnerUserID: joiningUserID,
-			EmitterUserID:            joiningUserID,
+		s.emitVoteSignal(shared_mtv.NewVoteForTrackSignalArgs{
+			TrackID: tracksIDs[2],
+			UserID:  joiningUserID,
 		})
-	}, updateDelegationOwnerWithExistingUserWithoutPermissions)
-
-	checkNothingWorked = defaultDuration
-	registerDelayedCallbackWrapper(func() {
-		mtvState := s.getMtvState(params.RoomCreatorUserID)
-		expectedCreator := &shared_mtv.InternalStateUser{
-			UserID:                            params.RoomCreatorUserID,
-			DeviceID:                          creatorDeviceID,
-			TracksVotedFor:                    []string{},
-			UserFitsPositionConstraint:        nil,
-			HasControlAndDelegationPermission: true,
-		}
-
-		s.Equal(2, mtvState.UsersLength)
-		s.Equal(expectedCreator, mtvState.UserRelatedInformation)
-		s.False(mtvState.Playing)
-		s.Equal(shared_mtv.MtvPlayingModeDirect, mtvState.PlayingMode)
-		s.Equal(&params.RoomCreatorUserID, mtvState.DelegationOwnerUserID)
-	}, checkNothingWorked)
+	}, joiningUserVoteForTrack)
 
-	updateDelegationOwner := defaultDuration
+	//Here we test the auto play after a track from not ready to be played
+	//one finally get the required score amount
+	checkJoinAndVoteWorkedAlsoRoomIsPlaying := defaultDuration
 	registerDelayedCallbackWrapper(func() {
-		s.emitUpdateDelegationOwnerSignal(shared_mtv.NewUpdateDelegationOwnerSignalArgs{
-			NewDelegationOwnerUserID: joiningUserID,
-			EmitterUserID:            params.RoomCreatorUserID,
-		})
-	}, updateDelegationOwner)
+		mtvState := s.getMtvState(joiningUserID)
 
-	checkItWorked := defaultDuration
-	registerDelayedCallbackWrapper(func() {
-		mtvState := s.getMtvState(params.RoomCreatorUserID)
-		expectedCreator := &shared_mtv.InternalStateUser{
-			UserID:                            params.RoomCreatorUserID,
-			DeviceID:                          creatorDeviceID,
+		expectedJoiningUserRelatedInformation := &shared_mtv.InternalStateUser{
+			UserID:                            joiningUserID,
+			DeviceID:                          joiningDeviceID,
 			TracksVotedFor:                    []string{},
-			UserFitsPositionConstraint:        nil,
-			HasControlAndDelegationPermission: true,
+			HasControlAndDelegationPermission: false,
 		}
 
-		s.Equal(expectedCreator, mtvState.UserRelatedInformation)
-		s.False(mtvState.Playing)
-		s.Equal(shared_mtv.MtvPlayingModeDirect, mtvState.PlayingMode)
-		s.Equal(&joiningUserID, mtvState.DelegationOwnerUserID)
-	}, checkItWorked)
-
-	delegationOwnerLeaveRoom := defaultDuration
-	registerDelayedCallbackWrapper(func() {
-		s.emitLeaveSignal(joiningUserID)
-	}, delegationOwnerLeaveRoom)
+		expectedTracks := []shared_mtv.TrackMetadataWithScoreWithDuration{
 
-	checkDelegationOwnerReset := defaultDuration
-	registerDelayedCallbackWrapper(func() {
-		mtvState := s.getMtvState(params.RoomCreatorUserID)
-		expectedCreator := &shared_mtv.InternalStateUser{
-			UserID:                            params.RoomCreatorUserID,
-			DeviceID:                          creatorDeviceID,
-			TracksVotedFor:                    []string{},
-			UserFitsPositionConstraint:        nil,
-			HasControlAndDelegationPermission: true,
+			{
+				TrackMetadataWithScore: shared_mtv.TrackMetadataWithScore{
+					TrackMetadata: shared.TrackMetadata{
+						ID:         tracks[0].ID,
+						Title:      tracks[0].Title,
+						ArtistName: tracks[0].ArtistName,
+						Duration:   0,
+					},
+					Score: 1,
+				},
+				Duration: tracks[0].Duration.Milliseconds(),
+			},
+			{
+				TrackMetadataWithScore: shared_mtv.TrackMetadataWithScore{
+					TrackMetadata: shared.TrackMetadata{
+						ID:         tracks[1].ID,
+						Title:      tracks[1].Title,
+						ArtistName: tracks[1].ArtistName,
+						Duration:   0,
+					},
+					Score: 1,
+				},
+				Duration: tracks[1].Duration.Milliseconds(),
+			},
 		}
 
-		s.Equal(expectedCreator, mtvState.UserRelatedInformation)
-		s.False(mtvState.Playing)
-		s.Equal(shared_mtv.MtvPlayingModeDirect, mtvState.PlayingMode)
-		s.Equal(params.RoomCreatorUserID, *mtvState.DelegationOwnerUserID)
-	}, checkDelegationOwnerReset)
+		s.Equal(tracks[2].ID, mtvState.CurrentTrack.ID)
+		s.Equal(2, mtvState.UsersLength)
+		s.True(mtvState.Playing)
+		s.Equal(expectedJoiningUserRelatedInformation, mtvState.UserRelatedInformation)
+		s.Equal(expectedTracks, mtvState.Tracks)
+	}, checkJoinAndVoteWorkedAlsoRoomIsPlaying)
 
 	s.env.ExecuteWorkflow(MtvRoomWorkflow, params)
 
@@ -3163,12 +3306,12 @@ func (s *UnitTestSuite) Test_CreateDirectRoomAndUpdateDelegationOwner() {
 	s.ErrorIs(err, workflow.ErrDeadlineExceeded, "The workflow ran on an infinite loop")
 }
 
-func (s *UnitTestSuite) Test_CreateBroadcastRoomAndAttemptToExecuteDelegationOperation() {
+func (s *UnitTestSuite) Test_LoadedCurrentTrackAndReadyToBePlayedListNoAutoPlayAfterOneGetReadyToBePlayed() {
 	var (
 		a *activities_mtv.Activities
 
-		joiningUserID       = faker.UUIDHyphenated()
-		joiningUserDeviceID = faker.UUIDHyphenated()
+		joiningUserID   = faker.UUIDHyphenated()
+		joiningDeviceID = faker.UUIDHyphenated()
 	)
 
 	tracks := []shared.TrackMetadata{
@@ -3178,86 +3321,123 @@ func (s *UnitTestSuite) Test_CreateBroadcastRoomAndAttemptToExecuteDelegationOpe
 			ArtistName: faker.Name(),
 			Duration:   random.GenerateRandomDuration(),
 		},
+		{
+			ID:         faker.UUIDHyphenated(),
+			Title:      faker.Word(),
+			ArtistName: faker.Name(),
+			Duration:   random.GenerateRandomDuration(),
+		},
+		{
+			ID:         faker.UUIDHyphenated(),
+			Title:      faker.Word(),
+			ArtistName: faker.Name(),
+			Duration:   random.GenerateRandomDuration(),
+		},
 	}
 
-	tracksIDs := []string{tracks[0].ID}
+	tracksIDs := []string{tracks[0].ID, tracks[1].ID, tracks[2].ID}
 	params, creatorDeviceID := getWorkflowInitParams(tracksIDs, 1)
-	defaultDuration := 1 * time.Millisecond
 
-	params.PlayingMode = shared_mtv.MtvPlayingModeBroadcast
+	defaultDuration := 1 * time.Millisecond
 	resetMock, registerDelayedCallbackWrapper := s.initTestEnv()
 
 	defer resetMock()
 
 	s.env.OnActivity(
-		activities.FetchTracksInformationActivity,
+		activities.FetchTracksInformationWithPolicyActivity,
 		mock.Anything,
-		tracksIDs,
-	).Return(tracks, nil).Once()
+		fetchTracksInformationArgs(tracksIDs),
+	).Return(activities.FetchedTracksInformationWithInitiator{Metadata: tracks}, nil).Once()
 	s.env.OnActivity(
 		a.CreationAcknowledgementActivity,
 		mock.Anything,
 		mock.Anything,
 	).Return(nil).Once()
-	s.env.OnActivity(
-		a.AcknowledgeUpdateDelegationOwner,
-		mock.Anything,
-		mock.Anything,
-	).Return(nil).Never()
 
 	init := defaultDuration
 	registerDelayedCallbackWrapper(func() {
 		mtvState := s.getMtvState(params.RoomCreatorUserID)
 
 		expectedCreator := &shared_mtv.InternalStateUser{
-			UserID:                            params.RoomCreatorUserID,
-			DeviceID:                          creatorDeviceID,
-			TracksVotedFor:                    []string{},
-			UserFitsPositionConstraint:        nil,
+			UserID:   params.RoomCreatorUserID,
+			DeviceID: creatorDeviceID,
+			TracksVotedFor: []string{
+				//reversed because we remove tracksVotedFor element using unordered method
+				tracks[2].ID,
+				tracks[1].ID,
+			},
 			HasControlAndDelegationPermission: true,
 		}
 
-		s.Equal(expectedCreator, mtvState.UserRelatedInformation)
 		s.False(mtvState.Playing)
-		s.Equal(shared_mtv.MtvPlayingModeBroadcast, mtvState.PlayingMode)
-		s.Nil(mtvState.DelegationOwnerUserID)
+		s.Equal(1, mtvState.MinimumScoreToBePlayed)
+		s.Equal(expectedCreator, mtvState.UserRelatedInformation)
 	}, init)
 
 	emitJoinSignal := defaultDuration
 	registerDelayedCallbackWrapper(func() {
 		args := shared_mtv.NewJoinSignalArgs{
-			DeviceID:           joiningUserDeviceID,
+			DeviceID:           joiningDeviceID,
 			UserID:             joiningUserID,
 			UserHasBeenInvited: false,
 		}
 		s.emitJoinSignal(args)
 	}, emitJoinSignal)
 
-	updateDelegationOwner := defaultDuration
+	joiningUserVoteForTrack := defaultDuration
 	registerDelayedCallbackWrapper(func() {
-		s.emitUpdateDelegationOwnerSignal(shared_mtv.NewUpdateDelegationOwnerSignalArgs{
-			NewDelegationOwnerUserID: joiningUserID,
-			EmitterUserID:            params.RoomCreatorUserID,
+		s.emitVoteSignal(shared_mtv.NewVoteForTrackSignalArgs{
+			TrackID: tracksIDs[2],
+			UserID:  joiningUserID,
 		})
-	}, updateDelegationOwner)
+	}, joiningUserVoteForTrack)
 
+	checkJoinAndVoteWorkedAlsoRoomIsNotPlaying := defaultDuration
 	registerDelayedCallbackWrapper(func() {
-		mtvState := s.getMtvState(params.RoomCreatorUserID)
+		mtvState := s.getMtvState(joiningUserID)
 
-		expectedCreator := &shared_mtv.InternalStateUser{
-			UserID:                            params.RoomCreatorUserID,
-			DeviceID:                          creatorDeviceID,
-			TracksVotedFor:                    []string{},
-			UserFitsPositionConstraint:        nil,
-			HasControlAndDelegationPermission: true,
+		expectedJoiningUserRelatedInformation := &shared_mtv.InternalStateUser{
+			UserID:   joiningUserID,
+			DeviceID: joiningDeviceID,
+			TracksVotedFor: []string{
+				tracksIDs[2],
+			},
+			HasControlAndDelegationPermission: false,
+		}
+
+		expectedTracks := []shared_mtv.TrackMetadataWithScoreWithDuration{
+			{
+				TrackMetadataWithScore: shared_mtv.TrackMetadataWithScore{
+					TrackMetadata: shared.TrackMetadata{
+						ID:         tracks[2].ID,
+						Title:      tracks[2].Title,
+						ArtistName: tracks[2].ArtistName,
+						Duration:   0,
+					},
+					Score: 2,
+				},
+				Duration: tracks[2].Duration.Milliseconds(),
+			},
+			{
+				TrackMetadataWithScore: shared_mtv.TrackMetadataWithScore{
+					TrackMetadata: shared.TrackMetadata{
+						ID:         tracks[1].ID,
+						Title:      tracks[1].Title,
+						ArtistName: tracks[1].ArtistName,
+						Duration:   0,
+					},
+					Score: 1,
+				},
+				Duration: tracks[1].Duration.Milliseconds(),
+			},
 		}
 
+		s.Equal(tracks[0].ID, mtvState.CurrentTrack.ID)
 		s.Equal(2, mtvState.UsersLength)
-		s.Equal(expectedCreator, mtvState.UserRelatedInformation)
 		s.False(mtvState.Playing)
-		s.Equal(shared_mtv.MtvPlayingModeBroadcast, mtvState.PlayingMode)
-		s.Nil(mtvState.DelegationOwnerUserID)
-	}, init)
+		s.Equal(expectedJoiningUserRelatedInformation, mtvState.UserRelatedInformation)
+		s.Equal(expectedTracks, mtvState.Tracks)
+	}, checkJoinAndVoteWorkedAlsoRoomIsNotPlaying)
 
 	s.env.ExecuteWorkflow(MtvRoomWorkflow, params)
 
@@ -3266,12 +3446,12 @@ func (s *UnitTestSuite) Test_CreateBroadcastRoomAndAttemptToExecuteDelegationOpe
 	s.ErrorIs(err, workflow.ErrDeadlineExceeded, "The workflow ran on an infinite loop")
 }
 
-func (s *UnitTestSuite) Test_CanUpdateControlAndDelegationPermission() {
+func (s *UnitTestSuite) Test_LoadedAndEndedCurrentTrackAndNoTrackReadyToBePlayedAutoPlayAfterOneGetReadyToBePlayed() {
 	var (
 		a *activities_mtv.Activities
 
-		joiningUserID       = faker.UUIDHyphenated()
-		joiningUserDeviceID = faker.UUIDHyphenated()
+		joiningUserID   = faker.UUIDHyphenated()
+		joiningDeviceID = faker.UUIDHyphenated()
 	)
 
 	tracks := []shared.TrackMetadata{
@@ -3284,170 +3464,194 @@ func (s *UnitTestSuite) Test_CanUpdateControlAndDelegationPermission() {
 	}
 
 	tracksIDs := []string{tracks[0].ID}
-	params, creatorDeviceID := getWorkflowInitParams(tracksIDs, 1)
-	defaultDuration := 1 * time.Millisecond
+	params, creatorDeviceID := getWorkflowInitParams(tracksIDs, 2)
 
+	defaultDuration := 1 * time.Millisecond
 	resetMock, registerDelayedCallbackWrapper := s.initTestEnv()
 
 	defer resetMock()
 
 	s.env.OnActivity(
-		activities.FetchTracksInformationActivity,
+		activities.FetchTracksInformationWithPolicyActivity,
 		mock.Anything,
-		tracksIDs,
-	).Return(tracks, nil).Once()
+		fetchTracksInformationArgs(tracksIDs),
+	).Return(activities.FetchedTracksInformationWithInitiator{Metadata: tracks}, nil).Once()
 	s.env.OnActivity(
 		a.CreationAcknowledgementActivity,
 		mock.Anything,
 		mock.Anything,
 	).Return(nil).Once()
-	s.env.OnActivity(
-		a.AcknowledgeUpdateControlAndDelegationPermission,
-		mock.Anything,
-		mock.Anything,
-	).Return(nil).Once()
 
 	init := defaultDuration
 	registerDelayedCallbackWrapper(func() {
 		mtvState := s.getMtvState(params.RoomCreatorUserID)
 
 		expectedCreator := &shared_mtv.InternalStateUser{
-			UserID:                            params.RoomCreatorUserID,
-			DeviceID:                          creatorDeviceID,
-			TracksVotedFor:                    []string{},
-			UserFitsPositionConstraint:        nil,
+			UserID:   params.RoomCreatorUserID,
+			DeviceID: creatorDeviceID,
+			TracksVotedFor: []string{
+				tracks[0].ID,
+			},
 			HasControlAndDelegationPermission: true,
 		}
-		s.Equal(expectedCreator, mtvState.UserRelatedInformation)
+
 		s.False(mtvState.Playing)
+		s.Equal(2, mtvState.MinimumScoreToBePlayed)
+		s.Equal(expectedCreator, mtvState.UserRelatedInformation)
 	}, init)
 
 	emitJoinSignal := defaultDuration
 	registerDelayedCallbackWrapper(func() {
 		args := shared_mtv.NewJoinSignalArgs{
-			DeviceID:           joiningUserDeviceID,
+			DeviceID:           joiningDeviceID,
 			UserID:             joiningUserID,
 			UserHasBeenInvited: false,
 		}
 		s.emitJoinSignal(args)
 	}, emitJoinSignal)
 
-	userHasBeenAddedAndHasNotControlAndDelegationPermissionByDefault := defaultDuration
-	registerDelayedCallbackWrapper(func() {
-		mtvState := s.getMtvState(joiningUserID)
-
-		s.Equal(2, mtvState.UsersLength)
-		s.Equal(joiningUserID, mtvState.UserRelatedInformation.UserID)
-		s.Equal(false, mtvState.UserRelatedInformation.HasControlAndDelegationPermission)
-	}, userHasBeenAddedAndHasNotControlAndDelegationPermissionByDefault)
-
-	updateAddedUserControlAndDelegationPermission := defaultDuration
+	joiningUserVoteForTrack := defaultDuration
 	registerDelayedCallbackWrapper(func() {
-		s.emitUpdateControlAndDelegationPermissionSignal(shared_mtv.NewUpdateControlAndDelegationPermissionSignalArgs{
-			ToUpdateUserID:                    joiningUserID,
-			HasControlAndDelegationPermission: true,
+		s.emitVoteSignal(shared_mtv.NewVoteForTrackSignalArgs{
+			TrackID: tracksIDs[0],
+			UserID:  joiningUserID,
 		})
-	}, updateAddedUserControlAndDelegationPermission)
+	}, joiningUserVoteForTrack)
 
-	addedUserHasControlAndDelegationPermission := defaultDuration
+	checkJoinAndVoteWorkedAlsoRoomIsPlaying := defaultDuration
 	registerDelayedCallbackWrapper(func() {
 		mtvState := s.getMtvState(joiningUserID)
 
-		s.Equal(joiningUserID, mtvState.UserRelatedInformation.UserID)
-		s.Equal(true, mtvState.UserRelatedInformation.HasControlAndDelegationPermission)
-	}, addedUserHasControlAndDelegationPermission)
+		expectedJoiningUserRelatedInformation := &shared_mtv.InternalStateUser{
+			UserID:                            joiningUserID,
+			DeviceID:                          joiningDeviceID,
+			TracksVotedFor:                    []string{},
+			HasControlAndDelegationPermission: false,
+		}
 
-	s.env.ExecuteWorkflow(MtvRoomWorkflow, params)
+		expectedTracks := []shared_mtv.TrackMetadataWithScoreWithDuration{}
 
-	s.True(s.env.IsWorkflowCompleted())
-	err := s.env.GetWorkflowError()
-	s.ErrorIs(err, workflow.ErrDeadlineExceeded, "The workflow ran on an infinite loop")
-}
+		s.Equal(tracks[0].ID, mtvState.CurrentTrack.ID)
+		s.Equal(2, mtvState.UsersLength)
+		s.True(mtvState.Playing)
+		s.Equal(expectedJoiningUserRelatedInformation, mtvState.UserRelatedInformation)
+		s.Equal(expectedTracks, mtvState.Tracks)
+	}, checkJoinAndVoteWorkedAlsoRoomIsPlaying)
 
-func (s *UnitTestSuite) Test_GetUsersListQuery() {
-	var (
-		a *activities_mtv.Activities
+	justWaitEndOfCurrentTrack := tracks[0].Duration
+	registerDelayedCallbackWrapper(func() {
+	}, justWaitEndOfCurrentTrack)
 
-		joiningUserID       = faker.UUIDHyphenated()
-		joiningUserDeviceID = faker.UUIDHyphenated()
-	)
+	checkCurrentTrack := defaultDuration * 10
+	registerDelayedCallbackWrapper(func() {
+		mtvState := s.getMtvState(params.RoomCreatorUserID)
 
-	tracks := []shared.TrackMetadata{
-		{
-			ID:         faker.UUIDHyphenated(),
-			Title:      faker.Word(),
-			ArtistName: faker.Name(),
-			Duration:   random.GenerateRandomDuration(),
-		},
-	}
+		expectedExposedCurrentTrack := &shared_mtv.ExposedCurrentTrack{
+			CurrentTrack: shared_mtv.CurrentTrack{
+				TrackMetadataWithScore: shared_mtv.TrackMetadataWithScore{
+					TrackMetadata: shared.TrackMetadata{
+						ID:         tracks[0].ID,
+						ArtistName: tracks[0].ArtistName,
+						Title:      tracks[0].Title,
+						Duration:   0,
+					},
 
-	tracksIDs := []string{tracks[0].ID}
-	params, _ := getWorkflowInitParams(tracksIDs, 1)
-	defaultDuration := 1 * time.Millisecond
+					Score: 2,
+				},
+				AlreadyElapsed: 0,
+			},
+			Duration: tracks[0].Duration.Milliseconds(),
+			Elapsed:  tracks[0].Duration.Milliseconds(),
+		}
 
-	params.PlayingMode = shared_mtv.MtvPlayingModeBroadcast
-	resetMock, registerDelayedCallbackWrapper := s.initTestEnv()
+		s.False(mtvState.Playing)
+		s.Equal(mtvState.CurrentTrack, expectedExposedCurrentTrack)
+	}, checkCurrentTrack)
 
-	defer resetMock()
+	trackToSuggest := shared.TrackMetadata{
+		ID:         faker.UUIDHyphenated(),
+		Title:      faker.Word(),
+		ArtistName: faker.Name(),
+		Duration:   random.GenerateRandomDuration(),
+	}
 
-	s.env.OnActivity(
-		activities.FetchTracksInformationActivity,
-		mock.Anything,
-		tracksIDs,
-	).Return(tracks, nil).Once()
-	s.env.OnActivity(
-		a.CreationAcknowledgementActivity,
-		mock.Anything,
-		mock.Anything,
-	).Return(nil).Once()
+	creatorSuggestSong := defaultDuration
+	registerDelayedCallbackWrapper(func() {
+		s.emitSuggestTrackSignal(shared_mtv.SuggestTracksSignalArgs{
+			DeviceID:        creatorDeviceID,
+			UserID:          params.RoomCreatorUserID,
+			TracksToSuggest: []string{trackToSuggest.ID},
+		})
+	}, creatorSuggestSong)
 
-	init := defaultDuration
+	s.mockOnceSuggest(params.RoomCreatorUserID, creatorDeviceID, params.RoomID, []shared.TrackMetadata{trackToSuggest})
+	checkSuggestWorked := defaultDuration
 	registerDelayedCallbackWrapper(func() {
-		usersList := s.getUsersList()
+		mtvState := s.getMtvState(shared_mtv.NoRelatedUserID)
 
-		expectedUsersList := []shared_mtv.ExposedInternalStateUserListElement{
+		expectedTracks := []shared_mtv.TrackMetadataWithScoreWithDuration{
 			{
-				UserID:                            params.RoomCreatorUserID,
-				HasControlAndDelegationPermission: true,
-				IsCreator:                         true,
-				IsDelegationOwner:                 false,
+				TrackMetadataWithScore: shared_mtv.TrackMetadataWithScore{
+					TrackMetadata: shared.TrackMetadata{
+						ID:         trackToSuggest.ID,
+						Title:      trackToSuggest.Title,
+						ArtistName: trackToSuggest.ArtistName,
+						Duration:   0,
+					},
+					Score: 1,
+				},
+				Duration: trackToSuggest.Duration.Milliseconds(),
 			},
 		}
 
-		s.Equal(expectedUsersList, usersList)
-	}, init)
+		s.False(mtvState.Playing)
+		s.Equal(expectedTracks, mtvState.Tracks)
+	}, checkSuggestWorked)
 
-	emitJoinSignal := defaultDuration
+	joiningUserVoteForSuggestedTrack := defaultDuration
 	registerDelayedCallbackWrapper(func() {
-		args := shared_mtv.NewJoinSignalArgs{
-			DeviceID:           joiningUserDeviceID,
-			UserID:             joiningUserID,
-			UserHasBeenInvited: false,
-		}
-		s.emitJoinSignal(args)
-	}, emitJoinSignal)
+		s.emitVoteSignal(shared_mtv.NewVoteForTrackSignalArgs{
+			TrackID: trackToSuggest.ID,
+			UserID:  joiningUserID,
+		})
+	}, joiningUserVoteForSuggestedTrack)
 
-	checkJoinWorked := defaultDuration
+	checkThatRoomAutoPlayed := defaultDuration
 	registerDelayedCallbackWrapper(func() {
-		usersList := s.getUsersList()
+		mtvState := s.getMtvState(joiningUserID)
 
-		expectedUsersList := []shared_mtv.ExposedInternalStateUserListElement{
-			{
-				UserID:                            params.RoomCreatorUserID,
-				HasControlAndDelegationPermission: true,
-				IsCreator:                         true,
-				IsDelegationOwner:                 false,
-			},
-			{
-				UserID:                            joiningUserID,
-				HasControlAndDelegationPermission: false,
-				IsCreator:                         false,
-				IsDelegationOwner:                 false,
+		expectedJoiningUser := &shared_mtv.InternalStateUser{
+			UserID:                            joiningUserID,
+			DeviceID:                          joiningDeviceID,
+			TracksVotedFor:                    []string{},
+			HasControlAndDelegationPermission: false,
+		}
+
+		expectedExposedCurrentTrack := &shared_mtv.ExposedCurrentTrack{
+			CurrentTrack: shared_mtv.CurrentTrack{
+				TrackMetadataWithScore: shared_mtv.TrackMetadataWithScore{
+					TrackMetadata: shared.TrackMetadata{
+						ID:         trackToSuggest.ID,
+						ArtistName: trackToSuggest.ArtistName,
+						Title:      trackToSuggest.Title,
+						Duration:   0,
+					},
+
+					Score: 2,
+				},
+				AlreadyElapsed: 0,
 			},
+			Duration: trackToSuggest.Duration.Milliseconds(),
+			Elapsed:  1,
 		}
 
-		s.ElementsMatch(expectedUsersList, usersList)
-	}, checkJoinWorked)
+		expectedTracks := []shared_mtv.TrackMetadataWithScoreWithDuration{}
+
+		s.True(mtvState.Playing)
+		s.Equal(expectedJoiningUser, mtvState.UserRelatedInformation)
+		s.Equal(mtvState.CurrentTrack, expectedExposedCurrentTrack)
+		s.Equal(mtvState.Tracks, expectedTracks)
+	}, checkThatRoomAutoPlayed)
 
 	s.env.ExecuteWorkflow(MtvRoomWorkflow, params)
 
@@ -3456,14 +3660,11 @@ func (s *UnitTestSuite) Test_GetUsersListQuery() {
 	s.ErrorIs(err, workflow.ErrDeadlineExceeded, "The workflow ran on an infinite loop")
 }
 
-func (s *UnitTestSuite) Test_GetUsersListQueryInDirectRoom() {
-	var (
-		a *activities_mtv.Activities
-
-		joiningUserID       = faker.UUIDHyphenated()
-		joiningUserDeviceID = faker.UUIDHyphenated()
-	)
+func (s *UnitTestSuite) Test_CreateRoomWithPositionAndTimeConstraintAndTestPositionConstraint() {
+	var a *activities_mtv.Activities
 
+	falseValue := false
+	trueValue := true
 	tracks := []shared.TrackMetadata{
 		{
 			ID:         faker.UUIDHyphenated(),
@@ -3471,109 +3672,150 @@ func (s *UnitTestSuite) Test_GetUsersListQueryInDirectRoom() {
 			ArtistName: faker.Name(),
 			Duration:   random.GenerateRandomDuration(),
 		},
+		{
+			ID:         faker.UUIDHyphenated(),
+			Title:      faker.Word(),
+			ArtistName: faker.Name(),
+			Duration:   random.GenerateRandomDuration(),
+		},
 	}
 
-	tracksIDs := []string{tracks[0].ID}
-	params, _ := getWorkflowInitParams(tracksIDs, 1)
+	tracksIDs := []string{tracks[0].ID, tracks[1].ID}
+	params, creatorDeviceID := getWorkflowInitParams(tracksIDs, 1)
 	defaultDuration := 1 * time.Millisecond
 
-	params.PlayingMode = shared_mtv.MtvPlayingModeDirect
-	resetMock, registerDelayedCallbackWrapper := s.initTestEnv()
+	start := time.Now()
 
-	defer resetMock()
+	end := start.Add(defaultDuration * 5000)
 
-	s.env.OnActivity(
-		activities.FetchTracksInformationActivity,
-		mock.Anything,
-		tracksIDs,
-	).Return(tracks, nil).Once()
-	s.env.OnActivity(
-		a.CreationAcknowledgementActivity,
-		mock.Anything,
-		mock.Anything,
-	).Return(nil).Once()
-	s.env.OnActivity(
-		a.AcknowledgeUpdateDelegationOwner,
+	physicalAndTimeConstraints := shared_mtv.MtvRoomPhysicalAndTimeConstraints{
+		PhysicalConstraintPosition: shared_mtv.MtvRoomCoords{
+			Lat: 42,
+			Lng: 42,
+		},
+		PhysicalConstraintRadius:   5000,
+		PhysicalConstraintEndsAt:   end,
+		PhysicalConstraintStartsAt: start,
+	}
+
+	params.HasPhysicalAndTimeConstraints = true
+	params.PhysicalAndTimeConstraints = &physicalAndTimeConstraints
+	params.CreatorUserRelatedInformation.UserFitsPositionConstraint = &falseValue
+
+	resetMock, registerDelayedCallbackWrapper := s.initTestEnv()
+
+	defer resetMock()
+
+	s.env.OnActivity(
+		activities.FetchTracksInformationWithPolicyActivity,
+		mock.Anything,
+		fetchTracksInformationArgs(tracksIDs),
+	).Return(activities.FetchedTracksInformationWithInitiator{Metadata: tracks}, nil).Once()
+	s.env.OnActivity(
+		a.CreationAcknowledgementActivity,
+		mock.Anything,
+		mock.Anything,
+	).Return(nil).Once()
+	s.env.OnActivity(
+		a.AcknowledgeUpdateUserFitsPositionConstraint,
 		mock.Anything,
 		mock.Anything,
 	).Return(nil).Once()
 
 	init := defaultDuration
 	registerDelayedCallbackWrapper(func() {
-		usersList := s.getUsersList()
+		mtvState := s.getMtvState(params.RoomCreatorUserID)
+		fmt.Printf("\n STATE =\n%+v\n", mtvState)
+		expectedCreator := &shared_mtv.InternalStateUser{
+			UserID:                            params.RoomCreatorUserID,
+			DeviceID:                          creatorDeviceID,
+			TracksVotedFor:                    []string{},
+			UserFitsPositionConstraint:        &falseValue,
+			HasControlAndDelegationPermission: true,
+		}
 
-		expectedUsersList := []shared_mtv.ExposedInternalStateUserListElement{
+		expectedTracks := []shared_mtv.TrackMetadataWithScoreWithDuration{
 			{
-				UserID:                            params.RoomCreatorUserID,
-				HasControlAndDelegationPermission: true,
-				IsCreator:                         true,
-				IsDelegationOwner:                 true,
+				TrackMetadataWithScore: shared_mtv.TrackMetadataWithScore{
+					TrackMetadata: shared.TrackMetadata{
+						ID:         tracks[0].ID,
+						Title:      tracks[0].Title,
+						ArtistName: tracks[0].ArtistName,
+						Duration:   0,
+					},
+					Score: 0,
+				},
+				Duration: tracks[0].Duration.Milliseconds(),
+			},
+			{
+				TrackMetadataWithScore: shared_mtv.TrackMetadataWithScore{
+					TrackMetadata: shared.TrackMetadata{
+						ID:         tracks[1].ID,
+						Title:      tracks[1].Title,
+						ArtistName: tracks[1].ArtistName,
+						Duration:   0,
+					},
+					Score: 0,
+				},
+				Duration: tracks[1].Duration.Milliseconds(),
 			},
 		}
 
-		s.Equal(expectedUsersList, usersList)
+		s.False(mtvState.Playing)
+		s.Equal(1, mtvState.MinimumScoreToBePlayed)
+		s.Equal(expectedCreator, mtvState.UserRelatedInformation)
+		s.Equal(expectedTracks, mtvState.Tracks) //here
+		s.True(mtvState.RoomHasTimeAndPositionConstraints)
 	}, init)
 
-	emitJoinSignal := defaultDuration
-	registerDelayedCallbackWrapper(func() {
-		args := shared_mtv.NewJoinSignalArgs{
-			DeviceID:           joiningUserDeviceID,
-			UserID:             joiningUserID,
-			UserHasBeenInvited: false,
-		}
-		s.emitJoinSignal(args)
-	}, emitJoinSignal)
-
-	updateDelegationOwner := defaultDuration
+	updateCreatorAbilityToVoteForTime := defaultDuration
 	registerDelayedCallbackWrapper(func() {
-		s.emitUpdateDelegationOwnerSignal(shared_mtv.NewUpdateDelegationOwnerSignalArgs{
-			NewDelegationOwnerUserID: joiningUserID,
-			EmitterUserID:            params.RoomCreatorUserID,
+		s.emitUpdateUserPositionPermissionSignal(shared_mtv.NewUpdateUserFitsPositionConstraintSignalArgs{
+			UserID:                     params.RoomCreatorUserID,
+			UserFitsPositionConstraint: true,
 		})
-	}, updateDelegationOwner)
+	}, updateCreatorAbilityToVoteForTime)
 
-	checkOperationsWorked := defaultDuration
+	checkPositionUpdateWorked := defaultDuration
 	registerDelayedCallbackWrapper(func() {
-		usersList := s.getUsersList()
+		mtvState := s.getMtvState(params.RoomCreatorUserID)
 
-		expectedUsersList := []shared_mtv.ExposedInternalStateUserListElement{
-			{
-				UserID:                            params.RoomCreatorUserID,
-				HasControlAndDelegationPermission: true,
-				IsCreator:                         true,
-				IsDelegationOwner:                 false,
-			},
-			{
-				UserID:                            joiningUserID,
-				HasControlAndDelegationPermission: false,
-				IsCreator:                         false,
-				IsDelegationOwner:                 true,
-			},
+		expectedCreator := &shared_mtv.InternalStateUser{
+			UserID:                            params.RoomCreatorUserID,
+			DeviceID:                          creatorDeviceID,
+			TracksVotedFor:                    []string{},
+			UserFitsPositionConstraint:        &trueValue,
+			HasControlAndDelegationPermission: true,
 		}
 
-		s.ElementsMatch(expectedUsersList, usersList)
-	}, checkOperationsWorked)
+		s.False(mtvState.Playing)
+		s.Equal(expectedCreator, mtvState.UserRelatedInformation)
+	}, checkPositionUpdateWorked)
 
-	emitLeave := defaultDuration
+	voteForAnExistingTrack := defaultDuration
 	registerDelayedCallbackWrapper(func() {
-		s.emitLeaveSignal(joiningUserID)
-	}, emitLeave)
+		s.emitVoteSignal(shared_mtv.NewVoteForTrackSignalArgs{
+			UserID:  params.RoomCreatorUserID,
+			TrackID: tracks[0].ID,
+		})
+	}, voteForAnExistingTrack)
 
-	checkDelegationOwnerResetAndUserListUpdate := defaultDuration
+	checkVoteWorked := defaultDuration
 	registerDelayedCallbackWrapper(func() {
-		usersList := s.getUsersList()
+		mtvState := s.getMtvState(params.RoomCreatorUserID)
 
-		expectedUsersList := []shared_mtv.ExposedInternalStateUserListElement{
-			{
-				UserID:                            params.RoomCreatorUserID,
-				HasControlAndDelegationPermission: true,
-				IsCreator:                         true,
-				IsDelegationOwner:                 true,
-			},
+		expectedCreator := &shared_mtv.InternalStateUser{
+			UserID:                            params.RoomCreatorUserID,
+			DeviceID:                          creatorDeviceID,
+			TracksVotedFor:                    []string{},
+			UserFitsPositionConstraint:        &trueValue,
+			HasControlAndDelegationPermission: true,
 		}
 
-		s.Equal(expectedUsersList, usersList)
-	}, checkDelegationOwnerResetAndUserListUpdate)
+		s.Equal(expectedCreator, mtvState.UserRelatedInformation)
+		s.True(mtvState.Playing)
+		s.Equal(tracks[0].ID, mtvState.CurrentTrack.ID)
+	}, checkVoteWorked)
 
 	s.env.ExecuteWorkflow(MtvRoomWorkflow, params)
 
@@ -3582,14 +3824,11 @@ func (s *UnitTestSuite) Test_GetUsersListQueryInDirectRoom() {
 	s.ErrorIs(err, workflow.ErrDeadlineExceeded, "The workflow ran on an infinite loop")
 }
 
-func (s *UnitTestSuite) Test_UserHasControlAndDelegationPermissionPlay() {
-	var (
-		a *activities_mtv.Activities
-
-		joiningUserID       = faker.UUIDHyphenated()
-		joiningUserDeviceID = faker.UUIDHyphenated()
-	)
+func (s *UnitTestSuite) Test_CreateRoomWithPositionAndTimeConstraintAndTestTimeConstraint() {
+	var a *activities_mtv.Activities
 
+	falseValue := false
+	trueValue := true
 	tracks := []shared.TrackMetadata{
 		{
 			ID:         faker.UUIDHyphenated(),
@@ -3597,32 +3836,50 @@ func (s *UnitTestSuite) Test_UserHasControlAndDelegationPermissionPlay() {
 			ArtistName: faker.Name(),
 			Duration:   random.GenerateRandomDuration(),
 		},
+		{
+			ID:         faker.UUIDHyphenated(),
+			Title:      faker.Word(),
+			ArtistName: faker.Name(),
+			Duration:   random.GenerateRandomDuration(),
+		},
 	}
 
-	tracksIDs := []string{tracks[0].ID}
+	tracksIDs := []string{tracks[0].ID, tracks[1].ID}
 	params, creatorDeviceID := getWorkflowInitParams(tracksIDs, 1)
 	defaultDuration := 1 * time.Millisecond
 
-	params.PlayingMode = shared_mtv.MtvPlayingModeDirect
+	start := time.Now()
+
+	end := start.Add(defaultDuration * 5000)
+
+	physicalAndTimeConstraints := shared_mtv.MtvRoomPhysicalAndTimeConstraints{
+		PhysicalConstraintPosition: shared_mtv.MtvRoomCoords{
+			Lat: 42,
+			Lng: 42,
+		},
+		PhysicalConstraintRadius:   5000,
+		PhysicalConstraintEndsAt:   end,
+		PhysicalConstraintStartsAt: start,
+	}
+
+	params.HasPhysicalAndTimeConstraints = true
+	params.PhysicalAndTimeConstraints = &physicalAndTimeConstraints
+	params.CreatorUserRelatedInformation.UserFitsPositionConstraint = &falseValue
+
 	resetMock, registerDelayedCallbackWrapper := s.initTestEnv()
 
 	defer resetMock()
 
 	s.env.OnActivity(
-		activities.FetchTracksInformationActivity,
+		activities.FetchTracksInformationWithPolicyActivity,
 		mock.Anything,
-		tracksIDs,
-	).Return(tracks, nil).Once()
+		fetchTracksInformationArgs(tracksIDs),
+	).Return(activities.FetchedTracksInformationWithInitiator{Metadata: tracks}, nil).Once()
 	s.env.OnActivity(
 		a.CreationAcknowledgementActivity,
 		mock.Anything,
 		mock.Anything,
 	).Return(nil).Once()
-	s.env.OnActivity(
-		a.PlayActivity,
-		mock.Anything,
-		mock.Anything,
-	).Return(nil).Once()
 
 	init := defaultDuration
 	registerDelayedCallbackWrapper(func() {
@@ -3632,72 +3889,118 @@ func (s *UnitTestSuite) Test_UserHasControlAndDelegationPermissionPlay() {
 			UserID:                            params.RoomCreatorUserID,
 			DeviceID:                          creatorDeviceID,
 			TracksVotedFor:                    []string{},
-			UserFitsPositionConstraint:        nil,
+			UserFitsPositionConstraint:        &falseValue,
 			HasControlAndDelegationPermission: true,
 		}
+
+		expectedTracks := []shared_mtv.TrackMetadataWithScoreWithDuration{
+			{
+				TrackMetadataWithScore: shared_mtv.TrackMetadataWithScore{
+					TrackMetadata: shared.TrackMetadata{
+						ID:         tracks[0].ID,
+						Title:      tracks[0].Title,
+						ArtistName: tracks[0].ArtistName,
+						Duration:   0,
+					},
+					Score: 0,
+				},
+				Duration: tracks[0].Duration.Milliseconds(),
+			},
+			{
+				TrackMetadataWithScore: shared_mtv.TrackMetadataWithScore{
+					TrackMetadata: shared.TrackMetadata{
+						ID:         tracks[1].ID,
+						Title:      tracks[1].Title,
+						ArtistName: tracks[1].ArtistName,
+						Duration:   0,
+					},
+					Score: 0,
+				},
+				Duration: tracks[1].Duration.Milliseconds(),
+			},
+		}
+
+		s.False(mtvState.Playing)
+		s.Equal(1, mtvState.MinimumScoreToBePlayed)
 		s.Equal(expectedCreator, mtvState.UserRelatedInformation)
+		s.Equal(expectedTracks, mtvState.Tracks)
 	}, init)
 
-	emitJoinSignal := defaultDuration
+	updateCreatorAbilityToVoteForTime := defaultDuration
 	registerDelayedCallbackWrapper(func() {
-		args := shared_mtv.NewJoinSignalArgs{
-			DeviceID:           joiningUserDeviceID,
-			UserID:             joiningUserID,
-			UserHasBeenInvited: false,
-		}
-		s.emitJoinSignal(args)
-	}, emitJoinSignal)
+		s.emitUpdateUserPositionPermissionSignal(shared_mtv.NewUpdateUserFitsPositionConstraintSignalArgs{
+			UserID:                     params.RoomCreatorUserID,
+			UserFitsPositionConstraint: true,
+		})
+	}, updateCreatorAbilityToVoteForTime)
 
-	checkJoinWorked := defaultDuration
+	checkPositionUpdateWorked := defaultDuration
 	registerDelayedCallbackWrapper(func() {
-		mtvState := s.getMtvState(joiningUserID)
+		mtvState := s.getMtvState(params.RoomCreatorUserID)
 
-		expectedJoiningUser := &shared_mtv.InternalStateUser{
-			UserID:                            joiningUserID,
-			DeviceID:                          joiningUserDeviceID,
+		expectedCreator := &shared_mtv.InternalStateUser{
+			UserID:                            params.RoomCreatorUserID,
+			DeviceID:                          creatorDeviceID,
 			TracksVotedFor:                    []string{},
-			UserFitsPositionConstraint:        nil,
-			HasControlAndDelegationPermission: false,
+			UserFitsPositionConstraint:        &trueValue,
+			HasControlAndDelegationPermission: true,
 		}
-		s.Equal(expectedJoiningUser, mtvState.UserRelatedInformation)
-	}, checkJoinWorked)
-
-	emitPlaySignal := defaultDuration
-	registerDelayedCallbackWrapper(func() {
-		s.emitPlaySignal(shared_mtv.NewPlaySignalArgs{
-			UserID: joiningUserID,
-		})
-	}, emitPlaySignal)
-
-	checkPlayFailed := defaultDuration
-	registerDelayedCallbackWrapper(func() {
-		mtvState := s.getMtvState(joiningUserID)
 
 		s.False(mtvState.Playing)
-	}, checkPlayFailed)
+		s.Equal(expectedCreator, mtvState.UserRelatedInformation)
+	}, checkPositionUpdateWorked)
 
-	emitUpdateUserControlAndDelegationPermission := defaultDuration
+	voteForAnExistingTrack := 5001 * defaultDuration
 	registerDelayedCallbackWrapper(func() {
-		s.emitUpdateControlAndDelegationPermissionSignal(shared_mtv.NewUpdateControlAndDelegationPermissionSignalArgs{
-			HasControlAndDelegationPermission: true,
-			ToUpdateUserID:                    joiningUserID,
+		s.emitVoteSignal(shared_mtv.NewVoteForTrackSignalArgs{
+			UserID:  params.RoomCreatorUserID,
+			TrackID: tracks[0].ID,
 		})
-	}, emitUpdateUserControlAndDelegationPermission)
+	}, voteForAnExistingTrack)
 
-	secondEmitPlaySignal := defaultDuration
+	checkVoteDidntWorked := defaultDuration
 	registerDelayedCallbackWrapper(func() {
-		s.emitPlaySignal(shared_mtv.NewPlaySignalArgs{
-			UserID: joiningUserID,
-		})
-	}, secondEmitPlaySignal)
+		mtvState := s.getMtvState(params.RoomCreatorUserID)
 
-	checkEmitPlayWorked := defaultDuration
-	registerDelayedCallbackWrapper(func() {
-		mtvState := s.getMtvState(joiningUserID)
+		expectedCreator := &shared_mtv.InternalStateUser{
+			UserID:                            params.RoomCreatorUserID,
+			DeviceID:                          creatorDeviceID,
+			TracksVotedFor:                    []string{},
+			UserFitsPositionConstraint:        &trueValue,
+			HasControlAndDelegationPermission: true,
+		}
 
-		s.True(mtvState.UserRelatedInformation.HasControlAndDelegationPermission)
-		s.True(mtvState.Playing)
-	}, checkEmitPlayWorked)
+		expectedTracks := []shared_mtv.TrackMetadataWithScoreWithDuration{
+			{
+				TrackMetadataWithScore: shared_mtv.TrackMetadataWithScore{
+					TrackMetadata: shared.TrackMetadata{
+						ID:         tracks[0].ID,
+						Title:      tracks[0].Title,
+						ArtistName: tracks[0].ArtistName,
+						Duration:   0,
+					},
+					Score: 0,
+				},
+				Duration: tracks[0].Duration.Milliseconds(),
+			},
+			{
+				TrackMetadataWithScore: shared_mtv.TrackMetadataWithScore{
+					TrackMetadata: shared.TrackMetadata{
+						ID:         tracks[1].ID,
+						Title:      tracks[1].Title,
+						ArtistName: tracks[1].ArtistName,
+						Duration:   0,
+					},
+					Score: 0,
+				},
+				Duration: tracks[1].Duration.Milliseconds(),
+			},
+		}
+
+		s.False(mtvState.Playing)
+		s.Equal(expectedCreator, mtvState.UserRelatedInformation)
+		s.Equal(expectedTracks, mtvState.Tracks)
+	}, checkVoteDidntWorked)
 
 	s.env.ExecuteWorkflow(MtvRoomWorkflow, params)
 
@@ -3706,7 +4009,7 @@ func (s *UnitTestSuite) Test_UserHasControlAndDelegationPermissionPlay() {
 	s.ErrorIs(err, workflow.ErrDeadlineExceeded, "The workflow ran on an infinite loop")
 }
 
-func (s *UnitTestSuite) Test_UserHasControlAndDelegationPermissionPause() {
+func (s *UnitTestSuite) Test_CreateDirectRoomAndUpdateDelegationOwner() {
 	var (
 		a *activities_mtv.Activities
 
@@ -3733,27 +4036,20 @@ func (s *UnitTestSuite) Test_UserHasControlAndDelegationPermissionPause() {
 	defer resetMock()
 
 	s.env.OnActivity(
-		activities.FetchTracksInformationActivity,
+		activities.FetchTracksInformationWithPolicyActivity,
 		mock.Anything,
-		tracksIDs,
-	).Return(tracks, nil).Once()
+		fetchTracksInformationArgs(tracksIDs),
+	).Return(activities.FetchedTracksInformationWithInitiator{Metadata: tracks}, nil).Once()
 	s.env.OnActivity(
 		a.CreationAcknowledgementActivity,
 		mock.Anything,
 		mock.Anything,
 	).Return(nil).Once()
 	s.env.OnActivity(
-		a.PlayActivity,
+		a.AcknowledgeUpdateDelegationOwner,
 		mock.Anything,
 		mock.Anything,
 	).Return(nil).Once()
-	//First call after initial tracks fetch
-	//Second call after Custom emit pause signal
-	s.env.OnActivity(
-		a.PauseActivity,
-		mock.Anything,
-		mock.Anything,
-	).Return(nil).Times(2)
 
 	init := defaultDuration
 	registerDelayedCallbackWrapper(func() {
@@ -3767,8 +4063,52 @@ func (s *UnitTestSuite) Test_UserHasControlAndDelegationPermissionPause() {
 			HasControlAndDelegationPermission: true,
 		}
 		s.Equal(expectedCreator, mtvState.UserRelatedInformation)
+		s.False(mtvState.Playing)
+		s.Equal(shared_mtv.MtvPlayingModeDirect, mtvState.PlayingMode)
+		s.Equal(&params.RoomCreatorUserID, mtvState.DelegationOwnerUserID)
 	}, init)
 
+	updateDelegationOwnerWithEmptyNewDelegationOwner := defaultDuration
+	registerDelayedCallbackWrapper(func() {
+		s.emitUpdateDelegationOwnerSignal(shared_mtv.NewUpdateDelegationOwnerSignalArgs{
+			NewDelegationOwnerUserID: "",
+			EmitterUserID:            params.RoomCreatorUserID,
+		})
+	}, updateDelegationOwnerWithEmptyNewDelegationOwner)
+
+	updateDelegationOwnerWithEmptyEmitter := defaultDuration
+	registerDelayedCallbackWrapper(func() {
+		s.emitUpdateDelegationOwnerSignal(shared_mtv.NewUpdateDelegationOwnerSignalArgs{
+			NewDelegationOwnerUserID: faker.UUIDHyphenated(),
+			EmitterUserID:            "",
+		})
+	}, updateDelegationOwnerWithEmptyEmitter)
+
+	updateDelegationOwnerWithNunExistingUser := defaultDuration
+	registerDelayedCallbackWrapper(func() {
+		s.emitUpdateDelegationOwnerSignal(shared_mtv.NewUpdateDelegationOwnerSignalArgs{
+			NewDelegationOwnerUserID: joiningUserID,
+			EmitterUserID:            params.RoomCreatorUserID,
+		})
+	}, updateDelegationOwnerWithNunExistingUser)
+
+	checkNothingWorked := defaultDuration
+	registerDelayedCallbackWrapper(func() {
+		mtvState := s.getMtvState(params.RoomCreatorUserID)
+
+		expectedCreator := &shared_mtv.InternalStateUser{
+			UserID:                            params.RoomCreatorUserID,
+			DeviceID:                          creatorDeviceID,
+			TracksVotedFor:                    []string{},
+			UserFitsPositionConstraint:        nil,
+			HasControlAndDelegationPermission: true,
+		}
+		s.Equal(expectedCreator, mtvState.UserRelatedInformation)
+		s.False(mtvState.Playing)
+		s.Equal(shared_mtv.MtvPlayingModeDirect, mtvState.PlayingMode)
+		s.Equal(&params.RoomCreatorUserID, mtvState.DelegationOwnerUserID)
+	}, checkNothingWorked)
+
 	emitJoinSignal := defaultDuration
 	registerDelayedCallbackWrapper(func() {
 		args := shared_mtv.NewJoinSignalArgs{
@@ -3779,70 +4119,78 @@ func (s *UnitTestSuite) Test_UserHasControlAndDelegationPermissionPause() {
 		s.emitJoinSignal(args)
 	}, emitJoinSignal)
 
-	checkJoinWorked := defaultDuration
+	updateDelegationOwnerWithExistingUserWithoutPermissions := defaultDuration
 	registerDelayedCallbackWrapper(func() {
-		mtvState := s.getMtvState(joiningUserID)
+		s.emitUpdateDelegationOwnerSignal(shared_mtv.NewUpdateDelegationOwnerSignalArgs{
+			NewDelegationOwnerUserID: joiningUserID,
+			EmitterUserID:            joiningUserID,
+		})
+	}, updateDelegationOwnerWithExistingUserWithoutPermissions)
 
-		expectedJoiningUser := &shared_mtv.InternalStateUser{
-			UserID:                            joiningUserID,
-			DeviceID:                          joiningUserDeviceID,
+	checkNothingWorked = defaultDuration
+	registerDelayedCallbackWrapper(func() {
+		mtvState := s.getMtvState(params.RoomCreatorUserID)
+		expectedCreator := &shared_mtv.InternalStateUser{
+			UserID:                            params.RoomCreatorUserID,
+			DeviceID:                          creatorDeviceID,
 			TracksVotedFor:                    []string{},
 			UserFitsPositionConstraint:        nil,
-			HasControlAndDelegationPermission: false,
+			HasControlAndDelegationPermission: true,
 		}
-		s.Equal(expectedJoiningUser, mtvState.UserRelatedInformation)
-	}, checkJoinWorked)
-
-	emitPlaySignal := defaultDuration
-	registerDelayedCallbackWrapper(func() {
-		s.emitPlaySignal(shared_mtv.NewPlaySignalArgs{
-			UserID: params.RoomCreatorUserID,
-		})
-	}, emitPlaySignal)
 
-	checkPlayWorked := defaultDuration
-	registerDelayedCallbackWrapper(func() {
-		mtvState := s.getMtvState(shared_mtv.NoRelatedUserID)
-
-		s.True(mtvState.Playing)
-	}, checkPlayWorked)
+		s.Equal(2, mtvState.UsersLength)
+		s.Equal(expectedCreator, mtvState.UserRelatedInformation)
+		s.False(mtvState.Playing)
+		s.Equal(shared_mtv.MtvPlayingModeDirect, mtvState.PlayingMode)
+		s.Equal(&params.RoomCreatorUserID, mtvState.DelegationOwnerUserID)
+	}, checkNothingWorked)
 
-	emitPauseSignal := defaultDuration
+	updateDelegationOwner := defaultDuration
 	registerDelayedCallbackWrapper(func() {
-		s.emitPlaySignal(shared_mtv.NewPlaySignalArgs{
-			UserID: joiningUserID,
+		s.emitUpdateDelegationOwnerSignal(shared_mtv.NewUpdateDelegationOwnerSignalArgs{
+			NewDelegationOwnerUserID: joiningUserID,
+			EmitterUserID:            params.RoomCreatorUserID,
 		})
-	}, emitPauseSignal)
-
-	checkPauseFailed := defaultDuration
-	registerDelayedCallbackWrapper(func() {
-		mtvState := s.getMtvState(shared_mtv.NoRelatedUserID)
-
-		s.True(mtvState.Playing)
-	}, checkPauseFailed)
+	}, updateDelegationOwner)
 
-	emitUpdateUserControlAndDelegationPermission := defaultDuration
+	checkItWorked := defaultDuration
 	registerDelayedCallbackWrapper(func() {
-		s.emitUpdateControlAndDelegationPermissionSignal(shared_mtv.NewUpdateControlAndDelegationPermissionSignalArgs{
+		mtvState := s.getMtvState(params.RoomCreatorUserID)
+		expectedCreator := &shared_mtv.InternalStateUser{
+			UserID:                            params.RoomCreatorUserID,
+			DeviceID:                          creatorDeviceID,
+			TracksVotedFor:                    []string{},
+			UserFitsPositionConstraint:        nil,
 			HasControlAndDelegationPermission: true,
-			ToUpdateUserID:                    joiningUserID,
-		})
-	}, emitUpdateUserControlAndDelegationPermission)
+		}
 
-	secondEmitPauseSignal := defaultDuration
+		s.Equal(expectedCreator, mtvState.UserRelatedInformation)
+		s.False(mtvState.Playing)
+		s.Equal(shared_mtv.MtvPlayingModeDirect, mtvState.PlayingMode)
+		s.Equal(&joiningUserID, mtvState.DelegationOwnerUserID)
+	}, checkItWorked)
+
+	delegationOwnerLeaveRoom := defaultDuration
 	registerDelayedCallbackWrapper(func() {
-		s.emitPauseSignal(shared_mtv.NewPauseSignalArgs{
-			UserID: joiningUserID,
-		})
-	}, secondEmitPauseSignal)
+		s.emitLeaveSignal(joiningUserID)
+	}, delegationOwnerLeaveRoom)
 
-	checkEmitPauseWorked := defaultDuration
+	checkDelegationOwnerReset := defaultDuration
 	registerDelayedCallbackWrapper(func() {
-		mtvState := s.getMtvState(joiningUserID)
+		mtvState := s.getMtvState(params.RoomCreatorUserID)
+		expectedCreator := &shared_mtv.InternalStateUser{
+			UserID:                            params.RoomCreatorUserID,
+			DeviceID:                          creatorDeviceID,
+			TracksVotedFor:                    []string{},
+			UserFitsPositionConstraint:        nil,
+			HasControlAndDelegationPermission: true,
+		}
 
-		s.True(mtvState.UserRelatedInformation.HasControlAndDelegationPermission)
+		s.Equal(expectedCreator, mtvState.UserRelatedInformation)
 		s.False(mtvState.Playing)
-	}, checkEmitPauseWorked)
+		s.Equal(shared_mtv.MtvPlayingModeDirect, mtvState.PlayingMode)
+		s.Equal(params.RoomCreatorUserID, *mtvState.DelegationOwnerUserID)
+	}, checkDelegationOwnerReset)
 
 	s.env.ExecuteWorkflow(MtvRoomWorkflow, params)
 
@@ -3851,7 +4199,7 @@ func (s *UnitTestSuite) Test_UserHasControlAndDelegationPermissionPause() {
 	s.ErrorIs(err, workflow.ErrDeadlineExceeded, "The workflow ran on an infinite loop")
 }
 
-func (s *UnitTestSuite) Test_UserHasControlAndDelegationPermissionGoToNextTrack() {
+func (s *UnitTestSuite) Test_CreateBroadcastRoomAndAttemptToExecuteDelegationOperation() {
 	var (
 		a *activities_mtv.Activities
 
@@ -3866,37 +4214,32 @@ func (s *UnitTestSuite) Test_UserHasControlAndDelegationPermissionGoToNextTrack(
 			ArtistName: faker.Name(),
 			Duration:   random.GenerateRandomDuration(),
 		},
-		{
-			ID:         faker.UUIDHyphenated(),
-			Title:      faker.Word(),
-			ArtistName: faker.Name(),
-			Duration:   random.GenerateRandomDuration(),
-		},
 	}
 
-	tracksIDs := []string{tracks[0].ID, tracks[1].ID}
+	tracksIDs := []string{tracks[0].ID}
 	params, creatorDeviceID := getWorkflowInitParams(tracksIDs, 1)
 	defaultDuration := 1 * time.Millisecond
 
+	params.PlayingMode = shared_mtv.MtvPlayingModeBroadcast
 	resetMock, registerDelayedCallbackWrapper := s.initTestEnv()
 
 	defer resetMock()
 
 	s.env.OnActivity(
-		activities.FetchTracksInformationActivity,
+		activities.FetchTracksInformationWithPolicyActivity,
 		mock.Anything,
-		tracksIDs,
-	).Return(tracks, nil).Once()
+		fetchTracksInformationArgs(tracksIDs),
+	).Return(activities.FetchedTracksInformationWithInitiator{Metadata: tracks}, nil).Once()
 	s.env.OnActivity(
 		a.CreationAcknowledgementActivity,
 		mock.Anything,
 		mock.Anything,
 	).Return(nil).Once()
 	s.env.OnActivity(
-		a.PlayActivity,
+		a.AcknowledgeUpdateDelegationOwner,
 		mock.Anything,
 		mock.Anything,
-	).Return(nil).Once()
+	).Return(nil).Never()
 
 	init := defaultDuration
 	registerDelayedCallbackWrapper(func() {
@@ -3905,11 +4248,15 @@ func (s *UnitTestSuite) Test_UserHasControlAndDelegationPermissionGoToNextTrack(
 		expectedCreator := &shared_mtv.InternalStateUser{
 			UserID:                            params.RoomCreatorUserID,
 			DeviceID:                          creatorDeviceID,
-			TracksVotedFor:                    []string{tracksIDs[1]},
+			TracksVotedFor:                    []string{},
 			UserFitsPositionConstraint:        nil,
 			HasControlAndDelegationPermission: true,
 		}
+
 		s.Equal(expectedCreator, mtvState.UserRelatedInformation)
+		s.False(mtvState.Playing)
+		s.Equal(shared_mtv.MtvPlayingModeBroadcast, mtvState.PlayingMode)
+		s.Nil(mtvState.DelegationOwnerUserID)
 	}, init)
 
 	emitJoinSignal := defaultDuration
@@ -3922,78 +4269,31 @@ func (s *UnitTestSuite) Test_UserHasControlAndDelegationPermissionGoToNextTrack(
 		s.emitJoinSignal(args)
 	}, emitJoinSignal)
 
-	checkJoinWorked := defaultDuration
+	updateDelegationOwner := defaultDuration
 	registerDelayedCallbackWrapper(func() {
-		mtvState := s.getMtvState(joiningUserID)
-
-		expectedJoiningUser := &shared_mtv.InternalStateUser{
-			UserID:                            joiningUserID,
-			DeviceID:                          joiningUserDeviceID,
-			TracksVotedFor:                    []string{},
-			UserFitsPositionConstraint:        nil,
-			HasControlAndDelegationPermission: false,
-		}
-		s.Equal(expectedJoiningUser, mtvState.UserRelatedInformation)
-	}, checkJoinWorked)
-
-	emitGoToNextTrackSignal := defaultDuration
-	registerDelayedCallbackWrapper(func() {
-		s.emitGoToNextTrackSignal(shared_mtv.NewGoToNextTrackSignalArgs{
-			UserID: joiningUserID,
+		s.emitUpdateDelegationOwnerSignal(shared_mtv.NewUpdateDelegationOwnerSignalArgs{
+			NewDelegationOwnerUserID: joiningUserID,
+			EmitterUserID:            params.RoomCreatorUserID,
 		})
-	}, emitGoToNextTrackSignal)
+	}, updateDelegationOwner)
 
-	checkGoToNextTrackFailed := defaultDuration
 	registerDelayedCallbackWrapper(func() {
-		mtvState := s.getMtvState(shared_mtv.NoRelatedUserID)
+		mtvState := s.getMtvState(params.RoomCreatorUserID)
 
-		expectedTracks := []shared_mtv.TrackMetadataWithScoreWithDuration{
-			{
-				TrackMetadataWithScore: shared_mtv.TrackMetadataWithScore{
-					TrackMetadata: shared.TrackMetadata{
-						ID:         tracks[1].ID,
-						Title:      tracks[1].Title,
-						ArtistName: tracks[1].ArtistName,
-						Duration:   0,
-					},
-					Score: 1,
-				},
-				Duration: tracks[1].Duration.Milliseconds(),
-			},
+		expectedCreator := &shared_mtv.InternalStateUser{
+			UserID:                            params.RoomCreatorUserID,
+			DeviceID:                          creatorDeviceID,
+			TracksVotedFor:                    []string{},
+			UserFitsPositionConstraint:        nil,
+			HasControlAndDelegationPermission: true,
 		}
 
+		s.Equal(2, mtvState.UsersLength)
+		s.Equal(expectedCreator, mtvState.UserRelatedInformation)
 		s.False(mtvState.Playing)
-		s.Equal(expectedTracks, mtvState.Tracks)
-		s.Equal(tracks[0].ID, mtvState.CurrentTrack.ID)
-	}, checkGoToNextTrackFailed)
-
-	emitUpdateUserControlAndDelegationPermission := defaultDuration
-	registerDelayedCallbackWrapper(func() {
-		s.emitUpdateControlAndDelegationPermissionSignal(shared_mtv.NewUpdateControlAndDelegationPermissionSignalArgs{
-			HasControlAndDelegationPermission: true,
-			ToUpdateUserID:                    joiningUserID,
-		})
-	}, emitUpdateUserControlAndDelegationPermission)
-
-	secondEmitGoToNextTrackSignal := defaultDuration
-	registerDelayedCallbackWrapper(func() {
-		s.emitGoToNextTrackSignal(shared_mtv.NewGoToNextTrackSignalArgs{
-			UserID: joiningUserID,
-		})
-	}, secondEmitGoToNextTrackSignal)
-
-	checkEmitGoToNextTrackWorked := defaultDuration
-	registerDelayedCallbackWrapper(func() {
-		mtvState := s.getMtvState(joiningUserID)
-
-		expectedTracks := []shared_mtv.TrackMetadataWithScoreWithDuration{}
-
-		s.True(mtvState.UserRelatedInformation.HasControlAndDelegationPermission)
-		s.Equal(expectedTracks, mtvState.Tracks)
-		s.Equal(tracks[1].ID, mtvState.CurrentTrack.ID)
-		s.True(mtvState.Playing)
-
-	}, checkEmitGoToNextTrackWorked)
+		s.Equal(shared_mtv.MtvPlayingModeBroadcast, mtvState.PlayingMode)
+		s.Nil(mtvState.DelegationOwnerUserID)
+	}, init)
 
 	s.env.ExecuteWorkflow(MtvRoomWorkflow, params)
 
@@ -4002,14 +4302,12 @@ func (s *UnitTestSuite) Test_UserHasControlAndDelegationPermissionGoToNextTrack(
 	s.ErrorIs(err, workflow.ErrDeadlineExceeded, "The workflow ran on an infinite loop")
 }
 
-func (s *UnitTestSuite) Test_OnlyInvitedUsersAndCreatorCanVoteInOpenRoom() {
+func (s *UnitTestSuite) Test_CanUpdateControlAndDelegationPermission() {
 	var (
 		a *activities_mtv.Activities
 
 		joiningUserID       = faker.UUIDHyphenated()
 		joiningUserDeviceID = faker.UUIDHyphenated()
-		invitedUserID       = faker.UUIDHyphenated()
-		invitedUserDeviceID = faker.UUIDHyphenated()
 	)
 
 	tracks := []shared.TrackMetadata{
@@ -4019,32 +4317,10 @@ func (s *UnitTestSuite) Test_OnlyInvitedUsersAndCreatorCanVoteInOpenRoom() {
 			ArtistName: faker.Name(),
 			Duration:   random.GenerateRandomDuration(),
 		},
-		{
-			ID:         faker.UUIDHyphenated(),
-			Title:      faker.Word(),
-			ArtistName: faker.Name(),
-			Duration:   random.GenerateRandomDuration(),
-		},
-	}
-
-	tracksToSuggest := []shared.TrackMetadata{
-		{
-			ID:         faker.UUIDHyphenated(),
-			Title:      faker.Word(),
-			ArtistName: faker.Name(),
-			Duration:   random.GenerateRandomDuration(),
-		},
-		{
-			ID:         faker.UUIDHyphenated(),
-			Title:      faker.Word(),
-			ArtistName: faker.Name(),
-			Duration:   random.GenerateRandomDuration(),
-		},
 	}
 
-	tracksIDs := []string{tracks[0].ID, tracks[1].ID}
+	tracksIDs := []string{tracks[0].ID}
 	params, creatorDeviceID := getWorkflowInitParams(tracksIDs, 1)
-	params.IsOpenOnlyInvitedUsersCanVote = true
 	defaultDuration := 1 * time.Millisecond
 
 	resetMock, registerDelayedCallbackWrapper := s.initTestEnv()
@@ -4052,33 +4328,37 @@ func (s *UnitTestSuite) Test_OnlyInvitedUsersAndCreatorCanVoteInOpenRoom() {
 	defer resetMock()
 
 	s.env.OnActivity(
-		activities.FetchTracksInformationActivity,
+		activities.FetchTracksInformationWithPolicyActivity,
 		mock.Anything,
-		tracksIDs,
-	).Return(tracks, nil).Once()
+		fetchTracksInformationArgs(tracksIDs),
+	).Return(activities.FetchedTracksInformationWithInitiator{Metadata: tracks}, nil).Once()
 	s.env.OnActivity(
 		a.CreationAcknowledgementActivity,
 		mock.Anything,
 		mock.Anything,
 	).Return(nil).Once()
+	s.env.OnActivity(
+		a.AcknowledgeUpdateControlAndDelegationPermission,
+		mock.Anything,
+		mock.Anything,
+	).Return(nil).Once()
 
 	init := defaultDuration
 	registerDelayedCallbackWrapper(func() {
 		mtvState := s.getMtvState(params.RoomCreatorUserID)
 
-		//TracksVotedFor with 1 element shows that creator even with UserHasBeenInvited to false can still vote
 		expectedCreator := &shared_mtv.InternalStateUser{
 			UserID:                            params.RoomCreatorUserID,
 			DeviceID:                          creatorDeviceID,
-			TracksVotedFor:                    []string{tracksIDs[1]},
+			TracksVotedFor:                    []string{},
 			UserFitsPositionConstraint:        nil,
 			HasControlAndDelegationPermission: true,
-			UserHasBeenInvited:                false,
 		}
 		s.Equal(expectedCreator, mtvState.UserRelatedInformation)
+		s.False(mtvState.Playing)
 	}, init)
 
-	emitJoinSignalForJoiningUser := defaultDuration
+	emitJoinSignal := defaultDuration
 	registerDelayedCallbackWrapper(func() {
 		args := shared_mtv.NewJoinSignalArgs{
 			DeviceID:           joiningUserDeviceID,
@@ -4086,245 +4366,250 @@ func (s *UnitTestSuite) Test_OnlyInvitedUsersAndCreatorCanVoteInOpenRoom() {
 			UserHasBeenInvited: false,
 		}
 		s.emitJoinSignal(args)
-	}, emitJoinSignalForJoiningUser)
-
-	emitJoinSignalForInvitedUser := defaultDuration
-	registerDelayedCallbackWrapper(func() {
-		args := shared_mtv.NewJoinSignalArgs{
-			DeviceID:           invitedUserDeviceID,
-			UserID:             invitedUserID,
-			UserHasBeenInvited: true,
-		}
-		s.emitJoinSignal(args)
-	}, emitJoinSignalForInvitedUser)
+	}, emitJoinSignal)
 
-	checkJoinWorked := defaultDuration
+	userHasBeenAddedAndHasNotControlAndDelegationPermissionByDefault := defaultDuration
 	registerDelayedCallbackWrapper(func() {
-		mtvState := s.getMtvState(invitedUserID)
+		mtvState := s.getMtvState(joiningUserID)
 
-		expectedJoiningUser := &shared_mtv.InternalStateUser{
-			UserID:                            invitedUserID,
-			DeviceID:                          invitedUserDeviceID,
-			TracksVotedFor:                    []string{},
-			UserFitsPositionConstraint:        nil,
-			HasControlAndDelegationPermission: false,
-			UserHasBeenInvited:                true,
-		}
-		s.Equal(expectedJoiningUser, mtvState.UserRelatedInformation)
-		s.Equal(3, mtvState.UsersLength)
-	}, checkJoinWorked)
+		s.Equal(2, mtvState.UsersLength)
+		s.Equal(joiningUserID, mtvState.UserRelatedInformation.UserID)
+		s.Equal(false, mtvState.UserRelatedInformation.HasControlAndDelegationPermission)
+	}, userHasBeenAddedAndHasNotControlAndDelegationPermissionByDefault)
 
-	//Emit vote for track with joiningUser
-	emitVoteForJoiningUser := defaultDuration
+	updateAddedUserControlAndDelegationPermission := defaultDuration
 	registerDelayedCallbackWrapper(func() {
-		args := shared_mtv.NewVoteForTrackSignalArgs{
-			TrackID: tracksIDs[1],
-			UserID:  joiningUserID,
-		}
-		s.emitVoteSignal(args)
-	}, emitVoteForJoiningUser)
+		s.emitUpdateControlAndDelegationPermissionSignal(shared_mtv.NewUpdateControlAndDelegationPermissionSignalArgs{
+			ToUpdateUserID:                    joiningUserID,
+			HasControlAndDelegationPermission: true,
+		})
+	}, updateAddedUserControlAndDelegationPermission)
 
-	checkDidntWorked := defaultDuration
+	addedUserHasControlAndDelegationPermission := defaultDuration
 	registerDelayedCallbackWrapper(func() {
 		mtvState := s.getMtvState(joiningUserID)
 
-		expectedTracks := []shared_mtv.TrackMetadataWithScoreWithDuration{
+		s.Equal(joiningUserID, mtvState.UserRelatedInformation.UserID)
+		s.Equal(true, mtvState.UserRelatedInformation.HasControlAndDelegationPermission)
+	}, addedUserHasControlAndDelegationPermission)
+
+	s.env.ExecuteWorkflow(MtvRoomWorkflow, params)
+
+	s.True(s.env.IsWorkflowCompleted())
+	err := s.env.GetWorkflowError()
+	s.ErrorIs(err, workflow.ErrDeadlineExceeded, "The workflow ran on an infinite loop")
+}
+
+func (s *UnitTestSuite) Test_GetUsersListQuery() {
+	var (
+		a *activities_mtv.Activities
+
+		joiningUserID       = faker.UUIDHyphenated()
+		joiningUserDeviceID = faker.UUIDHyphenated()
+	)
+
+	tracks := []shared.TrackMetadata{
+		{
+			ID:         faker.UUIDHyphenated(),
+			Title:      faker.Word(),
+			ArtistName: faker.Name(),
+			Duration:   random.GenerateRandomDuration(),
+		},
+	}
+
+	tracksIDs := []string{tracks[0].ID}
+	params, _ := getWorkflowInitParams(tracksIDs, 1)
+	defaultDuration := 1 * time.Millisecond
+
+	params.PlayingMode = shared_mtv.MtvPlayingModeBroadcast
+	resetMock, registerDelayedCallbackWrapper := s.initTestEnv()
+
+	defer resetMock()
+
+	s.env.OnActivity(
+		activities.FetchTracksInformationWithPolicyActivity,
+		mock.Anything,
+		fetchTracksInformationArgs(tracksIDs),
+	).Return(activities.FetchedTracksInformationWithInitiator{Metadata: tracks}, nil).Once()
+	s.env.OnActivity(
+		a.CreationAcknowledgementActivity,
+		mock.Anything,
+		mock.Anything,
+	).Return(nil).Once()
+
+	init := defaultDuration
+	registerDelayedCallbackWrapper(func() {
+		usersList := s.getUsersList()
+
+		expectedUsersList := []shared_mtv.ExposedInternalStateUserListElement{
 			{
-				TrackMetadataWithScore: shared_mtv.TrackMetadataWithScore{
-					TrackMetadata: shared.TrackMetadata{
-						ID:         tracks[1].ID,
-						Title:      tracks[1].Title,
-						ArtistName: tracks[1].ArtistName,
-						Duration:   0,
-					},
-					Score: 1,
-				},
-				Duration: tracks[1].Duration.Milliseconds(),
+				UserID:                            params.RoomCreatorUserID,
+				HasControlAndDelegationPermission: true,
+				IsCreator:                         true,
+				IsDelegationOwner:                 false,
 			},
 		}
-		s.Equal(expectedTracks, mtvState.Tracks)
 
-		expectedJoiningUser := &shared_mtv.InternalStateUser{
-			UserID:                            joiningUserID,
-			DeviceID:                          joiningUserDeviceID,
-			TracksVotedFor:                    []string{},
-			UserFitsPositionConstraint:        nil,
-			HasControlAndDelegationPermission: false,
-			UserHasBeenInvited:                false,
-		}
-		s.Equal(expectedJoiningUser, mtvState.UserRelatedInformation)
-	}, checkDidntWorked)
+		s.Equal(expectedUsersList, usersList)
+	}, init)
 
-	//Emit vote for track with invitedUser
-	emitVoteForInvitedUser := defaultDuration
+	emitJoinSignal := defaultDuration
 	registerDelayedCallbackWrapper(func() {
-		args := shared_mtv.NewVoteForTrackSignalArgs{
-			TrackID: tracksIDs[1],
-			UserID:  invitedUserID,
+		args := shared_mtv.NewJoinSignalArgs{
+			DeviceID:           joiningUserDeviceID,
+			UserID:             joiningUserID,
+			UserHasBeenInvited: false,
 		}
-		s.emitVoteSignal(args)
-	}, emitVoteForInvitedUser)
-
-	checkWorked := defaultDuration
+		s.emitJoinSignal(args)
+	}, emitJoinSignal)
+
+	checkJoinWorked := defaultDuration
 	registerDelayedCallbackWrapper(func() {
-		mtvState := s.getMtvState(invitedUserID)
+		usersList := s.getUsersList()
 
-		expectedTracks := []shared_mtv.TrackMetadataWithScoreWithDuration{
+		expectedUsersList := []shared_mtv.ExposedInternalStateUserListElement{
 			{
-				TrackMetadataWithScore: shared_mtv.TrackMetadataWithScore{
-					TrackMetadata: shared.TrackMetadata{
-						ID:         tracks[1].ID,
-						Title:      tracks[1].Title,
-						ArtistName: tracks[1].ArtistName,
-						Duration:   0,
-					},
-					Score: 2,
-				},
-				Duration: tracks[1].Duration.Milliseconds(),
+				UserID:                            params.RoomCreatorUserID,
+				HasControlAndDelegationPermission: true,
+				IsCreator:                         true,
+				IsDelegationOwner:                 false,
+			},
+			{
+				UserID:                            joiningUserID,
+				HasControlAndDelegationPermission: false,
+				IsCreator:                         false,
+				IsDelegationOwner:                 false,
 			},
 		}
-		s.Equal(expectedTracks, mtvState.Tracks)
 
-		expectedInvitedUser := &shared_mtv.InternalStateUser{
-			UserID:                            invitedUserID,
-			DeviceID:                          invitedUserDeviceID,
-			TracksVotedFor:                    []string{tracksIDs[1]},
-			UserFitsPositionConstraint:        nil,
-			HasControlAndDelegationPermission: false,
-			UserHasBeenInvited:                true,
-		}
-		s.Equal(expectedInvitedUser, mtvState.UserRelatedInformation)
-	}, checkWorked)
+		s.ElementsMatch(expectedUsersList, usersList)
+	}, checkJoinWorked)
 
-	//Suggest for joiningUser
-	suggestedTracks := []shared.TrackMetadata{
-		tracksToSuggest[0],
+	s.env.ExecuteWorkflow(MtvRoomWorkflow, params)
+
+	s.True(s.env.IsWorkflowCompleted())
+	err := s.env.GetWorkflowError()
+	s.ErrorIs(err, workflow.ErrDeadlineExceeded, "The workflow ran on an infinite loop")
+}
+
+func (s *UnitTestSuite) Test_GetUsersListQueryInDirectRoom() {
+	var (
+		a *activities_mtv.Activities
+
+		joiningUserID       = faker.UUIDHyphenated()
+		joiningUserDeviceID = faker.UUIDHyphenated()
+	)
+
+	tracks := []shared.TrackMetadata{
+		{
+			ID:         faker.UUIDHyphenated(),
+			Title:      faker.Word(),
+			ArtistName: faker.Name(),
+			Duration:   random.GenerateRandomDuration(),
+		},
 	}
-	s.mockOnceSuggest(joiningUserID, joiningUserDeviceID, params.RoomID, suggestedTracks)
-	emitSuggestForJoiningUser := defaultDuration
-	registerDelayedCallbackWrapper(func() {
-		s.emitSuggestTrackSignal(shared_mtv.SuggestTracksSignalArgs{
-			TracksToSuggest: []string{
-				tracksToSuggest[0].ID,
-			},
-			UserID:   joiningUserID,
-			DeviceID: joiningUserDeviceID,
-		})
-	}, emitSuggestForJoiningUser)
 
-	checkJoiningUserSuggestDidNotMakeHimVoteForSuggestedTrack := defaultDuration
+	tracksIDs := []string{tracks[0].ID}
+	params, _ := getWorkflowInitParams(tracksIDs, 1)
+	defaultDuration := 1 * time.Millisecond
+
+	params.PlayingMode = shared_mtv.MtvPlayingModeDirect
+	resetMock, registerDelayedCallbackWrapper := s.initTestEnv()
+
+	defer resetMock()
+
+	s.env.OnActivity(
+		activities.FetchTracksInformationWithPolicyActivity,
+		mock.Anything,
+		fetchTracksInformationArgs(tracksIDs),
+	).Return(activities.FetchedTracksInformationWithInitiator{Metadata: tracks}, nil).Once()
+	s.env.OnActivity(
+		a.CreationAcknowledgementActivity,
+		mock.Anything,
+		mock.Anything,
+	).Return(nil).Once()
+	s.env.OnActivity(
+		a.AcknowledgeUpdateDelegationOwner,
+		mock.Anything,
+		mock.Anything,
+	).Return(nil).Once()
+
+	init := defaultDuration
 	registerDelayedCallbackWrapper(func() {
-		mtvState := s.getMtvState(joiningUserID)
+		usersList := s.getUsersList()
 
-		expectedTracks := []shared_mtv.TrackMetadataWithScoreWithDuration{
-			{
-				TrackMetadataWithScore: shared_mtv.TrackMetadataWithScore{
-					TrackMetadata: shared.TrackMetadata{
-						ID:         tracks[1].ID,
-						Title:      tracks[1].Title,
-						ArtistName: tracks[1].ArtistName,
-						Duration:   0,
-					},
-					Score: 2,
-				},
-				Duration: tracks[1].Duration.Milliseconds(),
-			},
+		expectedUsersList := []shared_mtv.ExposedInternalStateUserListElement{
 			{
-				TrackMetadataWithScore: shared_mtv.TrackMetadataWithScore{
-					TrackMetadata: shared.TrackMetadata{
-						ID:         tracksToSuggest[0].ID,
-						Title:      tracksToSuggest[0].Title,
-						ArtistName: tracksToSuggest[0].ArtistName,
-						Duration:   0,
-					},
-					Score: 0,
-				},
-				Duration: tracksToSuggest[0].Duration.Milliseconds(),
+				UserID:                            params.RoomCreatorUserID,
+				HasControlAndDelegationPermission: true,
+				IsCreator:                         true,
+				IsDelegationOwner:                 true,
 			},
 		}
-		s.Equal(expectedTracks, mtvState.Tracks)
 
-		expectedJoiningUser := &shared_mtv.InternalStateUser{
-			UserID:                            joiningUserID,
-			DeviceID:                          joiningUserDeviceID,
-			TracksVotedFor:                    []string{},
-			UserFitsPositionConstraint:        nil,
-			HasControlAndDelegationPermission: false,
-			UserHasBeenInvited:                false,
+		s.Equal(expectedUsersList, usersList)
+	}, init)
+
+	emitJoinSignal := defaultDuration
+	registerDelayedCallbackWrapper(func() {
+		args := shared_mtv.NewJoinSignalArgs{
+			DeviceID:           joiningUserDeviceID,
+			UserID:             joiningUserID,
+			UserHasBeenInvited: false,
 		}
-		s.Equal(expectedJoiningUser, mtvState.UserRelatedInformation)
-	}, checkJoiningUserSuggestDidNotMakeHimVoteForSuggestedTrack)
+		s.emitJoinSignal(args)
+	}, emitJoinSignal)
 
-	//Suggest for invitedUser
-	invitedUserSuggestedTracks := []shared.TrackMetadata{
-		tracksToSuggest[1],
-	}
-	s.mockOnceSuggest(invitedUserID, invitedUserDeviceID, params.RoomID, invitedUserSuggestedTracks)
-	emitSuggestForInvitedUser := defaultDuration
+	updateDelegationOwner := defaultDuration
 	registerDelayedCallbackWrapper(func() {
-		s.emitSuggestTrackSignal(shared_mtv.SuggestTracksSignalArgs{
-			TracksToSuggest: []string{
-				tracksToSuggest[1].ID,
-			},
-			UserID:   invitedUserID,
-			DeviceID: invitedUserDeviceID,
+		s.emitUpdateDelegationOwnerSignal(shared_mtv.NewUpdateDelegationOwnerSignalArgs{
+			NewDelegationOwnerUserID: joiningUserID,
+			EmitterUserID:            params.RoomCreatorUserID,
 		})
-	}, emitSuggestForInvitedUser)
+	}, updateDelegationOwner)
 
-	checkInvitedUserSuggestDidMakeHimVoteForSuggestedTrack := defaultDuration
+	checkOperationsWorked := defaultDuration
 	registerDelayedCallbackWrapper(func() {
-		mtvState := s.getMtvState(invitedUserID)
+		usersList := s.getUsersList()
 
-		expectedTracks := []shared_mtv.TrackMetadataWithScoreWithDuration{
+		expectedUsersList := []shared_mtv.ExposedInternalStateUserListElement{
 			{
-				TrackMetadataWithScore: shared_mtv.TrackMetadataWithScore{
-					TrackMetadata: shared.TrackMetadata{
-						ID:         tracks[1].ID,
-						Title:      tracks[1].Title,
-						ArtistName: tracks[1].ArtistName,
-						Duration:   0,
-					},
-					Score: 2,
-				},
-				Duration: tracks[1].Duration.Milliseconds(),
+				UserID:                            params.RoomCreatorUserID,
+				HasControlAndDelegationPermission: true,
+				IsCreator:                         true,
+				IsDelegationOwner:                 false,
 			},
 			{
-				TrackMetadataWithScore: shared_mtv.TrackMetadataWithScore{
-					TrackMetadata: shared.TrackMetadata{
-						ID:         tracksToSuggest[1].ID,
-						Title:      tracksToSuggest[1].Title,
-						ArtistName: tracksToSuggest[1].ArtistName,
-						Duration:   0,
-					},
-					Score: 1,
-				},
-				Duration: tracksToSuggest[1].Duration.Milliseconds(),
+				UserID:                            joiningUserID,
+				HasControlAndDelegationPermission: false,
+				IsCreator:                         false,
+				IsDelegationOwner:                 true,
 			},
+		}
+
+		s.ElementsMatch(expectedUsersList, usersList)
+	}, checkOperationsWorked)
+
+	emitLeave := defaultDuration
+	registerDelayedCallbackWrapper(func() {
+		s.emitLeaveSignal(joiningUserID)
+	}, emitLeave)
+
+	checkDelegationOwnerResetAndUserListUpdate := defaultDuration
+	registerDelayedCallbackWrapper(func() {
+		usersList := s.getUsersList()
+
+		expectedUsersList := []shared_mtv.ExposedInternalStateUserListElement{
 			{
-				TrackMetadataWithScore: shared_mtv.TrackMetadataWithScore{
-					TrackMetadata: shared.TrackMetadata{
-						ID:         tracksToSuggest[0].ID,
-						Title:      tracksToSuggest[0].Title,
-						ArtistName: tracksToSuggest[0].ArtistName,
-						Duration:   0,
-					},
-					Score: 0,
-				},
-				Duration: tracksToSuggest[0].Duration.Milliseconds(),
+				UserID:                            params.RoomCreatorUserID,
+				HasControlAndDelegationPermission: true,
+				IsCreator:                         true,
+				IsDelegationOwner:                 true,
 			},
 		}
-		s.Equal(expectedTracks, mtvState.Tracks)
 
-		expectedInvitedUser := &shared_mtv.InternalStateUser{
-			UserID:                            invitedUserID,
-			DeviceID:                          invitedUserDeviceID,
-			TracksVotedFor:                    []string{tracks[1].ID, tracksToSuggest[1].ID},
-			UserFitsPositionConstraint:        nil,
-			HasControlAndDelegationPermission: false,
-			UserHasBeenInvited:                true,
-		}
-		s.Equal(expectedInvitedUser, mtvState.UserRelatedInformation)
-	}, checkInvitedUserSuggestDidMakeHimVoteForSuggestedTrack)
+		s.Equal(expectedUsersList, usersList)
+	}, checkDelegationOwnerResetAndUserListUpdate)
 
 	s.env.ExecuteWorkflow(MtvRoomWorkflow, params)
 
@@ -4333,7 +4618,7 @@ func (s *UnitTestSuite) Test_OnlyInvitedUsersAndCreatorCanVoteInOpenRoom() {
 	s.ErrorIs(err, workflow.ErrDeadlineExceeded, "The workflow ran on an infinite loop")
 }
 
-func (s *UnitTestSuite) Test_SuggestOrVoteSentActivityBaseOnCurrentTrack() {
+func (s *UnitTestSuite) Test_UserHasControlAndDelegationPermissionPlay() {
 	var (
 		a *activities_mtv.Activities
 
@@ -4350,117 +4635,2397 @@ func (s *UnitTestSuite) Test_SuggestOrVoteSentActivityBaseOnCurrentTrack() {
 		},
 	}
 
-	tracksIDs := []string{tracks[0].ID}
-	params, creatorDeviceID := getWorkflowInitParams(tracksIDs, 2)
+	tracksIDs := []string{tracks[0].ID}
+	params, creatorDeviceID := getWorkflowInitParams(tracksIDs, 1)
+	defaultDuration := 1 * time.Millisecond
+
+	params.PlayingMode = shared_mtv.MtvPlayingModeDirect
+	resetMock, registerDelayedCallbackWrapper := s.initTestEnv()
+
+	defer resetMock()
+
+	s.env.OnActivity(
+		activities.FetchTracksInformationWithPolicyActivity,
+		mock.Anything,
+		fetchTracksInformationArgs(tracksIDs),
+	).Return(activities.FetchedTracksInformationWithInitiator{Metadata: tracks}, nil).Once()
+	s.env.OnActivity(
+		a.CreationAcknowledgementActivity,
+		mock.Anything,
+		mock.Anything,
+	).Return(nil).Once()
+	s.env.OnActivity(
+		a.PlayActivity,
+		mock.Anything,
+		mock.Anything,
+	).Return(nil).Once()
+
+	init := defaultDuration
+	registerDelayedCallbackWrapper(func() {
+		mtvState := s.getMtvState(params.RoomCreatorUserID)
+
+		expectedCreator := &shared_mtv.InternalStateUser{
+			UserID:                            params.RoomCreatorUserID,
+			DeviceID:                          creatorDeviceID,
+			TracksVotedFor:                    []string{},
+			UserFitsPositionConstraint:        nil,
+			HasControlAndDelegationPermission: true,
+		}
+		s.Equal(expectedCreator, mtvState.UserRelatedInformation)
+	}, init)
+
+	emitJoinSignal := defaultDuration
+	registerDelayedCallbackWrapper(func() {
+		args := shared_mtv.NewJoinSignalArgs{
+			DeviceID:           joiningUserDeviceID,
+			UserID:             joiningUserID,
+			UserHasBeenInvited: false,
+		}
+		s.emitJoinSignal(args)
+	}, emitJoinSignal)
+
+	checkJoinWorked := defaultDuration
+	registerDelayedCallbackWrapper(func() {
+		mtvState := s.getMtvState(joiningUserID)
+
+		expectedJoiningUser := &shared_mtv.InternalStateUser{
+			UserID:                            joiningUserID,
+			DeviceID:                          joiningUserDeviceID,
+			TracksVotedFor:                    []string{},
+			UserFitsPositionConstraint:        nil,
+			HasControlAndDelegationPermission: false,
+		}
+		s.Equal(expectedJoiningUser, mtvState.UserRelatedInformation)
+	}, checkJoinWorked)
+
+	emitPlaySignal := defaultDuration
+	registerDelayedCallbackWrapper(func() {
+		s.emitPlaySignal(shared_mtv.NewPlaySignalArgs{
+			UserID: joiningUserID,
+		})
+	}, emitPlaySignal)
+
+	checkPlayFailed := defaultDuration
+	registerDelayedCallbackWrapper(func() {
+		mtvState := s.getMtvState(joiningUserID)
+
+		s.False(mtvState.Playing)
+	}, checkPlayFailed)
+
+	emitUpdateUserControlAndDelegationPermission := defaultDuration
+	registerDelayedCallbackWrapper(func() {
+		s.emitUpdateControlAndDelegationPermissionSignal(shared_mtv.NewUpdateControlAndDelegationPermissionSignalArgs{
+			HasControlAndDelegationPermission: true,
+			ToUpdateUserID:                    joiningUserID,
+		})
+	}, emitUpdateUserControlAndDelegationPermission)
+
+	secondEmitPlaySignal := defaultDuration
+	registerDelayedCallbackWrapper(func() {
+		s.emitPlaySignal(shared_mtv.NewPlaySignalArgs{
+			UserID: joiningUserID,
+		})
+	}, secondEmitPlaySignal)
+
+	checkEmitPlayWorked := defaultDuration
+	registerDelayedCallbackWrapper(func() {
+		mtvState := s.getMtvState(joiningUserID)
+
+		s.True(mtvState.UserRelatedInformation.HasControlAndDelegationPermission)
+		s.True(mtvState.Playing)
+	}, checkEmitPlayWorked)
+
+	s.env.ExecuteWorkflow(MtvRoomWorkflow, params)
+
+	s.True(s.env.IsWorkflowCompleted())
+	err := s.env.GetWorkflowError()
+	s.ErrorIs(err, workflow.ErrDeadlineExceeded, "The workflow ran on an infinite loop")
+}
+
+func (s *UnitTestSuite) Test_UserHasControlAndDelegationPermissionPause() {
+	var (
+		a *activities_mtv.Activities
+
+		joiningUserID       = faker.UUIDHyphenated()
+		joiningUserDeviceID = faker.UUIDHyphenated()
+	)
+
+	tracks := []shared.TrackMetadata{
+		{
+			ID:         faker.UUIDHyphenated(),
+			Title:      faker.Word(),
+			ArtistName: faker.Name(),
+			Duration:   random.GenerateRandomDuration(),
+		},
+	}
+
+	tracksIDs := []string{tracks[0].ID}
+	params, creatorDeviceID := getWorkflowInitParams(tracksIDs, 1)
+	defaultDuration := 1 * time.Millisecond
+
+	params.PlayingMode = shared_mtv.MtvPlayingModeDirect
+	resetMock, registerDelayedCallbackWrapper := s.initTestEnv()
+
+	defer resetMock()
+
+	s.env.OnActivity(
+		activities.FetchTracksInformationWithPolicyActivity,
+		mock.Anything,
+		fetchTracksInformationArgs(tracksIDs),
+	).Return(activities.FetchedTracksInformationWithInitiator{Metadata: tracks}, nil).Once()
+	s.env.OnActivity(
+		a.CreationAcknowledgementActivity,
+		mock.Anything,
+		mock.Anything,
+	).Return(nil).Once()
+	s.env.OnActivity(
+		a.PlayActivity,
+		mock.Anything,
+		mock.Anything,
+	).Return(nil).Once()
+	//First call after initial tracks fetch
+	//Second call after Custom emit pause signal
+	s.env.OnActivity(
+		a.PauseActivity,
+		mock.Anything,
+		mock.Anything,
+	).Return(nil).Times(2)
+
+	init := defaultDuration
+	registerDelayedCallbackWrapper(func() {
+		mtvState := s.getMtvState(params.RoomCreatorUserID)
+
+		expectedCreator := &shared_mtv.InternalStateUser{
+			UserID:                            params.RoomCreatorUserID,
+			DeviceID:                          creatorDeviceID,
+			TracksVotedFor:                    []string{},
+			UserFitsPositionConstraint:        nil,
+			HasControlAndDelegationPermission: true,
+		}
+		s.Equal(expectedCreator, mtvState.UserRelatedInformation)
+	}, init)
+
+	emitJoinSignal := defaultDuration
+	registerDelayedCallbackWrapper(func() {
+		args := shared_mtv.NewJoinSignalArgs{
+			DeviceID:           joiningUserDeviceID,
+			UserID:             joiningUserID,
+			UserHasBeenInvited: false,
+		}
+		s.emitJoinSignal(args)
+	}, emitJoinSignal)
+
+	checkJoinWorked := defaultDuration
+	registerDelayedCallbackWrapper(func() {
+		mtvState := s.getMtvState(joiningUserID)
+
+		expectedJoiningUser := &shared_mtv.InternalStateUser{
+			UserID:                            joiningUserID,
+			DeviceID:                          joiningUserDeviceID,
+			TracksVotedFor:                    []string{},
+			UserFitsPositionConstraint:        nil,
+			HasControlAndDelegationPermission: false,
+		}
+		s.Equal(expectedJoiningUser, mtvState.UserRelatedInformation)
+	}, checkJoinWorked)
+
+	emitPlaySignal := defaultDuration
+	registerDelayedCallbackWrapper(func() {
+		s.emitPlaySignal(shared_mtv.NewPlaySignalArgs{
+			UserID: params.RoomCreatorUserID,
+		})
+	}, emitPlaySignal)
+
+	checkPlayWorked := defaultDuration
+	registerDelayedCallbackWrapper(func() {
+		mtvState := s.getMtvState(shared_mtv.NoRelatedUserID)
+
+		s.True(mtvState.Playing)
+	}, checkPlayWorked)
+
+	emitPauseSignal := defaultDuration
+	registerDelayedCallbackWrapper(func() {
+		s.emitPlaySignal(shared_mtv.NewPlaySignalArgs{
+			UserID: joiningUserID,
+		})
+	}, emitPauseSignal)
+
+	checkPauseFailed := defaultDuration
+	registerDelayedCallbackWrapper(func() {
+		mtvState := s.getMtvState(shared_mtv.NoRelatedUserID)
+
+		s.True(mtvState.Playing)
+	}, checkPauseFailed)
+
+	emitUpdateUserControlAndDelegationPermission := defaultDuration
+	registerDelayedCallbackWrapper(func() {
+		s.emitUpdateControlAndDelegationPermissionSignal(shared_mtv.NewUpdateControlAndDelegationPermissionSignalArgs{
+			HasControlAndDelegationPermission: true,
+			ToUpdateUserID:                    joiningUserID,
+		})
+	}, emitUpdateUserControlAndDelegationPermission)
+
+	secondEmitPauseSignal := defaultDuration
+	registerDelayedCallbackWrapper(func() {
+		s.emitPauseSignal(shared_mtv.NewPauseSignalArgs{
+			UserID: joiningUserID,
+		})
+	}, secondEmitPauseSignal)
+
+	checkEmitPauseWorked := defaultDuration
+	registerDelayedCallbackWrapper(func() {
+		mtvState := s.getMtvState(joiningUserID)
+
+		s.True(mtvState.UserRelatedInformation.HasControlAndDelegationPermission)
+		s.False(mtvState.Playing)
+	}, checkEmitPauseWorked)
+
+	s.env.ExecuteWorkflow(MtvRoomWorkflow, params)
+
+	s.True(s.env.IsWorkflowCompleted())
+	err := s.env.GetWorkflowError()
+	s.ErrorIs(err, workflow.ErrDeadlineExceeded, "The workflow ran on an infinite loop")
+}
+
+func (s *UnitTestSuite) Test_UserHasControlAndDelegationPermissionGoToNextTrack() {
+	var (
+		a *activities_mtv.Activities
+
+		joiningUserID       = faker.UUIDHyphenated()
+		joiningUserDeviceID = faker.UUIDHyphenated()
+	)
+
+	tracks := []shared.TrackMetadata{
+		{
+			ID:         faker.UUIDHyphenated(),
+			Title:      faker.Word(),
+			ArtistName: faker.Name(),
+			Duration:   random.GenerateRandomDuration(),
+		},
+		{
+			ID:         faker.UUIDHyphenated(),
+			Title:      faker.Word(),
+			ArtistName: faker.Name(),
+			Duration:   random.GenerateRandomDuration(),
+		},
+	}
+
+	tracksIDs := []string{tracks[0].ID, tracks[1].ID}
+	params, creatorDeviceID := getWorkflowInitParams(tracksIDs, 1)
+	defaultDuration := 1 * time.Millisecond
+
+	resetMock, registerDelayedCallbackWrapper := s.initTestEnv()
+
+	defer resetMock()
+
+	s.env.OnActivity(
+		activities.FetchTracksInformationWithPolicyActivity,
+		mock.Anything,
+		fetchTracksInformationArgs(tracksIDs),
+	).Return(activities.FetchedTracksInformationWithInitiator{Metadata: tracks}, nil).Once()
+	s.env.OnActivity(
+		a.CreationAcknowledgementActivity,
+		mock.Anything,
+		mock.Anything,
+	).Return(nil).Once()
+	s.env.OnActivity(
+		a.PlayActivity,
+		mock.Anything,
+		mock.Anything,
+	).Return(nil).Once()
+
+	init := defaultDuration
+	registerDelayedCallbackWrapper(func() {
+		mtvState := s.getMtvState(params.RoomCreatorUserID)
+
+		expectedCreator := &shared_mtv.InternalStateUser{
+			UserID:                            params.RoomCreatorUserID,
+			DeviceID:                          creatorDeviceID,
+			TracksVotedFor:                    []string{tracksIDs[1]},
+			UserFitsPositionConstraint:        nil,
+			HasControlAndDelegationPermission: true,
+		}
+		s.Equal(expectedCreator, mtvState.UserRelatedInformation)
+	}, init)
+
+	emitJoinSignal := defaultDuration
+	registerDelayedCallbackWrapper(func() {
+		args := shared_mtv.NewJoinSignalArgs{
+			DeviceID:           joiningUserDeviceID,
+			UserID:             joiningUserID,
+			UserHasBeenInvited: false,
+		}
+		s.emitJoinSignal(args)
+	}, emitJoinSignal)
+
+	checkJoinWorked := defaultDuration
+	registerDelayedCallbackWrapper(func() {
+		mtvState := s.getMtvState(joiningUserID)
+
+		expectedJoiningUser := &shared_mtv.InternalStateUser{
+			UserID:                            joiningUserID,
+			DeviceID:                          joiningUserDeviceID,
+			TracksVotedFor:                    []string{},
+			UserFitsPositionConstraint:        nil,
+			HasControlAndDelegationPermission: false,
+		}
+		s.Equal(expectedJoiningUser, mtvState.UserRelatedInformation)
+	}, checkJoinWorked)
+
+	emitGoToNextTrackSignal := defaultDuration
+	registerDelayedCallbackWrapper(func() {
+		s.emitGoToNextTrackSignal(shared_mtv.NewGoToNextTrackSignalArgs{
+			UserID: joiningUserID,
+		})
+	}, emitGoToNextTrackSignal)
+
+	checkGoToNextTrackFailed := defaultDuration
+	registerDelayedCallbackWrapper(func() {
+		mtvState := s.getMtvState(shared_mtv.NoRelatedUserID)
+
+		expectedTracks := []shared_mtv.TrackMetadataWithScoreWithDuration{
+			{
+				TrackMetadataWithScore: shared_mtv.TrackMetadataWithScore{
+					TrackMetadata: shared.TrackMetadata{
+						ID:         tracks[1].ID,
+						Title:      tracks[1].Title,
+						ArtistName: tracks[1].ArtistName,
+						Duration:   0,
+					},
+					Score: 1,
+				},
+				Duration: tracks[1].Duration.Milliseconds(),
+			},
+		}
+
+		s.False(mtvState.Playing)
+		s.Equal(expectedTracks, mtvState.Tracks)
+		s.Equal(tracks[0].ID, mtvState.CurrentTrack.ID)
+	}, checkGoToNextTrackFailed)
+
+	emitUpdateUserControlAndDelegationPermission := defaultDuration
+	registerDelayedCallbackWrapper(func() {
+		s.emitUpdateControlAndDelegationPermissionSignal(shared_mtv.NewUpdateControlAndDelegationPermissionSignalArgs{
+			HasControlAndDelegationPermission: true,
+			ToUpdateUserID:                    joiningUserID,
+		})
+	}, emitUpdateUserControlAndDelegationPermission)
+
+	secondEmitGoToNextTrackSignal := defaultDuration
+	registerDelayedCallbackWrapper(func() {
+		s.emitGoToNextTrackSignal(shared_mtv.NewGoToNextTrackSignalArgs{
+			UserID: joiningUserID,
+		})
+	}, secondEmitGoToNextTrackSignal)
+
+	checkEmitGoToNextTrackWorked := defaultDuration
+	registerDelayedCallbackWrapper(func() {
+		mtvState := s.getMtvState(joiningUserID)
+
+		expectedTracks := []shared_mtv.TrackMetadataWithScoreWithDuration{}
+
+		s.True(mtvState.UserRelatedInformation.HasControlAndDelegationPermission)
+		s.Equal(expectedTracks, mtvState.Tracks)
+		s.Equal(tracks[1].ID, mtvState.CurrentTrack.ID)
+		s.True(mtvState.Playing)
+
+	}, checkEmitGoToNextTrackWorked)
+
+	s.env.ExecuteWorkflow(MtvRoomWorkflow, params)
+
+	s.True(s.env.IsWorkflowCompleted())
+	err := s.env.GetWorkflowError()
+	s.ErrorIs(err, workflow.ErrDeadlineExceeded, "The workflow ran on an infinite loop")
+}
+
+func (s *UnitTestSuite) Test_OnlyInvitedUsersAndCreatorCanVoteInOpenRoom() {
+	var (
+		a *activities_mtv.Activities
+
+		joiningUserID       = faker.UUIDHyphenated()
+		joiningUserDeviceID = faker.UUIDHyphenated()
+		invitedUserID       = faker.UUIDHyphenated()
+		invitedUserDeviceID = faker.UUIDHyphenated()
+	)
+
+	tracks := []shared.TrackMetadata{
+		{
+			ID:         faker.UUIDHyphenated(),
+			Title:      faker.Word(),
+			ArtistName: faker.Name(),
+			Duration:   random.GenerateRandomDuration(),
+		},
+		{
+			ID:         faker.UUIDHyphenated(),
+			Title:      faker.Word(),
+			ArtistName: faker.Name(),
+			Duration:   random.GenerateRandomDuration(),
+		},
+	}
+
+	tracksToSuggest := []shared.TrackMetadata{
+		{
+			ID:         faker.UUIDHyphenated(),
+			Title:      faker.Word(),
+			ArtistName: faker.Name(),
+			Duration:   random.GenerateRandomDuration(),
+		},
+		{
+			ID:         faker.UUIDHyphenated(),
+			Title:      faker.Word(),
+			ArtistName: faker.Name(),
+			Duration:   random.GenerateRandomDuration(),
+		},
+	}
+
+	tracksIDs := []string{tracks[0].ID, tracks[1].ID}
+	params, creatorDeviceID := getWorkflowInitParams(tracksIDs, 1)
+	params.IsOpenOnlyInvitedUsersCanVote = true
+	defaultDuration := 1 * time.Millisecond
+
+	resetMock, registerDelayedCallbackWrapper := s.initTestEnv()
+
+	defer resetMock()
+
+	s.env.OnActivity(
+		activities.FetchTracksInformationWithPolicyActivity,
+		mock.Anything,
+		fetchTracksInformationArgs(tracksIDs),
+	).Return(activities.FetchedTracksInformationWithInitiator{Metadata: tracks}, nil).Once()
+	s.env.OnActivity(
+		a.CreationAcknowledgementActivity,
+		mock.Anything,
+		mock.Anything,
+	).Return(nil).Once()
+
+	init := defaultDuration
+	registerDelayedCallbackWrapper(func() {
+		mtvState := s.getMtvState(params.RoomCreatorUserID)
+
+		//TracksVotedFor with 1 element shows that creator even with UserHasBeenInvited to false can still vote
+		expectedCreator := &shared_mtv.InternalStateUser{
+			UserID:                            params.RoomCreatorUserID,
+			DeviceID:                          creatorDeviceID,
+			TracksVotedFor:                    []string{tracksIDs[1]},
+			UserFitsPositionConstraint:        nil,
+			HasControlAndDelegationPermission: true,
+			UserHasBeenInvited:                false,
+		}
+		s.Equal(expectedCreator, mtvState.UserRelatedInformation)
+	}, init)
+
+	emitJoinSignalForJoiningUser := defaultDuration
+	registerDelayedCallbackWrapper(func() {
+		args := shared_mtv.NewJoinSignalArgs{
+			DeviceID:           joiningUserDeviceID,
+			UserID:             joiningUserID,
+			UserHasBeenInvited: false,
+		}
+		s.emitJoinSignal(args)
+	}, emitJoinSignalForJoiningUser)
+
+	emitJoinSignalForInvitedUser := defaultDuration
+	registerDelayedCallbackWrapper(func() {
+		args := shared_mtv.NewJoinSignalArgs{
+			DeviceID:           invitedUserDeviceID,
+			UserID:             invitedUserID,
+			UserHasBeenInvited: true,
+		}
+		s.emitJoinSignal(args)
+	}, emitJoinSignalForInvitedUser)
+
+	checkJoinWorked := defaultDuration
+	registerDelayedCallbackWrapper(func() {
+		mtvState := s.getMtvState(invitedUserID)
+
+		expectedJoiningUser := &shared_mtv.InternalStateUser{
+			UserID:                            invitedUserID,
+			DeviceID:                          invitedUserDeviceID,
+			TracksVotedFor:                    []string{},
+			UserFitsPositionConstraint:        nil,
+			HasControlAndDelegationPermission: false,
+			UserHasBeenInvited:                true,
+		}
+		s.Equal(expectedJoiningUser, mtvState.UserRelatedInformation)
+		s.Equal(3, mtvState.UsersLength)
+	}, checkJoinWorked)
+
+	//Emit vote for track with joiningUser
+	emitVoteForJoiningUser := defaultDuration
+	registerDelayedCallbackWrapper(func() {
+		args := shared_mtv.NewVoteForTrackSignalArgs{
+			TrackID: tracksIDs[1],
+			UserID:  joiningUserID,
+		}
+		s.emitVoteSignal(args)
+	}, emitVoteForJoiningUser)
+
+	checkDidntWorked := defaultDuration
+	registerDelayedCallbackWrapper(func() {
+		mtvState := s.getMtvState(joiningUserID)
+
+		expectedTracks := []shared_mtv.TrackMetadataWithScoreWithDuration{
+			{
+				TrackMetadataWithScore: shared_mtv.TrackMetadataWithScore{
+					TrackMetadata: shared.TrackMetadata{
+						ID:         tracks[1].ID,
+						Title:      tracks[1].Title,
+						ArtistName: tracks[1].ArtistName,
+						Duration:   0,
+					},
+					Score: 1,
+				},
+				Duration: tracks[1].Duration.Milliseconds(),
+			},
+		}
+		s.Equal(expectedTracks, mtvState.Tracks)
+
+		expectedJoiningUser := &shared_mtv.InternalStateUser{
+			UserID:                            joiningUserID,
+			DeviceID:                          joiningUserDeviceID,
+			TracksVotedFor:                    []string{},
+			UserFitsPositionConstraint:        nil,
+			HasControlAndDelegationPermission: false,
+			UserHasBeenInvited:                false,
+		}
+		s.Equal(expectedJoiningUser, mtvState.UserRelatedInformation)
+	}, checkDidntWorked)
+
+	//Emit vote for track with invitedUser
+	emitVoteForInvitedUser := defaultDuration
+	registerDelayedCallbackWrapper(func() {
+		args := shared_mtv.NewVoteForTrackSignalArgs{
+			TrackID: tracksIDs[1],
+			UserID:  invitedUserID,
+		}
+		s.emitVoteSignal(args)
+	}, emitVoteForInvitedUser)
+
+	checkWorked := defaultDuration
+	registerDelayedCallbackWrapper(func() {
+		mtvState := s.getMtvState(invitedUserID)
+
+		expectedTracks := []shared_mtv.TrackMetadataWithScoreWithDuration{
+			{
+				TrackMetadataWithScore: shared_mtv.TrackMetadataWithScore{
+					TrackMetadata: shared.TrackMetadata{
+						ID:         tracks[1].ID,
+						Title:      tracks[1].Title,
+						ArtistName: tracks[1].ArtistName,
+						Duration:   0,
+					},
+					Score: 2,
+				},
+				Duration: tracks[1].Duration.Milliseconds(),
+			},
+		}
+		s.Equal(expectedTracks, mtvState.Tracks)
+
+		expectedInvitedUser := &shared_mtv.InternalStateUser{
+			UserID:                            invitedUserID,
+			DeviceID:                          invitedUserDeviceID,
+			TracksVotedFor:                    []string{tracksIDs[1]},
+			UserFitsPositionConstraint:        nil,
+			HasControlAndDelegationPermission: false,
+			UserHasBeenInvited:                true,
+		}
+		s.Equal(expectedInvitedUser, mtvState.UserRelatedInformation)
+	}, checkWorked)
+
+	//Suggest for joiningUser
+	suggestedTracks := []shared.TrackMetadata{
+		tracksToSuggest[0],
+	}
+	s.mockOnceSuggest(joiningUserID, joiningUserDeviceID, params.RoomID, suggestedTracks)
+	emitSuggestForJoiningUser := defaultDuration
+	registerDelayedCallbackWrapper(func() {
+		s.emitSuggestTrackSignal(shared_mtv.SuggestTracksSignalArgs{
+			TracksToSuggest: []string{
+				tracksToSuggest[0].ID,
+			},
+			UserID:   joiningUserID,
+			DeviceID: joiningUserDeviceID,
+		})
+	}, emitSuggestForJoiningUser)
+
+	checkJoiningUserSuggestDidNotMakeHimVoteForSuggestedTrack := defaultDuration
+	registerDelayedCallbackWrapper(func() {
+		mtvState := s.getMtvState(joiningUserID)
+
+		expectedTracks := []shared_mtv.TrackMetadataWithScoreWithDuration{
+			{
+				TrackMetadataWithScore: shared_mtv.TrackMetadataWithScore{
+					TrackMetadata: shared.TrackMetadata{
+						ID:         tracks[1].ID,
+						Title:      tracks[1].Title,
+						ArtistName: tracks[1].ArtistName,
+						Duration:   0,
+					},
+					Score: 2,
+				},
+				Duration: tracks[1].Duration.Milliseconds(),
+			},
+			{
+				TrackMetadataWithScore: shared_mtv.TrackMetadataWithScore{
+					TrackMetadata: shared.TrackMetadata{
+						ID:         tracksToSuggest[0].ID,
+						Title:      tracksToSuggest[0].Title,
+						ArtistName: tracksToSuggest[0].ArtistName,
+						Duration:   0,
+					},
+					Score: 0,
+				},
+				Duration: tracksToSuggest[0].Duration.Milliseconds(),
+			},
+		}
+		s.Equal(expectedTracks, mtvState.Tracks)
+
+		expectedJoiningUser := &shared_mtv.InternalStateUser{
+			UserID:                            joiningUserID,
+			DeviceID:                          joiningUserDeviceID,
+			TracksVotedFor:                    []string{},
+			UserFitsPositionConstraint:        nil,
+			HasControlAndDelegationPermission: false,
+			UserHasBeenInvited:                false,
+		}
+		s.Equal(expectedJoiningUser, mtvState.UserRelatedInformation)
+	}, checkJoiningUserSuggestDidNotMakeHimVoteForSuggestedTrack)
+
+	//Suggest for invitedUser
+	invitedUserSuggestedTracks := []shared.TrackMetadata{
+		tracksToSuggest[1],
+	}
+	s.mockOnceSuggest(invitedUserID, invitedUserDeviceID, params.RoomID, invitedUserSuggestedTracks)
+	emitSuggestForInvitedUser := defaultDuration
+	registerDelayedCallbackWrapper(func() {
+		s.emitSuggestTrackSignal(shared_mtv.SuggestTracksSignalArgs{
+			TracksToSuggest: []string{
+				tracksToSuggest[1].ID,
+			},
+			UserID:   invitedUserID,
+			DeviceID: invitedUserDeviceID,
+		})
+	}, emitSuggestForInvitedUser)
+
+	checkInvitedUserSuggestDidMakeHimVoteForSuggestedTrack := defaultDuration
+	registerDelayedCallbackWrapper(func() {
+		mtvState := s.getMtvState(invitedUserID)
+
+		expectedTracks := []shared_mtv.TrackMetadataWithScoreWithDuration{
+			{
+				TrackMetadataWithScore: shared_mtv.TrackMetadataWithScore{
+					TrackMetadata: shared.TrackMetadata{
+						ID:         tracks[1].ID,
+						Title:      tracks[1].Title,
+						ArtistName: tracks[1].ArtistName,
+						Duration:   0,
+					},
+					Score: 2,
+				},
+				Duration: tracks[1].Duration.Milliseconds(),
+			},
+			{
+				TrackMetadataWithScore: shared_mtv.TrackMetadataWithScore{
+					TrackMetadata: shared.TrackMetadata{
+						ID:         tracksToSuggest[1].ID,
+						Title:      tracksToSuggest[1].Title,
+						ArtistName: tracksToSuggest[1].ArtistName,
+						Duration:   0,
+					},
+					Score: 1,
+				},
+				Duration: tracksToSuggest[1].Duration.Milliseconds(),
+			},
+			{
+				TrackMetadataWithScore: shared_mtv.TrackMetadataWithScore{
+					TrackMetadata: shared.TrackMetadata{
+						ID:         tracksToSuggest[0].ID,
+						Title:      tracksToSuggest[0].Title,
+						ArtistName: tracksToSuggest[0].ArtistName,
+						Duration:   0,
+					},
+					Score: 0,
+				},
+				Duration: tracksToSuggest[0].Duration.Milliseconds(),
+			},
+		}
+		s.Equal(expectedTracks, mtvState.Tracks)
+
+		expectedInvitedUser := &shared_mtv.InternalStateUser{
+			UserID:                            invitedUserID,
+			DeviceID:                          invitedUserDeviceID,
+			TracksVotedFor:                    []string{tracks[1].ID, tracksToSuggest[1].ID},
+			UserFitsPositionConstraint:        nil,
+			HasControlAndDelegationPermission: false,
+			UserHasBeenInvited:                true,
+		}
+		s.Equal(expectedInvitedUser, mtvState.UserRelatedInformation)
+	}, checkInvitedUserSuggestDidMakeHimVoteForSuggestedTrack)
+
+	s.env.ExecuteWorkflow(MtvRoomWorkflow, params)
+
+	s.True(s.env.IsWorkflowCompleted())
+	err := s.env.GetWorkflowError()
+	s.ErrorIs(err, workflow.ErrDeadlineExceeded, "The workflow ran on an infinite loop")
+}
+
+func (s *UnitTestSuite) Test_SuggestOrVoteSentActivityBaseOnCurrentTrack() {
+	var (
+		a *activities_mtv.Activities
+
+		joiningUserID       = faker.UUIDHyphenated()
+		joiningUserDeviceID = faker.UUIDHyphenated()
+	)
+
+	tracks := []shared.TrackMetadata{
+		{
+			ID:         faker.UUIDHyphenated(),
+			Title:      faker.Word(),
+			ArtistName: faker.Name(),
+			Duration:   random.GenerateRandomDuration(),
+		},
+	}
+
+	tracksIDs := []string{tracks[0].ID}
+	params, creatorDeviceID := getWorkflowInitParams(tracksIDs, 2)
+	defaultDuration := 1 * time.Millisecond
+
+	resetMock, registerDelayedCallbackWrapper := s.initTestEnv()
+
+	defer resetMock()
+
+	s.env.OnActivity(
+		a.NotifySuggestOrVoteUpdateActivity,
+		mock.Anything,
+		mock.Anything,
+	).Return(nil).Once()
+	s.env.OnActivity(
+		activities.FetchTracksInformationWithPolicyActivity,
+		mock.Anything,
+		fetchTracksInformationArgs(tracksIDs),
+	).Return(activities.FetchedTracksInformationWithInitiator{Metadata: tracks}, nil).Once()
+	s.env.OnActivity(
+		a.CreationAcknowledgementActivity,
+		mock.Anything,
+		mock.Anything,
+	).Return(nil).Once()
+
+	init := defaultDuration
+	registerDelayedCallbackWrapper(func() {
+		mtvState := s.getMtvState(params.RoomCreatorUserID)
+
+		//TracksVotedFor with 1 element shows that creator even with UserHasBeenInvited to false can still vote
+		expectedCreator := &shared_mtv.InternalStateUser{
+			UserID:                            params.RoomCreatorUserID,
+			DeviceID:                          creatorDeviceID,
+			TracksVotedFor:                    []string{tracksIDs[0]},
+			UserFitsPositionConstraint:        nil,
+			HasControlAndDelegationPermission: true,
+			UserHasBeenInvited:                false,
+		}
+
+		expectedTracks := []shared_mtv.TrackMetadataWithScoreWithDuration{
+			{
+				TrackMetadataWithScore: shared_mtv.TrackMetadataWithScore{
+					TrackMetadata: shared.TrackMetadata{
+						ID:         tracks[0].ID,
+						Title:      tracks[0].Title,
+						ArtistName: tracks[0].ArtistName,
+						Duration:   0,
+					},
+					Score: 1,
+				},
+				Duration: tracks[0].Duration.Milliseconds(),
+			},
+		}
+
+		var expectedExposedCurrentTrack *shared_mtv.ExposedCurrentTrack = nil
+
+		s.False(mtvState.Playing)
+		s.Nil(mtvState.TimeConstraintIsValid)
+		s.Equal(expectedExposedCurrentTrack, mtvState.CurrentTrack)
+		s.Equal(expectedCreator, mtvState.UserRelatedInformation)
+		s.Equal(expectedTracks, mtvState.Tracks)
+	}, init)
+
+	//Emit join for joiningUser
+	emitJoinSignalForJoiningUser := defaultDuration
+	registerDelayedCallbackWrapper(func() {
+		args := shared_mtv.NewJoinSignalArgs{
+			DeviceID:           joiningUserDeviceID,
+			UserID:             joiningUserID,
+			UserHasBeenInvited: false,
+		}
+		s.emitJoinSignal(args)
+	}, emitJoinSignalForJoiningUser)
+
+	//Emit vote for track with joiningUser
+	emitVoteForJoiningUser := defaultDuration
+	registerDelayedCallbackWrapper(func() {
+		args := shared_mtv.NewVoteForTrackSignalArgs{
+			TrackID: tracksIDs[0],
+			UserID:  joiningUserID,
+		}
+		s.emitVoteSignal(args)
+	}, emitVoteForJoiningUser)
+
+	waitForVoteOrSuggestInterval := shared_mtv.CheckForVoteUpdateIntervalDuration + defaultDuration
+	registerDelayedCallbackWrapper(func() {
+		mtvState := s.getMtvState(params.RoomCreatorUserID)
+		expectedTracks := []shared_mtv.TrackMetadataWithScoreWithDuration{}
+
+		expectedExposedCurrentTrack := &shared_mtv.ExposedCurrentTrack{
+			CurrentTrack: shared_mtv.CurrentTrack{
+				TrackMetadataWithScore: shared_mtv.TrackMetadataWithScore{
+					TrackMetadata: shared.TrackMetadata{
+						ID:         tracks[0].ID,
+						ArtistName: tracks[0].ArtistName,
+						Title:      tracks[0].Title,
+						Duration:   0,
+					},
+
+					Score: 2,
+				},
+
+				AlreadyElapsed: 0,
+			},
+			Duration: tracks[0].Duration.Milliseconds(),
+			Elapsed:  waitForVoteOrSuggestInterval.Milliseconds(),
+		}
+
+		s.True(mtvState.Playing)
+		s.Equal(expectedExposedCurrentTrack, mtvState.CurrentTrack)
+		s.Equal(expectedTracks, mtvState.Tracks)
+	}, waitForVoteOrSuggestInterval)
+
+	s.env.ExecuteWorkflow(MtvRoomWorkflow, params)
+
+	s.True(s.env.IsWorkflowCompleted())
+	err := s.env.GetWorkflowError()
+	s.ErrorIs(err, workflow.ErrDeadlineExceeded, "The workflow ran on an infinite loop")
+}
+
+func (s *UnitTestSuite) Test_MtvRoomWithConstraintTimeIsValidStartBeforeNow() {
+	var a *activities_mtv.Activities
+
+	tracks := []shared.TrackMetadata{
+		{
+			ID:         faker.UUIDHyphenated(),
+			Title:      faker.Word(),
+			ArtistName: faker.Name(),
+			Duration:   random.GenerateRandomDuration(),
+		},
+	}
+	defaultDuration := 1 * time.Millisecond
+	tracksIDs := []string{tracks[0].ID}
+	params, _ := getWorkflowInitParams(tracksIDs, 2)
+	start := time.Now()
+
+	end := start.Add(defaultDuration * 5000)
+
+	physicalAndTimeConstraints := shared_mtv.MtvRoomPhysicalAndTimeConstraints{
+		PhysicalConstraintPosition: shared_mtv.MtvRoomCoords{
+			Lat: 42,
+			Lng: 42,
+		},
+		PhysicalConstraintRadius:   5000,
+		PhysicalConstraintEndsAt:   end,
+		PhysicalConstraintStartsAt: start,
+	}
+	params.HasPhysicalAndTimeConstraints = true
+	params.PhysicalAndTimeConstraints = &physicalAndTimeConstraints
+	params.CreatorUserRelatedInformation.UserFitsPositionConstraint = &shared_mtv.TrueValue
+
+	resetMock, registerDelayedCallbackWrapper := s.initTestEnv()
+
+	defer resetMock()
+
+	s.env.OnActivity(
+		a.AcknowledgeUpdateTimeConstraint,
+		mock.Anything,
+		mock.Anything,
+	).Return(nil).Once()
+	s.env.OnActivity(
+		activities.FetchTracksInformationWithPolicyActivity,
+		mock.Anything,
+		fetchTracksInformationArgs(tracksIDs),
+	).Return(activities.FetchedTracksInformationWithInitiator{Metadata: tracks}, nil).Once()
+	s.env.OnActivity(
+		a.CreationAcknowledgementActivity,
+		mock.Anything,
+		mock.Anything,
+	).Return(nil).Once()
+
+	init := defaultDuration
+	registerDelayedCallbackWrapper(func() {
+		mtvState := s.getMtvState(params.RoomCreatorUserID)
+
+		//TracksVotedFor with 1 element shows that creator even with UserHasBeenInvited to false can still vote
+		s.NotNil(mtvState.TimeConstraintIsValid)
+		s.True(*mtvState.TimeConstraintIsValid)
+	}, init)
+
+	checkTimeConstraintBecomesFalsy := defaultDuration * 5000
+	registerDelayedCallbackWrapper(func() {
+		mtvState := s.getMtvState(params.RoomCreatorUserID)
+
+		//TracksVotedFor with 1 element shows that creator even with UserHasBeenInvited to false can still vote
+		s.NotNil(mtvState.TimeConstraintIsValid)
+		s.False(*mtvState.TimeConstraintIsValid)
+	}, checkTimeConstraintBecomesFalsy)
+
+	s.env.ExecuteWorkflow(MtvRoomWorkflow, params)
+
+	s.True(s.env.IsWorkflowCompleted())
+	err := s.env.GetWorkflowError()
+	s.ErrorIs(err, workflow.ErrDeadlineExceeded, "The workflow ran on an infinite loop")
+}
+
+func (s *UnitTestSuite) Test_MtvRoomWithConstraintTimeIsValidStartAfterNow() {
+	var a *activities_mtv.Activities
+
+	tracks := []shared.TrackMetadata{
+		{
+			ID:         faker.UUIDHyphenated(),
+			Title:      faker.Word(),
+			ArtistName: faker.Name(),
+			Duration:   random.GenerateRandomDuration(),
+		},
+	}
+	defaultDuration := 1 * time.Millisecond
+	tracksIDs := []string{tracks[0].ID}
+	params, _ := getWorkflowInitParams(tracksIDs, 2)
+	start := time.Now().Add(defaultDuration * 5000)
+
+	end := start.Add(defaultDuration * 500)
+
+	physicalAndTimeConstraints := shared_mtv.MtvRoomPhysicalAndTimeConstraints{
+		PhysicalConstraintPosition: shared_mtv.MtvRoomCoords{
+			Lat: 42,
+			Lng: 42,
+		},
+		PhysicalConstraintRadius:   5000,
+		PhysicalConstraintEndsAt:   end,
+		PhysicalConstraintStartsAt: start,
+	}
+	params.HasPhysicalAndTimeConstraints = true
+	params.PhysicalAndTimeConstraints = &physicalAndTimeConstraints
+	params.CreatorUserRelatedInformation.UserFitsPositionConstraint = &shared_mtv.TrueValue
+
+	resetMock, registerDelayedCallbackWrapper := s.initTestEnv()
+
+	defer resetMock()
+
+	s.env.OnActivity(
+		a.AcknowledgeUpdateTimeConstraint,
+		mock.Anything,
+		mock.Anything,
+	).Return(nil).Twice()
+	s.env.OnActivity(
+		activities.FetchTracksInformationWithPolicyActivity,
+		mock.Anything,
+		fetchTracksInformationArgs(tracksIDs),
+	).Return(activities.FetchedTracksInformationWithInitiator{Metadata: tracks}, nil).Once()
+	s.env.OnActivity(
+		a.CreationAcknowledgementActivity,
+		mock.Anything,
+		mock.Anything,
+	).Return(nil).Once()
+
+	init := defaultDuration
+	registerDelayedCallbackWrapper(func() {
+		mtvState := s.getMtvState(params.RoomCreatorUserID)
+
+		//TracksVotedFor with 1 element shows that creator even with UserHasBeenInvited to false can still vote
+		s.NotNil(mtvState.TimeConstraintIsValid)
+		s.False(*mtvState.TimeConstraintIsValid)
+	}, init)
+
+	checkTimeConstraintBecomesTruthy := defaultDuration * 5000
+	registerDelayedCallbackWrapper(func() {
+		mtvState := s.getMtvState(params.RoomCreatorUserID)
+
+		//TracksVotedFor with 1 element shows that creator even with UserHasBeenInvited to false can still vote
+		s.NotNil(mtvState.TimeConstraintIsValid)
+		s.True(*mtvState.TimeConstraintIsValid)
+	}, checkTimeConstraintBecomesTruthy)
+
+	checkTimeConstraintBecomesFalsy := defaultDuration * 5000
+	registerDelayedCallbackWrapper(func() {
+		mtvState := s.getMtvState(params.RoomCreatorUserID)
+
+		//TracksVotedFor with 1 element shows that creator even with UserHasBeenInvited to false can still vote
+		s.NotNil(mtvState.TimeConstraintIsValid)
+		s.False(*mtvState.TimeConstraintIsValid)
+	}, checkTimeConstraintBecomesFalsy)
+
+	s.env.ExecuteWorkflow(MtvRoomWorkflow, params)
+
+	s.True(s.env.IsWorkflowCompleted())
+	err := s.env.GetWorkflowError()
+	s.ErrorIs(err, workflow.ErrDeadlineExceeded, "The workflow ran on an infinite loop")
+}
+
+func (s *UnitTestSuite) Test_MtvRoomWithConstraintFailPhysicalAndTimeConstraintsNilButHasPhysicalAndTimeConstraintsTrue() {
+
+	tracks := []shared.TrackMetadata{
+		{
+			ID:         faker.UUIDHyphenated(),
+			Title:      faker.Word(),
+			ArtistName: faker.Name(),
+			Duration:   random.GenerateRandomDuration(),
+		},
+	}
+	tracksIDs := []string{tracks[0].ID}
+	params, _ := getWorkflowInitParams(tracksIDs, 2)
+
+	params.HasPhysicalAndTimeConstraints = true
+	params.PhysicalAndTimeConstraints = nil
+	params.CreatorUserRelatedInformation.UserFitsPositionConstraint = &shared_mtv.TrueValue
+
+	resetMock, _ := s.initTestEnv()
+
+	defer resetMock()
+
+	s.env.ExecuteWorkflow(MtvRoomWorkflow, params)
+
+	s.True(s.env.IsWorkflowCompleted())
+	err := s.env.GetWorkflowError()
+	s.Error(err)
+	var applicationErr *temporal.ApplicationError
+	s.True(errors.As(err, &applicationErr))
+	s.Equal("PhysicalAndTimeConstraints nil but HasPhysicalAndTimeConstraints true", applicationErr.Error())
+}
+
+func (s *UnitTestSuite) Test_MtvRoomWithConstraintFailPhysicalAndTimeConstraintsSetButHasPhysicalAndTimeConstraintsFalse() {
+
+	tracks := []shared.TrackMetadata{
+		{
+			ID:         faker.UUIDHyphenated(),
+			Title:      faker.Word(),
+			ArtistName: faker.Name(),
+			Duration:   random.GenerateRandomDuration(),
+		},
+	}
+	defaultDuration := 1 * time.Millisecond
+	tracksIDs := []string{tracks[0].ID}
+	params, _ := getWorkflowInitParams(tracksIDs, 2)
+	start := time.Now().Add(defaultDuration * 5000)
+
+	end := start.Add(defaultDuration * 500)
+
+	physicalAndTimeConstraints := shared_mtv.MtvRoomPhysicalAndTimeConstraints{
+		PhysicalConstraintPosition: shared_mtv.MtvRoomCoords{
+			Lat: 42,
+			Lng: 42,
+		},
+		PhysicalConstraintRadius:   5000,
+		PhysicalConstraintEndsAt:   end,
+		PhysicalConstraintStartsAt: start,
+	}
+	params.HasPhysicalAndTimeConstraints = false
+	params.PhysicalAndTimeConstraints = &physicalAndTimeConstraints
+	params.CreatorUserRelatedInformation.UserFitsPositionConstraint = &shared_mtv.TrueValue
+
+	resetMock, _ := s.initTestEnv()
+
+	defer resetMock()
+
+	s.env.ExecuteWorkflow(MtvRoomWorkflow, params)
+
+	s.True(s.env.IsWorkflowCompleted())
+	err := s.env.GetWorkflowError()
+	s.Error(err)
+	var applicationErr *temporal.ApplicationError
+	s.True(errors.As(err, &applicationErr))
+	s.Equal("PhysicalAndTimeConstraints set but HasPhysicalAndTimeConstraints false", applicationErr.Error())
+}
+
+func (s *UnitTestSuite) Test_MtvRoomWithConstraintFailStartIsAfterEnd() {
+
+	tracks := []shared.TrackMetadata{
+		{
+			ID:         faker.UUIDHyphenated(),
+			Title:      faker.Word(),
+			ArtistName: faker.Name(),
+			Duration:   random.GenerateRandomDuration(),
+		},
+	}
+	defaultDuration := 1 * time.Millisecond
+	tracksIDs := []string{tracks[0].ID}
+	params, _ := getWorkflowInitParams(tracksIDs, 2)
+	start := time.Now().Add(defaultDuration * 5000)
+
+	end := start.Add(defaultDuration * 500)
+
+	physicalAndTimeConstraints := shared_mtv.MtvRoomPhysicalAndTimeConstraints{
+		PhysicalConstraintPosition: shared_mtv.MtvRoomCoords{
+			Lat: 42,
+			Lng: 42,
+		},
+		PhysicalConstraintRadius:   5000,
+		PhysicalConstraintEndsAt:   start,
+		PhysicalConstraintStartsAt: end,
+	}
+	params.HasPhysicalAndTimeConstraints = true
+	params.PhysicalAndTimeConstraints = &physicalAndTimeConstraints
+	params.CreatorUserRelatedInformation.UserFitsPositionConstraint = &shared_mtv.TrueValue
+
+	resetMock, _ := s.initTestEnv()
+
+	defer resetMock()
+
+	s.env.ExecuteWorkflow(MtvRoomWorkflow, params)
+
+	s.True(s.env.IsWorkflowCompleted())
+	err := s.env.GetWorkflowError()
+	s.Error(err)
+	var applicationErr *temporal.ApplicationError
+	s.True(errors.As(err, &applicationErr))
+	s.Equal("start is after end", applicationErr.Error())
+}
+
+func (s *UnitTestSuite) Test_MtvRoomWithConstraintFailStartEqualEnd() {
+
+	tracks := []shared.TrackMetadata{
+		{
+			ID:         faker.UUIDHyphenated(),
+			Title:      faker.Word(),
+			ArtistName: faker.Name(),
+			Duration:   random.GenerateRandomDuration(),
+		},
+	}
+	defaultDuration := 1 * time.Millisecond
+	tracksIDs := []string{tracks[0].ID}
+	params, _ := getWorkflowInitParams(tracksIDs, 2)
+	start := time.Now().Add(defaultDuration * 5000)
+
+	end := start.Add(defaultDuration * 500)
+
+	physicalAndTimeConstraints := shared_mtv.MtvRoomPhysicalAndTimeConstraints{
+		PhysicalConstraintPosition: shared_mtv.MtvRoomCoords{
+			Lat: 42,
+			Lng: 42,
+		},
+		PhysicalConstraintRadius:   5000,
+		PhysicalConstraintEndsAt:   end,
+		PhysicalConstraintStartsAt: end,
+	}
+	params.HasPhysicalAndTimeConstraints = true
+	params.PhysicalAndTimeConstraints = &physicalAndTimeConstraints
+	params.CreatorUserRelatedInformation.UserFitsPositionConstraint = &shared_mtv.TrueValue
+
+	resetMock, _ := s.initTestEnv()
+
+	defer resetMock()
+
+	s.env.ExecuteWorkflow(MtvRoomWorkflow, params)
+
+	s.True(s.env.IsWorkflowCompleted())
+	err := s.env.GetWorkflowError()
+	s.Error(err)
+	var applicationErr *temporal.ApplicationError
+	s.True(errors.As(err, &applicationErr))
+	s.Equal("start equal end", applicationErr.Error())
+}
+
+func (s *UnitTestSuite) Test_MtvRoomWithConstraintFailEndIsBeforeNow() {
+
+	tracks := []shared.TrackMetadata{
+		{
+			ID:         faker.UUIDHyphenated(),
+			Title:      faker.Word(),
+			ArtistName: faker.Name(),
+			Duration:   random.GenerateRandomDuration(),
+		},
+	}
+	tracksIDs := []string{tracks[0].ID}
+	params, _ := getWorkflowInitParams(tracksIDs, 2)
+	now := time.Now()
+	start := now.Add(time.Duration(-2) * time.Minute)
+
+	end := now.Add(time.Duration(-1) * time.Minute)
+	physicalAndTimeConstraints := shared_mtv.MtvRoomPhysicalAndTimeConstraints{
+		PhysicalConstraintPosition: shared_mtv.MtvRoomCoords{
+			Lat: 42,
+			Lng: 42,
+		},
+		PhysicalConstraintRadius:   5000,
+		PhysicalConstraintEndsAt:   end,
+		PhysicalConstraintStartsAt: start,
+	}
+	params.HasPhysicalAndTimeConstraints = true
+	params.PhysicalAndTimeConstraints = &physicalAndTimeConstraints
+	params.CreatorUserRelatedInformation.UserFitsPositionConstraint = &shared_mtv.TrueValue
+
+	resetMock, _ := s.initTestEnv()
+
+	defer resetMock()
+
+	s.env.ExecuteWorkflow(MtvRoomWorkflow, params)
+
+	s.True(s.env.IsWorkflowCompleted())
+	err := s.env.GetWorkflowError()
+	s.Error(err)
+	var applicationErr *temporal.ApplicationError
+	s.True(errors.As(err, &applicationErr))
+	s.Equal("end is before now", applicationErr.Error())
+}
+
+func (s *UnitTestSuite) Test_MtvRoomWithConstraintFailEndEqualNow() {
+
+	tracks := []shared.TrackMetadata{
+		{
+			ID:         faker.UUIDHyphenated(),
+			Title:      faker.Word(),
+			ArtistName: faker.Name(),
+			Duration:   random.GenerateRandomDuration(),
+		},
+	}
+	tracksIDs := []string{tracks[0].ID}
+	params, _ := getWorkflowInitParams(tracksIDs, 2)
+	//mocking now
+	resetMock, _ := s.initTestEnv()
+	///
+
+	now := TimeWrapper()
+	start := now.Add(time.Duration(-2) * time.Minute)
+	physicalAndTimeConstraints := shared_mtv.MtvRoomPhysicalAndTimeConstraints{
+		PhysicalConstraintPosition: shared_mtv.MtvRoomCoords{
+			Lat: 42,
+			Lng: 42,
+		},
+		PhysicalConstraintRadius:   5000,
+		PhysicalConstraintEndsAt:   now,
+		PhysicalConstraintStartsAt: start,
+	}
+	params.HasPhysicalAndTimeConstraints = true
+	params.PhysicalAndTimeConstraints = &physicalAndTimeConstraints
+	params.CreatorUserRelatedInformation.UserFitsPositionConstraint = &shared_mtv.TrueValue
+
+	defer resetMock()
+
+	s.env.ExecuteWorkflow(MtvRoomWorkflow, params)
+
+	s.True(s.env.IsWorkflowCompleted())
+	err := s.env.GetWorkflowError()
+	s.Error(err)
+	var applicationErr *temporal.ApplicationError
+	s.True(errors.As(err, &applicationErr))
+	s.Equal("end equal now", applicationErr.Error())
+
+}
+
+func (s *UnitTestSuite) Test_MtvRoomFailPlayingModeIsInvalid() {
+
+	tracks := []shared.TrackMetadata{
+		{
+			ID:         faker.UUIDHyphenated(),
+			Title:      faker.Word(),
+			ArtistName: faker.Name(),
+			Duration:   random.GenerateRandomDuration(),
+		},
+	}
+	tracksIDs := []string{tracks[0].ID}
+	params, _ := getWorkflowInitParams(tracksIDs, 2)
+	//mocking now
+	resetMock, _ := s.initTestEnv()
+	///
+	params.PlayingMode = "UNKNOWN_PLAYING_MODE"
+
+	defer resetMock()
+
+	s.env.ExecuteWorkflow(MtvRoomWorkflow, params)
+
+	s.True(s.env.IsWorkflowCompleted())
+	err := s.env.GetWorkflowError()
+	s.Error(err)
+	var applicationErr *temporal.ApplicationError
+	s.True(errors.As(err, &applicationErr))
+	s.Equal("PlayingMode is invalid", applicationErr.Error())
+}
+
+func (s *UnitTestSuite) Test_MtvRoomFailIsOpenOnlyInvitedUsersCanVoteTrueButIsOpenFalse() {
+
+	tracks := []shared.TrackMetadata{
+		{
+			ID:         faker.UUIDHyphenated(),
+			Title:      faker.Word(),
+			ArtistName: faker.Name(),
+			Duration:   random.GenerateRandomDuration(),
+		},
+	}
+	tracksIDs := []string{tracks[0].ID}
+	params, _ := getWorkflowInitParams(tracksIDs, 2)
+	//mocking now
+	resetMock, _ := s.initTestEnv()
+	///
+	params.IsOpen = false
+	params.IsOpenOnlyInvitedUsersCanVote = true
+
+	defer resetMock()
+
+	s.env.ExecuteWorkflow(MtvRoomWorkflow, params)
+
+	s.True(s.env.IsWorkflowCompleted())
+	err := s.env.GetWorkflowError()
+	s.Error(err)
+	var applicationErr *temporal.ApplicationError
+	s.True(errors.As(err, &applicationErr))
+	s.Equal("IsOpenOnlyInvitedUsersCanVote true but IsOpen false", applicationErr.Error())
+}
+
+func (s *UnitTestSuite) Test_UserOutsideRoomAreaSuggestingTrackMustTriggerTracksListUpdate() {
+	var a *activities_mtv.Activities
+
+	tracks := []shared.TrackMetadata{
+		{
+			ID:         faker.UUIDHyphenated(),
+			Title:      faker.Word(),
+			ArtistName: faker.Name(),
+			Duration:   random.GenerateRandomDuration(),
+		},
+	}
+	tracksIDs := []string{tracks[0].ID}
+	tracksIDsToSuggest := []string{faker.UUIDHyphenated()}
+	tracksToSuggestMetadata := []shared.TrackMetadata{
+		{
+			ID:         tracksIDsToSuggest[0],
+			Title:      faker.Word(),
+			ArtistName: faker.Name(),
+			Duration:   random.GenerateRandomDuration(),
+		},
+	}
+
+	params, creatorDeviceID := getWorkflowInitParams(tracksIDs, 1)
+	defaultDuration := 1 * time.Millisecond
+
+	start := time.Now()
+
+	end := start.Add(defaultDuration * 5000)
+
+	physicalAndTimeConstraints := shared_mtv.MtvRoomPhysicalAndTimeConstraints{
+		PhysicalConstraintPosition: shared_mtv.MtvRoomCoords{
+			Lat: 42,
+			Lng: 42,
+		},
+		PhysicalConstraintRadius:   5000,
+		PhysicalConstraintEndsAt:   end,
+		PhysicalConstraintStartsAt: start,
+	}
+
+	params.HasPhysicalAndTimeConstraints = true
+	params.PhysicalAndTimeConstraints = &physicalAndTimeConstraints
+	params.CreatorUserRelatedInformation.UserFitsPositionConstraint = &shared_mtv.FalseValue
+	creatorUserID := params.RoomCreatorUserID
+
+	resetMock, registerDelayedCallbackWrapper := s.initTestEnv()
+
+	defer resetMock()
+
+	s.env.OnActivity(
+		activities.FetchTracksInformationWithPolicyActivity,
+		mock.Anything,
+		fetchTracksInformationArgs(tracksIDs),
+	).Return(activities.FetchedTracksInformationWithInitiator{Metadata: tracks}, nil).Once()
+
+	s.env.OnActivity(
+		activities.FetchTracksInformationWithPolicyActivity,
+		mock.Anything,
+		fetchTracksInformationArgsForInitiator(tracksIDsToSuggest, creatorUserID, creatorDeviceID),
+	).Return(activities.FetchedTracksInformationWithInitiator{
+		Metadata: tracksToSuggestMetadata,
+		UserID:   creatorUserID,
+		DeviceID: creatorDeviceID,
+	}, nil).Once()
+
+	s.env.OnActivity(
+		a.CreationAcknowledgementActivity,
+		mock.Anything,
+		mock.Anything,
+	).Return(nil).Once()
+
+	// This activity must be called when a user outside of the
+	// room area suggests a song.
+	s.env.OnActivity(
+		a.NotifySuggestOrVoteUpdateActivity,
+		mock.Anything,
+		mock.Anything,
+	).Return(nil).Once()
+
+	init := defaultDuration
+	registerDelayedCallbackWrapper(func() {
+		expectedCreator := &shared_mtv.InternalStateUser{
+			UserID:                            params.RoomCreatorUserID,
+			DeviceID:                          creatorDeviceID,
+			TracksVotedFor:                    []string{},
+			UserFitsPositionConstraint:        &shared_mtv.FalseValue,
+			HasControlAndDelegationPermission: true,
+		}
+		expectedTracks := []shared_mtv.TrackMetadataWithScoreWithDuration{
+			{
+				TrackMetadataWithScore: shared_mtv.TrackMetadataWithScore{
+					TrackMetadata: shared.TrackMetadata{
+						ID:         tracks[0].ID,
+						Title:      tracks[0].Title,
+						ArtistName: tracks[0].ArtistName,
+						Duration:   0,
+					},
+					Score: 0,
+				},
+				Duration: tracks[0].Duration.Milliseconds(),
+			},
+		}
+
+		mtvState := s.getMtvState(params.RoomCreatorUserID)
+
+		s.False(mtvState.Playing)
+		s.Equal(1, mtvState.MinimumScoreToBePlayed)
+		s.Equal(expectedCreator, mtvState.UserRelatedInformation)
+		s.Equal(expectedTracks, mtvState.Tracks)
+		s.True(mtvState.RoomHasTimeAndPositionConstraints)
+	}, init)
+
+	creatorSuggestsATrack := defaultDuration
+	registerDelayedCallbackWrapper(func() {
+		s.emitSuggestTrackSignal(shared_mtv.SuggestTracksSignalArgs{
+			TracksToSuggest: tracksIDsToSuggest,
+			UserID:          creatorUserID,
+			DeviceID:        creatorDeviceID,
+		})
+	}, creatorSuggestsATrack)
+
+	checkSongHasBeenSuggested := defaultDuration
+	registerDelayedCallbackWrapper(func() {
+		expectedTracks := []shared_mtv.TrackMetadataWithScoreWithDuration{
+			{
+				TrackMetadataWithScore: shared_mtv.TrackMetadataWithScore{
+					TrackMetadata: shared.TrackMetadata{
+						ID:         tracks[0].ID,
+						Title:      tracks[0].Title,
+						ArtistName: tracks[0].ArtistName,
+						Duration:   0,
+					},
+					Score: 0,
+				},
+				Duration: tracks[0].Duration.Milliseconds(),
+			},
+
+			// Song suggested by the creator
+			{
+				TrackMetadataWithScore: shared_mtv.TrackMetadataWithScore{
+					TrackMetadata: shared.TrackMetadata{
+						ID:         tracksToSuggestMetadata[0].ID,
+						Title:      tracksToSuggestMetadata[0].Title,
+						ArtistName: tracksToSuggestMetadata[0].ArtistName,
+						Duration:   0,
+					},
+					Score: 0,
+				},
+				Duration: tracksToSuggestMetadata[0].Duration.Milliseconds(),
+			},
+		}
+
+		mtvState := s.getMtvState(params.RoomCreatorUserID)
+
+		s.Equal(expectedTracks, mtvState.Tracks)
+	}, checkSongHasBeenSuggested)
+
+	s.env.ExecuteWorkflow(MtvRoomWorkflow, params)
+
+	s.True(s.env.IsWorkflowCompleted())
+	err := s.env.GetWorkflowError()
+	s.ErrorIs(err, workflow.ErrDeadlineExceeded, "The workflow ran on an infinite loop")
+}
+
+func (s *UnitTestSuite) Test_GetMtvRoomConstraintsDetails() {
+	var a *activities_mtv.Activities
+
+	tracks := []shared.TrackMetadata{
+		{
+			ID:         faker.UUIDHyphenated(),
+			Title:      faker.Word(),
+			ArtistName: faker.Name(),
+			Duration:   random.GenerateRandomDuration(),
+		},
+	}
+	tracksIDs := []string{tracks[0].ID}
+
+	params, _ := getWorkflowInitParams(tracksIDs, 1)
+	defaultDuration := 1 * time.Millisecond
+
+	start := time.Now()
+
+	end := start.Add(defaultDuration * 5000)
+
+	physicalAndTimeConstraints := shared_mtv.MtvRoomPhysicalAndTimeConstraints{
+		PhysicalConstraintPosition: shared_mtv.MtvRoomCoords{
+			Lat: 42,
+			Lng: 42,
+		},
+		PhysicalConstraintRadius:   5000,
+		PhysicalConstraintEndsAt:   end,
+		PhysicalConstraintStartsAt: start,
+	}
+
+	params.HasPhysicalAndTimeConstraints = true
+	params.PhysicalAndTimeConstraints = &physicalAndTimeConstraints
+	params.CreatorUserRelatedInformation.UserFitsPositionConstraint = &shared_mtv.FalseValue
+
+	resetMock, registerDelayedCallbackWrapper := s.initTestEnv()
+
+	defer resetMock()
+
+	s.env.OnActivity(
+		activities.FetchTracksInformationWithPolicyActivity,
+		mock.Anything,
+		fetchTracksInformationArgs(tracksIDs),
+	).Return(activities.FetchedTracksInformationWithInitiator{Metadata: tracks}, nil).Once()
+	s.env.OnActivity(
+		a.CreationAcknowledgementActivity,
+		mock.Anything,
+		mock.Anything,
+	).Return(nil).Once()
+
+	init := defaultDuration
+	registerDelayedCallbackWrapper(func() {
+		expectedDetails := shared_mtv.MtvRoomConstraintsDetails{
+			PhysicalConstraintEndsAt:   end.Format(time.RFC3339),
+			PhysicalConstraintStartsAt: start.Format(time.RFC3339),
+			PhysicalConstraintRadius:   5000,
+			RoomID:                     params.RoomID,
+			PhysicalConstraintPosition: shared_mtv.MtvRoomCoords{
+				Lat: 42,
+				Lng: 42,
+			},
+		}
+
+		details := s.getMtvRoomConstraintsDetails()
+		s.Equal(expectedDetails, details)
+	}, init)
+
+	s.env.ExecuteWorkflow(MtvRoomWorkflow, params)
+
+	s.True(s.env.IsWorkflowCompleted())
+	err := s.env.GetWorkflowError()
+	s.ErrorIs(err, workflow.ErrDeadlineExceeded, "The workflow ran on an infinite loop")
+}
+
+func (s *UnitTestSuite) Test_GetMtvRoomConstraintsDetailsFailRoomDoesntHaveConstraints() {
+	var a *activities_mtv.Activities
+
+	tracks := []shared.TrackMetadata{
+		{
+			ID:         faker.UUIDHyphenated(),
+			Title:      faker.Word(),
+			ArtistName: faker.Name(),
+			Duration:   random.GenerateRandomDuration(),
+		},
+	}
+	tracksIDs := []string{tracks[0].ID}
+
+	params, _ := getWorkflowInitParams(tracksIDs, 1)
+	defaultDuration := 1 * time.Millisecond
+	resetMock, registerDelayedCallbackWrapper := s.initTestEnv()
+
+	defer resetMock()
+
+	s.env.OnActivity(
+		activities.FetchTracksInformationWithPolicyActivity,
+		mock.Anything,
+		fetchTracksInformationArgs(tracksIDs),
+	).Return(activities.FetchedTracksInformationWithInitiator{Metadata: tracks}, nil).Once()
+	s.env.OnActivity(
+		a.CreationAcknowledgementActivity,
+		mock.Anything,
+		mock.Anything,
+	).Return(nil).Once()
+
+	init := defaultDuration
+	registerDelayedCallbackWrapper(func() {
+		_, err := s.env.QueryWorkflow(shared_mtv.MtvGetRoomConstraintsDetails)
+
+		s.Error(err)
+		s.ErrorIs(err, ErrRoomDoesNotHaveConstraints)
+	}, init)
+
+	s.env.ExecuteWorkflow(MtvRoomWorkflow, params)
+
+	s.True(s.env.IsWorkflowCompleted())
+	err := s.env.GetWorkflowError()
+	s.ErrorIs(err, workflow.ErrDeadlineExceeded, "The workflow ran on an infinite loop")
+}
+
+func (s *UnitTestSuite) Test_MtvRoomPanicAfterUnkownWorkflowSignal() {
+	var a *activities_mtv.Activities
+
+	tracks := []shared.TrackMetadata{
+		{
+			ID:         faker.UUIDHyphenated(),
+			Title:      faker.Word(),
+			ArtistName: faker.Name(),
+			Duration:   random.GenerateRandomDuration(),
+		},
+	}
+	tracksIDs := []string{tracks[0].ID}
+
+	params, _ := getWorkflowInitParams(tracksIDs, 1)
+	defaultDuration := 200 * time.Millisecond
+	resetMock, registerDelayedCallbackWrapper := s.initTestEnv()
+
+	defer resetMock()
+
+	s.env.OnActivity(
+		activities.FetchTracksInformationWithPolicyActivity,
+		mock.Anything,
+		fetchTracksInformationArgs(tracksIDs),
+	).Return(activities.FetchedTracksInformationWithInitiator{Metadata: tracks}, nil).Once()
+	s.env.OnActivity(
+		a.CreationAcknowledgementActivity,
+		mock.Anything,
+		mock.Anything,
+	).Return(nil).Once()
+
+	init := defaultDuration
+	registerDelayedCallbackWrapper(func() {
+		s.emitUnkownSignal()
+	}, init)
+
+	s.env.ExecuteWorkflow(MtvRoomWorkflow, params)
+
+	s.True(s.env.IsWorkflowCompleted())
+	err := s.env.GetWorkflowError()
+	s.Error(err)
+	var panicError *temporal.PanicError
+	s.True(errors.As(err, &panicError))
+	s.Contains(panicError.Error(), ErrUnknownWorflowSignal.Error())
+}
+
+func (s *UnitTestSuite) Test_KickAndBanUsers() {
+	var (
+		a *activities_mtv.Activities
+
+		kickedUserID       = faker.UUIDHyphenated()
+		bannedUserID       = faker.UUIDHyphenated()
+		bannedUserDeviceID = faker.UUIDHyphenated()
+	)
+
+	tracks := []shared.TrackMetadata{
+		{
+			ID:         faker.UUIDHyphenated(),
+			Title:      faker.Word(),
+			ArtistName: faker.Name(),
+			Duration:   random.GenerateRandomDuration(),
+		},
+		{
+			ID:         faker.UUIDHyphenated(),
+			Title:      faker.Word(),
+			ArtistName: faker.Name(),
+			Duration:   random.GenerateRandomDuration(),
+		},
+	}
+	tracksIDs := []string{tracks[0].ID, tracks[1].ID}
+	params, _ := getWorkflowInitParams(tracksIDs, 1)
+
+	defaultDuration := 1 * time.Millisecond
+	resetMock, registerDelayedCallbackWrapper := s.initTestEnv()
+
+	defer resetMock()
+
+	s.env.OnActivity(
+		activities.FetchTracksInformationWithPolicyActivity,
+		mock.Anything,
+		fetchTracksInformationArgs(tracksIDs),
+	).Return(activities.FetchedTracksInformationWithInitiator{Metadata: tracks}, nil).Once()
+	s.env.OnActivity(
+		a.CreationAcknowledgementActivity,
+		mock.Anything,
+		mock.Anything,
+	).Return(nil).Once()
+	s.env.OnActivity(
+		a.JoinActivity,
+		mock.Anything,
+		mock.Anything,
+	).Return(nil).Times(2)
+	s.env.OnActivity(
+		a.UserLengthUpdateActivity,
+		mock.Anything,
+		mock.Anything,
+	).Return(nil).Times(4)
+	s.env.OnActivity(
+		a.AcknowledgeUserKickedActivity,
+		mock.Anything,
+		mock.Anything,
+	).Return(nil).Once()
+	s.env.OnActivity(
+		a.AcknowledgeUserBannedActivity,
+		mock.Anything,
+		mock.Anything,
+	).Return(nil).Once()
+	s.env.OnActivity(
+		a.RejectBannedUserJoinActivity,
+		mock.Anything,
+		activities_mtv.RejectBannedUserJoinArgs{
+			RoomID:   params.RoomID,
+			UserID:   bannedUserID,
+			DeviceID: bannedUserDeviceID,
+		},
+	).Return(nil).Once()
+
+	joinRoom := defaultDuration
+	registerDelayedCallbackWrapper(func() {
+		s.emitJoinSignal(shared_mtv.NewJoinSignalArgs{
+			UserID:             kickedUserID,
+			DeviceID:           faker.UUIDHyphenated(),
+			UserHasBeenInvited: false,
+		})
+		s.emitJoinSignal(shared_mtv.NewJoinSignalArgs{
+			UserID:             bannedUserID,
+			DeviceID:           bannedUserDeviceID,
+			UserHasBeenInvited: false,
+		})
+	}, joinRoom)
+
+	voteForTrack := defaultDuration
+	registerDelayedCallbackWrapper(func() {
+		s.emitVoteSignal(shared_mtv.NewVoteForTrackSignalArgs{
+			UserID:  bannedUserID,
+			TrackID: tracks[1].ID,
+		})
+	}, voteForTrack)
+
+	// Users without control and delegation permission can not moderate the room.
+	kickByUserWithoutPermission := defaultDuration
+	registerDelayedCallbackWrapper(func() {
+		s.emitKickUserSignal(shared_mtv.NewKickUserSignalArgs{
+			KickedUserID:  kickedUserID,
+			EmitterUserID: bannedUserID,
+		})
+	}, kickByUserWithoutPermission)
+
+	checkKickWasIgnored := defaultDuration
+	registerDelayedCallbackWrapper(func() {
+		mtvState := s.getMtvState(shared_mtv.NoRelatedUserID)
+
+		s.Equal(3, mtvState.UsersLength)
+		s.Equal(2, mtvState.Tracks[0].Score)
+	}, checkKickWasIgnored)
+
+	kickUser := defaultDuration
+	registerDelayedCallbackWrapper(func() {
+		s.emitKickUserSignal(shared_mtv.NewKickUserSignalArgs{
+			KickedUserID:  kickedUserID,
+			EmitterUserID: params.RoomCreatorUserID,
+		})
+	}, kickUser)
+
+	checkUserWasKicked := defaultDuration
+	registerDelayedCallbackWrapper(func() {
+		mtvState := s.getMtvState(kickedUserID)
+
+		s.Equal(2, mtvState.UsersLength)
+		s.Nil(mtvState.UserRelatedInformation)
+	}, checkUserWasKicked)
+
+	banUser := defaultDuration
+	registerDelayedCallbackWrapper(func() {
+		s.emitBanUserSignal(shared_mtv.NewBanUserSignalArgs{
+			BannedUserID:  bannedUserID,
+			EmitterUserID: params.RoomCreatorUserID,
+		})
+	}, banUser)
+
+	checkUserWasBannedAndVotesCleared := defaultDuration
+	registerDelayedCallbackWrapper(func() {
+		mtvState := s.getMtvState(bannedUserID)
+
+		s.Equal(1, mtvState.UsersLength)
+		s.Nil(mtvState.UserRelatedInformation)
+		s.Equal(1, mtvState.Tracks[0].Score)
+	}, checkUserWasBannedAndVotesCleared)
+
+	bannedUserJoinsAgain := defaultDuration
+	registerDelayedCallbackWrapper(func() {
+		s.emitJoinSignal(shared_mtv.NewJoinSignalArgs{
+			UserID:             bannedUserID,
+			DeviceID:           bannedUserDeviceID,
+			UserHasBeenInvited: false,
+		})
+	}, bannedUserJoinsAgain)
+
+	checkBannedUserCouldNotJoin := defaultDuration
+	registerDelayedCallbackWrapper(func() {
+		mtvState := s.getMtvState(bannedUserID)
+
+		s.Equal(1, mtvState.UsersLength)
+		s.Nil(mtvState.UserRelatedInformation)
+	}, checkBannedUserCouldNotJoin)
+
+	s.env.ExecuteWorkflow(MtvRoomWorkflow, params)
+
+	s.True(s.env.IsWorkflowCompleted())
+	err := s.env.GetWorkflowError()
+	s.ErrorIs(err, workflow.ErrDeadlineExceeded, "The workflow ran on an infinite loop")
+}
+
+func (s *UnitTestSuite) Test_UpdateRoomSettings() {
+	var (
+		a *activities_mtv.Activities
+
+		joiningUserID                 = faker.UUIDHyphenated()
+		updatedRoomName               = faker.Word()
+		isOpen                        = false
+		isOpenOnlyInvitedUsersCanVote = true
+		minimumScoreToBePlayed        = 1
+		negativeMinimumScore          = -1
+	)
+
+	tracks := []shared.TrackMetadata{
+		{
+			ID:         faker.UUIDHyphenated(),
+			Title:      faker.Word(),
+			ArtistName: faker.Name(),
+			Duration:   random.GenerateRandomDuration(),
+		},
+	}
+	tracksIDs := []string{tracks[0].ID}
+	//The initial track only has the creator vote and is not ready to be played
+	params, _ := getWorkflowInitParams(tracksIDs, 2)
+
+	defaultDuration := 1 * time.Millisecond
+	resetMock, registerDelayedCallbackWrapper := s.initTestEnv()
+
+	defer resetMock()
+
+	s.env.OnActivity(
+		activities.FetchTracksInformationWithPolicyActivity,
+		mock.Anything,
+		fetchTracksInformationArgs(tracksIDs),
+	).Return(activities.FetchedTracksInformationWithInitiator{Metadata: tracks}, nil).Once()
+	s.env.OnActivity(
+		a.CreationAcknowledgementActivity,
+		mock.Anything,
+		mock.Anything,
+	).Return(nil).Once()
+	s.env.OnActivity(
+		a.JoinActivity,
+		mock.Anything,
+		mock.Anything,
+	).Return(nil).Once()
+	s.env.OnActivity(
+		a.AcknowledgeUpdateRoomSettings,
+		mock.Anything,
+		mock.Anything,
+	).Return(nil).Twice()
+	s.env.OnActivity(
+		a.AcknowledgeUpdateRoomSettingsFail,
+		mock.Anything,
+		activities_mtv.AcknowledgeUpdateRoomSettingsFailArgs{
+			UserID: params.RoomCreatorUserID,
+			Reason: shared_mtv.MtvRoomSettingsUpdateFailReasonOnlyInvitedUsersCanVoteInPrivateRoom,
+		},
+	).Return(nil).Once()
+	s.env.OnActivity(
+		a.AcknowledgeUpdateRoomSettingsFail,
+		mock.Anything,
+		activities_mtv.AcknowledgeUpdateRoomSettingsFailArgs{
+			UserID: params.RoomCreatorUserID,
+			Reason: shared_mtv.MtvRoomSettingsUpdateFailReasonNegativeMinimumScoreToBePlayed,
+		},
+	).Return(nil).Once()
+	s.env.OnActivity(
+		a.PlayActivity,
+		mock.Anything,
+		mock.Anything,
+	).Return(nil).Once()
+
+	joinRoom := defaultDuration
+	registerDelayedCallbackWrapper(func() {
+		s.emitJoinSignal(shared_mtv.NewJoinSignalArgs{
+			UserID:             joiningUserID,
+			DeviceID:           faker.UUIDHyphenated(),
+			UserHasBeenInvited: false,
+		})
+	}, joinRoom)
+
+	// Only the creator can update the room settings.
+	updateSettingsAsNotCreator := defaultDuration
+	registerDelayedCallbackWrapper(func() {
+		s.emitUpdateRoomSettingsSignal(shared_mtv.NewUpdateRoomSettingsSignalArgs{
+			UserID: joiningUserID,
+			Settings: shared_mtv.MtvRoomSettingsUpdate{
+				RoomName: &updatedRoomName,
+			},
+		})
+	}, updateSettingsAsNotCreator)
+
+	checkSettingsWereNotUpdated := defaultDuration
+	registerDelayedCallbackWrapper(func() {
+		mtvState := s.getMtvState(shared_mtv.NoRelatedUserID)
+
+		s.Equal(params.RoomName, mtvState.RoomName)
+		s.Nil(mtvState.CurrentTrack)
+	}, checkSettingsWereNotUpdated)
+
+	updateRoomName := defaultDuration
+	registerDelayedCallbackWrapper(func() {
+		s.emitUpdateRoomSettingsSignal(shared_mtv.NewUpdateRoomSettingsSignalArgs{
+			UserID: params.RoomCreatorUserID,
+			Settings: shared_mtv.MtvRoomSettingsUpdate{
+				RoomName: &updatedRoomName,
+			},
+		})
+	}, updateRoomName)
+
+	checkRoomNameWasUpdated := defaultDuration
+	registerDelayedCallbackWrapper(func() {
+		mtvState := s.getMtvState(shared_mtv.NoRelatedUserID)
+
+		s.Equal(updatedRoomName, mtvState.RoomName)
+		s.True(mtvState.IsOpen)
+		s.Equal(2, mtvState.MinimumScoreToBePlayed)
+	}, checkRoomNameWasUpdated)
+
+	// Updates resulting in invalid parameters are rejected.
+	updateWithInvalidSettings := defaultDuration
+	registerDelayedCallbackWrapper(func() {
+		s.emitUpdateRoomSettingsSignal(shared_mtv.NewUpdateRoomSettingsSignalArgs{
+			UserID: params.RoomCreatorUserID,
+			Settings: shared_mtv.MtvRoomSettingsUpdate{
+				IsOpen:                        &isOpen,
+				IsOpenOnlyInvitedUsersCanVote: &isOpenOnlyInvitedUsersCanVote,
+			},
+		})
+	}, updateWithInvalidSettings)
+
+	checkInvalidSettingsWereIgnored := defaultDuration
+	registerDelayedCallbackWrapper(func() {
+		mtvState := s.getMtvState(shared_mtv.NoRelatedUserID)
+
+		s.True(mtvState.IsOpen)
+		s.False(mtvState.IsOpenOnlyInvitedUsersCanVotes)
+	}, checkInvalidSettingsWereIgnored)
+
+	updateWithNegativeMinimumScore := defaultDuration
+	registerDelayedCallbackWrapper(func() {
+		s.emitUpdateRoomSettingsSignal(shared_mtv.NewUpdateRoomSettingsSignalArgs{
+			UserID: params.RoomCreatorUserID,
+			Settings: shared_mtv.MtvRoomSettingsUpdate{
+				MinimumScoreToBePlayed: &negativeMinimumScore,
+			},
+		})
+	}, updateWithNegativeMinimumScore)
+
+	checkNegativeMinimumScoreWasIgnored := defaultDuration
+	registerDelayedCallbackWrapper(func() {
+		mtvState := s.getMtvState(shared_mtv.NoRelatedUserID)
+
+		s.Equal(2, mtvState.MinimumScoreToBePlayed)
+		s.Nil(mtvState.CurrentTrack)
+	}, checkNegativeMinimumScoreWasIgnored)
+
+	lowerMinimumScoreToBePlayed := defaultDuration
+	registerDelayedCallbackWrapper(func() {
+		s.emitUpdateRoomSettingsSignal(shared_mtv.NewUpdateRoomSettingsSignalArgs{
+			UserID: params.RoomCreatorUserID,
+			Settings: shared_mtv.MtvRoomSettingsUpdate{
+				MinimumScoreToBePlayed: &minimumScoreToBePlayed,
+			},
+		})
+	}, lowerMinimumScoreToBePlayed)
+
+	checkReadyTrackStartedPlaying := defaultDuration
+	registerDelayedCallbackWrapper(func() {
+		mtvState := s.getMtvState(shared_mtv.NoRelatedUserID)
+
+		s.Equal(1, mtvState.MinimumScoreToBePlayed)
+		s.True(mtvState.Playing)
+		s.NotNil(mtvState.CurrentTrack)
+		s.Equal(tracks[0].ID, mtvState.CurrentTrack.ID)
+	}, checkReadyTrackStartedPlaying)
+
+	s.env.ExecuteWorkflow(MtvRoomWorkflow, params)
+
+	s.True(s.env.IsWorkflowCompleted())
+	err := s.env.GetWorkflowError()
+	s.ErrorIs(err, workflow.ErrDeadlineExceeded, "The workflow ran on an infinite loop")
+}
+
+func (s *UnitTestSuite) Test_UpdateRoomSettingsAfterTimeConstraintEnded() {
+	var (
+		a *activities_mtv.Activities
+
+		updatedRoomName = faker.Word()
+	)
+
+	tracks := []shared.TrackMetadata{
+		{
+			ID:         faker.UUIDHyphenated(),
+			Title:      faker.Word(),
+			ArtistName: faker.Name(),
+			Duration:   random.GenerateRandomDuration(),
+		},
+	}
+	defaultDuration := 1 * time.Millisecond
+	tracksIDs := []string{tracks[0].ID}
+	params, _ := getWorkflowInitParams(tracksIDs, 2)
+	start := time.Now()
+	end := start.Add(defaultDuration * 5000)
+
+	params.HasPhysicalAndTimeConstraints = true
+	params.PhysicalAndTimeConstraints = &shared_mtv.MtvRoomPhysicalAndTimeConstraints{
+		PhysicalConstraintPosition: shared_mtv.MtvRoomCoords{
+			Lat: 42,
+			Lng: 42,
+		},
+		PhysicalConstraintRadius:   5000,
+		PhysicalConstraintEndsAt:   end,
+		PhysicalConstraintStartsAt: start,
+	}
+	params.CreatorUserRelatedInformation.UserFitsPositionConstraint = &shared_mtv.TrueValue
+
+	resetMock, registerDelayedCallbackWrapper := s.initTestEnv()
+
+	defer resetMock()
+
+	s.env.OnActivity(
+		activities.FetchTracksInformationWithPolicyActivity,
+		mock.Anything,
+		fetchTracksInformationArgs(tracksIDs),
+	).Return(activities.FetchedTracksInformationWithInitiator{Metadata: tracks}, nil).Once()
+	s.env.OnActivity(
+		a.CreationAcknowledgementActivity,
+		mock.Anything,
+		mock.Anything,
+	).Return(nil).Once()
+	s.env.OnActivity(
+		a.AcknowledgeUpdateTimeConstraint,
+		mock.Anything,
+		mock.Anything,
+	).Return(nil).Once()
+	s.env.OnActivity(
+		a.AcknowledgeUpdateRoomSettings,
+		mock.Anything,
+		mock.Anything,
+	).Return(nil).Once()
+
+	// The time constraint is not checked again, it is not part of the update.
+	updateRoomNameAfterTimeConstraintEnded := defaultDuration * 6000
+	registerDelayedCallbackWrapper(func() {
+		s.emitUpdateRoomSettingsSignal(shared_mtv.NewUpdateRoomSettingsSignalArgs{
+			UserID: params.RoomCreatorUserID,
+			Settings: shared_mtv.MtvRoomSettingsUpdate{
+				RoomName: &updatedRoomName,
+			},
+		})
+	}, updateRoomNameAfterTimeConstraintEnded)
+
+	checkRoomNameWasUpdated := defaultDuration
+	registerDelayedCallbackWrapper(func() {
+		mtvState := s.getMtvState(shared_mtv.NoRelatedUserID)
+
+		s.Equal(updatedRoomName, mtvState.RoomName)
+		s.NotNil(mtvState.TimeConstraintIsValid)
+		s.False(*mtvState.TimeConstraintIsValid)
+	}, checkRoomNameWasUpdated)
+
+	s.env.ExecuteWorkflow(MtvRoomWorkflow, params)
+
+	s.True(s.env.IsWorkflowCompleted())
+	err := s.env.GetWorkflowError()
+	s.ErrorIs(err, workflow.ErrDeadlineExceeded, "The workflow ran on an infinite loop")
+}
+
+func (s *UnitTestSuite) Test_SuggestTracksLimits() {
+	var (
+		a *activities_mtv.Activities
+
+		firstUserID         = faker.UUIDHyphenated()
+		firstUserDeviceID   = faker.UUIDHyphenated()
+		secondUserID        = faker.UUIDHyphenated()
+		secondUserDeviceID  = faker.UUIDHyphenated()
+		suggestedTracksSize = 5
+	)
+
+	tracks := []shared.TrackMetadata{
+		{
+			ID:         faker.UUIDHyphenated(),
+			Title:      faker.Word(),
+			ArtistName: faker.Name(),
+			Duration:   random.GenerateRandomDuration(),
+		},
+	}
+	suggestedTracks := make([]shared.TrackMetadata, 0, suggestedTracksSize)
+	suggestedTracksIDs := make([]string, 0, suggestedTracksSize)
+	for i := 0; i < suggestedTracksSize; i++ {
+		track := shared.TrackMetadata{
+			ID:         faker.UUIDHyphenated(),
+			Title:      faker.Word(),
+			ArtistName: faker.Name(),
+			Duration:   random.GenerateRandomDuration(),
+		}
+
+		suggestedTracks = append(suggestedTracks, track)
+		suggestedTracksIDs = append(suggestedTracksIDs, track.ID)
+	}
+	tracksIDs := []string{tracks[0].ID}
+	params, _ := getWorkflowInitParams(tracksIDs, 1)
+	params.MaximumTracksListLength = 4
+	params.MaximumPendingSuggestionsPerUser = 3
+	params.MaximumSuggestionsPerUserPerMinute = 2
+
+	defaultDuration := 1 * time.Millisecond
+	resetMock, registerDelayedCallbackWrapper := s.initTestEnv()
+
+	defer resetMock()
+
+	s.env.OnActivity(
+		activities.FetchTracksInformationWithPolicyActivity,
+		mock.Anything,
+		fetchTracksInformationArgs(tracksIDs),
+	).Return(activities.FetchedTracksInformationWithInitiator{Metadata: tracks}, nil).Once()
+	s.env.OnActivity(
+		a.CreationAcknowledgementActivity,
+		mock.Anything,
+		mock.Anything,
+	).Return(nil).Once()
+	s.env.OnActivity(
+		a.JoinActivity,
+		mock.Anything,
+		mock.Anything,
+	).Return(nil).Twice()
+	for _, reason := range []shared_mtv.MtvRoomTracksSuggestionFailReason{
+		shared_mtv.MtvRoomTracksSuggestionFailReasonTooManyPendingSuggestions,
+		shared_mtv.MtvRoomTracksSuggestionFailReasonRateLimited,
+	} {
+		s.env.OnActivity(
+			a.AcknowledgeTracksSuggestionFail,
+			mock.Anything,
+			activities_mtv.AcknowledgeTracksSuggestionFailArgs{
+				DeviceID: firstUserDeviceID,
+				Reason:   reason,
+			},
+		).Return(nil).Once()
+	}
+	s.env.OnActivity(
+		a.AcknowledgeTracksSuggestionFail,
+		mock.Anything,
+		activities_mtv.AcknowledgeTracksSuggestionFailArgs{
+			DeviceID: secondUserDeviceID,
+			Reason:   shared_mtv.MtvRoomTracksSuggestionFailReasonTracksListIsFull,
+		},
+	).Return(nil).Once()
+	s.mockOnceSuggest(firstUserID, firstUserDeviceID, params.RoomID, suggestedTracks[:2])
+	s.mockOnceSuggest(firstUserID, firstUserDeviceID, params.RoomID, suggestedTracks[2:3])
+
+	joinRoom := defaultDuration
+	registerDelayedCallbackWrapper(func() {
+		s.emitJoinSignal(shared_mtv.NewJoinSignalArgs{
+			UserID:             firstUserID,
+			DeviceID:           firstUserDeviceID,
+			UserHasBeenInvited: false,
+		})
+		s.emitJoinSignal(shared_mtv.NewJoinSignalArgs{
+			UserID:             secondUserID,
+			DeviceID:           secondUserDeviceID,
+			UserHasBeenInvited: false,
+		})
+	}, joinRoom)
+
+	// A user can not have more than 3 tracks waiting to be played.
+	suggestTooManyTracks := defaultDuration
+	registerDelayedCallbackWrapper(func() {
+		s.emitSuggestTrackSignal(shared_mtv.SuggestTracksSignalArgs{
+			TracksToSuggest: suggestedTracksIDs[:4],
+			UserID:          firstUserID,
+			DeviceID:        firstUserDeviceID,
+		})
+	}, suggestTooManyTracks)
+
+	suggestTracks := defaultDuration
+	registerDelayedCallbackWrapper(func() {
+		s.emitSuggestTrackSignal(shared_mtv.SuggestTracksSignalArgs{
+			TracksToSuggest: suggestedTracksIDs[:2],
+			UserID:          firstUserID,
+			DeviceID:        firstUserDeviceID,
+		})
+	}, suggestTracks)
+
+	checkSuggestedTracksWereAdded := defaultDuration
+	registerDelayedCallbackWrapper(func() {
+		mtvState := s.getMtvState(shared_mtv.NoRelatedUserID)
+
+		s.Len(mtvState.Tracks, 2)
+	}, checkSuggestedTracksWereAdded)
+
+	// A user can not suggest more than 2 tracks per minute.
+	suggestTracksTooFast := defaultDuration
+	registerDelayedCallbackWrapper(func() {
+		s.emitSuggestTrackSignal(shared_mtv.SuggestTracksSignalArgs{
+			TracksToSuggest: suggestedTracksIDs[2:3],
+			UserID:          firstUserID,
+			DeviceID:        firstUserDeviceID,
+		})
+	}, suggestTracksTooFast)
+
+	// The tracks list can not contain more than 4 tracks.
+	fillTracksList := defaultDuration
+	registerDelayedCallbackWrapper(func() {
+		s.emitSuggestTrackSignal(shared_mtv.SuggestTracksSignalArgs{
+			TracksToSuggest: suggestedTracksIDs[2:],
+			UserID:          secondUserID,
+			DeviceID:        secondUserDeviceID,
+		})
+	}, fillTracksList)
+
+	checkRejectedSuggestionsWereNotAdded := defaultDuration
+	registerDelayedCallbackWrapper(func() {
+		mtvState := s.getMtvState(shared_mtv.NoRelatedUserID)
+
+		s.Len(mtvState.Tracks, 2)
+	}, checkRejectedSuggestionsWereNotAdded)
+
+	suggestTrackOnceRateLimitWindowIsOver := shared_mtv.SuggestionsRateLimitWindow
+	registerDelayedCallbackWrapper(func() {
+		s.emitSuggestTrackSignal(shared_mtv.SuggestTracksSignalArgs{
+			TracksToSuggest: suggestedTracksIDs[2:3],
+			UserID:          firstUserID,
+			DeviceID:        firstUserDeviceID,
+		})
+	}, suggestTrackOnceRateLimitWindowIsOver)
+
+	checkSuggestedTrackWasAdded := defaultDuration
+	registerDelayedCallbackWrapper(func() {
+		mtvState := s.getMtvState(shared_mtv.NoRelatedUserID)
+
+		s.Len(mtvState.Tracks, 3)
+	}, checkSuggestedTrackWasAdded)
+
+	s.env.ExecuteWorkflow(MtvRoomWorkflow, params)
+
+	s.True(s.env.IsWorkflowCompleted())
+	err := s.env.GetWorkflowError()
+	s.ErrorIs(err, workflow.ErrDeadlineExceeded, "The workflow ran on an infinite loop")
+}
+
+func (s *UnitTestSuite) Test_RemoveTracks() {
+	var (
+		a *activities_mtv.Activities
+
+		joiningUserID = faker.UUIDHyphenated()
+	)
+
+	tracks := []shared.TrackMetadata{
+		{
+			ID:         faker.UUIDHyphenated(),
+			Title:      faker.Word(),
+			ArtistName: faker.Name(),
+			Duration:   random.GenerateRandomDuration(),
+		},
+		{
+			ID:         faker.UUIDHyphenated(),
+			Title:      faker.Word(),
+			ArtistName: faker.Name(),
+			Duration:   random.GenerateRandomDuration(),
+		},
+		{
+			ID:         faker.UUIDHyphenated(),
+			Title:      faker.Word(),
+			ArtistName: faker.Name(),
+			Duration:   random.GenerateRandomDuration(),
+		},
+	}
+	tracksIDs := []string{tracks[0].ID, tracks[1].ID, tracks[2].ID}
+	params, _ := getWorkflowInitParams(tracksIDs, 1)
+
 	defaultDuration := 1 * time.Millisecond
-
 	resetMock, registerDelayedCallbackWrapper := s.initTestEnv()
 
 	defer resetMock()
 
 	s.env.OnActivity(
-		a.NotifySuggestOrVoteUpdateActivity,
+		activities.FetchTracksInformationWithPolicyActivity,
+		mock.Anything,
+		fetchTracksInformationArgs(tracksIDs),
+	).Return(activities.FetchedTracksInformationWithInitiator{Metadata: tracks}, nil).Once()
+	s.env.OnActivity(
+		a.CreationAcknowledgementActivity,
 		mock.Anything,
 		mock.Anything,
 	).Return(nil).Once()
 	s.env.OnActivity(
-		activities.FetchTracksInformationActivity,
+		a.JoinActivity,
 		mock.Anything,
-		tracksIDs,
-	).Return(tracks, nil).Once()
-	s.env.OnActivity(
-		a.CreationAcknowledgementActivity,
 		mock.Anything,
+	).Return(nil).Once()
+	s.env.OnActivity(
+		a.AcknowledgeTracksRemovedActivity,
 		mock.Anything,
+		mock.MatchedBy(func(args activities_mtv.AcknowledgeTracksRemovedArgs) bool {
+			return args.UserID == params.RoomCreatorUserID &&
+				len(args.RemovedTracksIDs) == 1 && args.RemovedTracksIDs[0] == tracks[2].ID &&
+				len(args.State.Tracks) == 1 && args.State.Tracks[0].ID == tracks[1].ID
+		}),
 	).Return(nil).Once()
+	// The acknowledgement already forwarded the updated tracks list.
+	s.env.OnActivity(
+		a.NotifySuggestOrVoteUpdateActivity,
+		mock.Anything,
+		mock.Anything,
+	).Return(nil).Never()
 
-	init := defaultDuration
-	registerDelayedCallbackWrapper(func() {
-		mtvState := s.getMtvState(params.RoomCreatorUserID)
-
-		//TracksVotedFor with 1 element shows that creator even with UserHasBeenInvited to false can still vote
-		expectedCreator := &shared_mtv.InternalStateUser{
-			UserID:                            params.RoomCreatorUserID,
-			DeviceID:                          creatorDeviceID,
-			TracksVotedFor:                    []string{tracksIDs[0]},
-			UserFitsPositionConstraint:        nil,
-			HasControlAndDelegationPermission: true,
-			UserHasBeenInvited:                false,
-		}
-
-		expectedTracks := []shared_mtv.TrackMetadataWithScoreWithDuration{
-			{
-				TrackMetadataWithScore: shared_mtv.TrackMetadataWithScore{
-					TrackMetadata: shared.TrackMetadata{
-						ID:         tracks[0].ID,
-						Title:      tracks[0].Title,
-						ArtistName: tracks[0].ArtistName,
-						Duration:   0,
-					},
-					Score: 1,
-				},
-				Duration: tracks[0].Duration.Milliseconds(),
-			},
-		}
-
-		var expectedExposedCurrentTrack *shared_mtv.ExposedCurrentTrack = nil
-
-		s.False(mtvState.Playing)
-		s.Nil(mtvState.TimeConstraintIsValid)
-		s.Equal(expectedExposedCurrentTrack, mtvState.CurrentTrack)
-		s.Equal(expectedCreator, mtvState.UserRelatedInformation)
-		s.Equal(expectedTracks, mtvState.Tracks)
-	}, init)
-
-	//Emit join for joiningUser
-	emitJoinSignalForJoiningUser := defaultDuration
+	joinRoom := defaultDuration
 	registerDelayedCallbackWrapper(func() {
-		args := shared_mtv.NewJoinSignalArgs{
-			DeviceID:           joiningUserDeviceID,
+		s.emitJoinSignal(shared_mtv.NewJoinSignalArgs{
 			UserID:             joiningUserID,
+			DeviceID:           faker.UUIDHyphenated(),
 			UserHasBeenInvited: false,
-		}
-		s.emitJoinSignal(args)
-	}, emitJoinSignalForJoiningUser)
+		})
+	}, joinRoom)
 
-	//Emit vote for track with joiningUser
-	emitVoteForJoiningUser := defaultDuration
+	voteForTrack := defaultDuration
 	registerDelayedCallbackWrapper(func() {
-		args := shared_mtv.NewVoteForTrackSignalArgs{
-			TrackID: tracksIDs[0],
+		s.emitVoteSignal(shared_mtv.NewVoteForTrackSignalArgs{
 			UserID:  joiningUserID,
-		}
-		s.emitVoteSignal(args)
-	}, emitVoteForJoiningUser)
+			TrackID: tracks[2].ID,
+		})
+	}, voteForTrack)
 
-	waitForVoteOrSuggestInterval := shared_mtv.CheckForVoteUpdateIntervalDuration + defaultDuration
+	// Users without control and delegation permission can not remove tracks.
+	removeTracksWithoutPermission := defaultDuration
 	registerDelayedCallbackWrapper(func() {
-		mtvState := s.getMtvState(params.RoomCreatorUserID)
-		expectedTracks := []shared_mtv.TrackMetadataWithScoreWithDuration{}
+		s.emitRemoveTracksSignal(shared_mtv.NewRemoveTracksSignalArgs{
+			UserID:    joiningUserID,
+			TracksIDs: []string{tracks[1].ID},
+		})
+	}, removeTracksWithoutPermission)
 
-		expectedExposedCurrentTrack := &shared_mtv.ExposedCurrentTrack{
-			CurrentTrack: shared_mtv.CurrentTrack{
-				TrackMetadataWithScore: shared_mtv.TrackMetadataWithScore{
-					TrackMetadata: shared.TrackMetadata{
-						ID:         tracks[0].ID,
-						ArtistName: tracks[0].ArtistName,
-						Title:      tracks[0].Title,
-						Duration:   0,
-					},
+	checkTracksWereNotRemoved := defaultDuration
+	registerDelayedCallbackWrapper(func() {
+		mtvState := s.getMtvState(joiningUserID)
 
-					Score: 2,
-				},
+		s.Len(mtvState.Tracks, 2)
+		s.Equal([]string{tracks[2].ID}, mtvState.UserRelatedInformation.TracksVotedFor)
+	}, checkTracksWereNotRemoved)
 
-				AlreadyElapsed: 0,
-			},
-			Duration: tracks[0].Duration.Milliseconds(),
-			Elapsed:  waitForVoteOrSuggestInterval.Milliseconds(),
-		}
+	// Unknown tracks and the current track are ignored.
+	removeTracks := defaultDuration
+	registerDelayedCallbackWrapper(func() {
+		s.emitRemoveTracksSignal(shared_mtv.NewRemoveTracksSignalArgs{
+			UserID:    params.RoomCreatorUserID,
+			TracksIDs: []string{tracks[2].ID, tracks[0].ID, faker.UUIDHyphenated()},
+		})
+	}, removeTracks)
 
-		s.True(mtvState.Playing)
-		s.Equal(expectedExposedCurrentTrack, mtvState.CurrentTrack)
-		s.Equal(expectedTracks, mtvState.Tracks)
-	}, waitForVoteOrSuggestInterval)
+	checkTracksWereRemoved := defaultDuration
+	registerDelayedCallbackWrapper(func() {
+		mtvState := s.getMtvState(joiningUserID)
+
+		s.Len(mtvState.Tracks, 1)
+		s.Equal(tracks[1].ID, mtvState.Tracks[0].ID)
+		s.Equal(tracks[0].ID, mtvState.CurrentTrack.ID)
+		s.Empty(mtvState.UserRelatedInformation.TracksVotedFor)
+	}, checkTracksWereRemoved)
 
 	s.env.ExecuteWorkflow(MtvRoomWorkflow, params)
 
@@ -4469,7 +7034,7 @@ func (s *UnitTestSuite) Test_SuggestOrVoteSentActivityBaseOnCurrentTrack() {
 	s.ErrorIs(err, workflow.ErrDeadlineExceeded, "The workflow ran on an infinite loop")
 }
 
-func (s *UnitTestSuite) Test_MtvRoomWithConstraintTimeIsValidStartBeforeNow() {
+func (s *UnitTestSuite) Test_RemovingLastQueuedTrackForwardsEmptiedTracksList() {
 	var a *activities_mtv.Activities
 
 	tracks := []shared.TrackMetadata{
@@ -4479,64 +7044,56 @@ func (s *UnitTestSuite) Test_MtvRoomWithConstraintTimeIsValidStartBeforeNow() {
 			ArtistName: faker.Name(),
 			Duration:   random.GenerateRandomDuration(),
 		},
-	}
-	defaultDuration := 1 * time.Millisecond
-	tracksIDs := []string{tracks[0].ID}
-	params, _ := getWorkflowInitParams(tracksIDs, 2)
-	start := time.Now()
-
-	end := start.Add(defaultDuration * 5000)
-
-	physicalAndTimeConstraints := shared_mtv.MtvRoomPhysicalAndTimeConstraints{
-		PhysicalConstraintPosition: shared_mtv.MtvRoomCoords{
-			Lat: 42,
-			Lng: 42,
+		{
+			ID:         faker.UUIDHyphenated(),
+			Title:      faker.Word(),
+			ArtistName: faker.Name(),
+			Duration:   random.GenerateRandomDuration(),
 		},
-		PhysicalConstraintRadius:   5000,
-		PhysicalConstraintEndsAt:   end,
-		PhysicalConstraintStartsAt: start,
 	}
-	params.HasPhysicalAndTimeConstraints = true
-	params.PhysicalAndTimeConstraints = &physicalAndTimeConstraints
-	params.CreatorUserRelatedInformation.UserFitsPositionConstraint = &shared_mtv.TrueValue
+	tracksIDs := []string{tracks[0].ID, tracks[1].ID}
+	params, _ := getWorkflowInitParams(tracksIDs, 1)
 
+	defaultDuration := 1 * time.Millisecond
 	resetMock, registerDelayedCallbackWrapper := s.initTestEnv()
 
 	defer resetMock()
 
 	s.env.OnActivity(
-		a.AcknowledgeUpdateTimeConstraint,
-		mock.Anything,
-		mock.Anything,
-	).Return(nil).Once()
-	s.env.OnActivity(
-		activities.FetchTracksInformationActivity,
+		activities.FetchTracksInformationWithPolicyActivity,
 		mock.Anything,
-		tracksIDs,
-	).Return(tracks, nil).Once()
+		fetchTracksInformationArgs(tracksIDs),
+	).Return(activities.FetchedTracksInformationWithInitiator{Metadata: tracks}, nil).Once()
 	s.env.OnActivity(
 		a.CreationAcknowledgementActivity,
 		mock.Anything,
 		mock.Anything,
 	).Return(nil).Once()
+	s.env.OnActivity(
+		a.AcknowledgeTracksRemovedActivity,
+		mock.Anything,
+		mock.MatchedBy(func(args activities_mtv.AcknowledgeTracksRemovedArgs) bool {
+			return args.UserID == params.RoomCreatorUserID &&
+				len(args.RemovedTracksIDs) == 1 && args.RemovedTracksIDs[0] == tracks[1].ID &&
+				len(args.State.Tracks) == 0
+		}),
+	).Return(nil).Once()
 
-	init := defaultDuration
+	removeTracks := defaultDuration
 	registerDelayedCallbackWrapper(func() {
-		mtvState := s.getMtvState(params.RoomCreatorUserID)
-
-		//TracksVotedFor with 1 element shows that creator even with UserHasBeenInvited to false can still vote
-		s.NotNil(mtvState.TimeConstraintIsValid)
-		s.True(*mtvState.TimeConstraintIsValid)
-	}, init)
+		s.emitRemoveTracksSignal(shared_mtv.NewRemoveTracksSignalArgs{
+			UserID:    params.RoomCreatorUserID,
+			TracksIDs: []string{tracks[1].ID},
+		})
+	}, removeTracks)
 
-	checkTimeConstraintBecomesFalsy := defaultDuration * 5000
+	checkTracksListIsEmpty := defaultDuration
 	registerDelayedCallbackWrapper(func() {
 		mtvState := s.getMtvState(params.RoomCreatorUserID)
 
-		//TracksVotedFor with 1 element shows that creator even with UserHasBeenInvited to false can still vote
-		s.NotNil(mtvState.TimeConstraintIsValid)
-		s.False(*mtvState.TimeConstraintIsValid)
-	}, checkTimeConstraintBecomesFalsy)
+		s.Empty(mtvState.Tracks)
+		s.Equal(tracks[0].ID, mtvState.CurrentTrack.ID)
+	}, checkTracksListIsEmpty)
 
 	s.env.ExecuteWorkflow(MtvRoomWorkflow, params)
 
@@ -4545,7 +7102,7 @@ func (s *UnitTestSuite) Test_MtvRoomWithConstraintTimeIsValidStartBeforeNow() {
 	s.ErrorIs(err, workflow.ErrDeadlineExceeded, "The workflow ran on an infinite loop")
 }
 
-func (s *UnitTestSuite) Test_MtvRoomWithConstraintTimeIsValidStartAfterNow() {
+func (s *UnitTestSuite) Test_SuggestTracksForwardsRejectedTracks() {
 	var a *activities_mtv.Activities
 
 	tracks := []shared.TrackMetadata{
@@ -4556,112 +7113,88 @@ func (s *UnitTestSuite) Test_MtvRoomWithConstraintTimeIsValidStartAfterNow() {
 			Duration:   random.GenerateRandomDuration(),
 		},
 	}
-	defaultDuration := 1 * time.Millisecond
 	tracksIDs := []string{tracks[0].ID}
-	params, _ := getWorkflowInitParams(tracksIDs, 2)
-	start := time.Now().Add(defaultDuration * 5000)
-
-	end := start.Add(defaultDuration * 500)
+	params, creatorDeviceID := getWorkflowInitParams(tracksIDs, 1)
 
-	physicalAndTimeConstraints := shared_mtv.MtvRoomPhysicalAndTimeConstraints{
-		PhysicalConstraintPosition: shared_mtv.MtvRoomCoords{
-			Lat: 42,
-			Lng: 42,
+	trackToSuggest := shared.TrackMetadata{
+		ID:         faker.UUIDHyphenated(),
+		Title:      faker.Word(),
+		ArtistName: faker.Name(),
+		Duration:   random.GenerateRandomDuration(),
+	}
+	rejectedTracks := []shared.RejectedTrack{
+		{
+			ID:     faker.UUIDHyphenated(),
+			Reason: shared.RejectedTrackReasonNotFound,
+		},
+		{
+			ID:     faker.UUIDHyphenated(),
+			Reason: shared.RejectedTrackReasonFetchFailed,
 		},
-		PhysicalConstraintRadius:   5000,
-		PhysicalConstraintEndsAt:   end,
-		PhysicalConstraintStartsAt: start,
 	}
-	params.HasPhysicalAndTimeConstraints = true
-	params.PhysicalAndTimeConstraints = &physicalAndTimeConstraints
-	params.CreatorUserRelatedInformation.UserFitsPositionConstraint = &shared_mtv.TrueValue
 
+	defaultDuration := 1 * time.Millisecond
 	resetMock, registerDelayedCallbackWrapper := s.initTestEnv()
 
 	defer resetMock()
 
 	s.env.OnActivity(
-		a.AcknowledgeUpdateTimeConstraint,
-		mock.Anything,
+		activities.FetchTracksInformationWithPolicyActivity,
 		mock.Anything,
-	).Return(nil).Twice()
+		fetchTracksInformationArgs(tracksIDs),
+	).Return(activities.FetchedTracksInformationWithInitiator{Metadata: tracks}, nil).Once()
 	s.env.OnActivity(
-		activities.FetchTracksInformationActivity,
+		a.CreationAcknowledgementActivity,
 		mock.Anything,
-		tracksIDs,
-	).Return(tracks, nil).Once()
+		mock.Anything,
+	).Return(nil).Once()
 	s.env.OnActivity(
-		a.CreationAcknowledgementActivity,
+		activities.FetchTracksInformationWithPolicyActivity,
 		mock.Anything,
+		fetchTracksInformationArgsForInitiator([]string{trackToSuggest.ID, rejectedTracks[0].ID, rejectedTracks[1].ID}, params.RoomCreatorUserID, creatorDeviceID),
+	).Return(activities.FetchedTracksInformationWithInitiator{
+		Metadata:       []shared.TrackMetadata{trackToSuggest},
+		RejectedTracks: rejectedTracks,
+		UserID:         params.RoomCreatorUserID,
+		DeviceID:       creatorDeviceID,
+	}, nil).Once()
+	s.env.OnActivity(
+		a.AcknowledgeTracksSuggestion,
 		mock.Anything,
+		mock.MatchedBy(func(args activities_mtv.AcknowledgeTracksSuggestionArgs) bool {
+			return args.DeviceID == creatorDeviceID &&
+				len(args.RejectedTracks) == 2 &&
+				args.RejectedTracks[0] == rejectedTracks[0] &&
+				args.RejectedTracks[1] == rejectedTracks[1]
+		}),
 	).Return(nil).Once()
 
-	init := defaultDuration
-	registerDelayedCallbackWrapper(func() {
-		mtvState := s.getMtvState(params.RoomCreatorUserID)
-
-		//TracksVotedFor with 1 element shows that creator even with UserHasBeenInvited to false can still vote
-		s.NotNil(mtvState.TimeConstraintIsValid)
-		s.False(*mtvState.TimeConstraintIsValid)
-	}, init)
-
-	checkTimeConstraintBecomesTruthy := defaultDuration * 5000
-	registerDelayedCallbackWrapper(func() {
-		mtvState := s.getMtvState(params.RoomCreatorUserID)
-
-		//TracksVotedFor with 1 element shows that creator even with UserHasBeenInvited to false can still vote
-		s.NotNil(mtvState.TimeConstraintIsValid)
-		s.True(*mtvState.TimeConstraintIsValid)
-	}, checkTimeConstraintBecomesTruthy)
-
-	checkTimeConstraintBecomesFalsy := defaultDuration * 5000
+	suggestTracks := defaultDuration
 	registerDelayedCallbackWrapper(func() {
-		mtvState := s.getMtvState(params.RoomCreatorUserID)
-
-		//TracksVotedFor with 1 element shows that creator even with UserHasBeenInvited to false can still vote
-		s.NotNil(mtvState.TimeConstraintIsValid)
-		s.False(*mtvState.TimeConstraintIsValid)
-	}, checkTimeConstraintBecomesFalsy)
-
-	s.env.ExecuteWorkflow(MtvRoomWorkflow, params)
-
-	s.True(s.env.IsWorkflowCompleted())
-	err := s.env.GetWorkflowError()
-	s.ErrorIs(err, workflow.ErrDeadlineExceeded, "The workflow ran on an infinite loop")
-}
-
-func (s *UnitTestSuite) Test_MtvRoomWithConstraintFailPhysicalAndTimeConstraintsNilButHasPhysicalAndTimeConstraintsTrue() {
-
-	tracks := []shared.TrackMetadata{
-		{
-			ID:         faker.UUIDHyphenated(),
-			Title:      faker.Word(),
-			ArtistName: faker.Name(),
-			Duration:   random.GenerateRandomDuration(),
-		},
-	}
-	tracksIDs := []string{tracks[0].ID}
-	params, _ := getWorkflowInitParams(tracksIDs, 2)
-
-	params.HasPhysicalAndTimeConstraints = true
-	params.PhysicalAndTimeConstraints = nil
-	params.CreatorUserRelatedInformation.UserFitsPositionConstraint = &shared_mtv.TrueValue
+		s.emitSuggestTrackSignal(shared_mtv.SuggestTracksSignalArgs{
+			TracksToSuggest: []string{trackToSuggest.ID, rejectedTracks[0].ID, rejectedTracks[1].ID},
+			UserID:          params.RoomCreatorUserID,
+			DeviceID:        creatorDeviceID,
+		})
+	}, suggestTracks)
 
-	resetMock, _ := s.initTestEnv()
+	checkOnlyResolvedTrackWasSuggested := defaultDuration
+	registerDelayedCallbackWrapper(func() {
+		mtvState := s.getMtvState(params.RoomCreatorUserID)
 
-	defer resetMock()
+		s.Len(mtvState.Tracks, 1)
+		s.Equal(trackToSuggest.ID, mtvState.Tracks[0].ID)
+	}, checkOnlyResolvedTrackWasSuggested)
 
 	s.env.ExecuteWorkflow(MtvRoomWorkflow, params)
 
 	s.True(s.env.IsWorkflowCompleted())
 	err := s.env.GetWorkflowError()
-	s.Error(err)
-	var applicationErr *temporal.ApplicationError
-	s.True(errors.As(err, &applicationErr))
-	s.Equal("PhysicalAndTimeConstraints nil but HasPhysicalAndTimeConstraints true", applicationErr.Error())
+	s.ErrorIs(err, workflow.ErrDeadlineExceeded, "The workflow ran on an infinite loop")
 }
 
-func (s *UnitTestSuite) Test_MtvRoomWithConstraintFailPhysicalAndTimeConstraintsSetButHasPhysicalAndTimeConstraintsFalse() {
+func (s *UnitTestSuite) Test_InitialTracksFetchingIsRetriedAfterQuotaReset() {
+	var a *activities_mtv.Activities
 
 	tracks := []shared.TrackMetadata{
 		{
@@ -4671,85 +7204,92 @@ func (s *UnitTestSuite) Test_MtvRoomWithConstraintFailPhysicalAndTimeConstraints
 			Duration:   random.GenerateRandomDuration(),
 		},
 	}
-	defaultDuration := 1 * time.Millisecond
 	tracksIDs := []string{tracks[0].ID}
-	params, _ := getWorkflowInitParams(tracksIDs, 2)
-	start := time.Now().Add(defaultDuration * 5000)
-
-	end := start.Add(defaultDuration * 500)
+	params, _ := getWorkflowInitParams(tracksIDs, 1)
 
-	physicalAndTimeConstraints := shared_mtv.MtvRoomPhysicalAndTimeConstraints{
-		PhysicalConstraintPosition: shared_mtv.MtvRoomCoords{
-			Lat: 42,
-			Lng: 42,
-		},
-		PhysicalConstraintRadius:   5000,
-		PhysicalConstraintEndsAt:   end,
-		PhysicalConstraintStartsAt: start,
-	}
-	params.HasPhysicalAndTimeConstraints = false
-	params.PhysicalAndTimeConstraints = &physicalAndTimeConstraints
-	params.CreatorUserRelatedInformation.UserFitsPositionConstraint = &shared_mtv.TrueValue
+	quotaResetDelay := time.Hour
+	quotaExceededError := temporal.NewApplicationError(
+		"quota exceeded",
+		activities.QuotaExceededErrorType,
+		time.Now().Add(quotaResetDelay),
+	)
+	quotaIsReset := false
 
-	resetMock, _ := s.initTestEnv()
+	resetMock, registerDelayedCallbackWrapper := s.initTestEnv()
 
 	defer resetMock()
 
+	s.env.OnActivity(
+		activities.FetchTracksInformationWithPolicyActivity,
+		mock.Anything,
+		fetchTracksInformationArgs(tracksIDs),
+	).Return(activities.FetchedTracksInformationWithInitiator{}, quotaExceededError).Once()
+	s.env.OnActivity(
+		activities.FetchTracksInformationWithPolicyActivity,
+		mock.Anything,
+		fetchTracksInformationArgs(tracksIDs),
+	).Return(activities.FetchedTracksInformationWithInitiator{Metadata: tracks}, nil).Once()
+	s.env.OnActivity(
+		a.CreationAcknowledgementActivity,
+		mock.Anything,
+		mock.MatchedBy(func(args activities_mtv.CreationAcknowledgementArgs) bool {
+			return quotaIsReset &&
+				args.MtvRoomExposedState.CurrentTrack != nil &&
+				args.MtvRoomExposedState.CurrentTrack.ID == tracks[0].ID &&
+				len(args.RejectedTracks) == 0
+		}),
+	).Return(nil).Once()
+
+	// The creation must not be acknowledged before the quota is reset.
+	resetQuota := quotaResetDelay - time.Second
+	registerDelayedCallbackWrapper(func() {
+		quotaIsReset = true
+	}, resetQuota)
+
 	s.env.ExecuteWorkflow(MtvRoomWorkflow, params)
 
 	s.True(s.env.IsWorkflowCompleted())
 	err := s.env.GetWorkflowError()
-	s.Error(err)
-	var applicationErr *temporal.ApplicationError
-	s.True(errors.As(err, &applicationErr))
-	s.Equal("PhysicalAndTimeConstraints set but HasPhysicalAndTimeConstraints false", applicationErr.Error())
+	s.ErrorIs(err, workflow.ErrDeadlineExceeded, "The workflow ran on an infinite loop")
 }
 
-func (s *UnitTestSuite) Test_MtvRoomWithConstraintFailStartIsAfterEnd() {
-
-	tracks := []shared.TrackMetadata{
-		{
-			ID:         faker.UUIDHyphenated(),
-			Title:      faker.Word(),
-			ArtistName: faker.Name(),
-			Duration:   random.GenerateRandomDuration(),
-		},
-	}
-	defaultDuration := 1 * time.Millisecond
-	tracksIDs := []string{tracks[0].ID}
-	params, _ := getWorkflowInitParams(tracksIDs, 2)
-	start := time.Now().Add(defaultDuration * 5000)
-
-	end := start.Add(defaultDuration * 500)
+func (s *UnitTestSuite) Test_InitialTracksFetchingFailureRejectsInitialTracks() {
+	var a *activities_mtv.Activities
 
-	physicalAndTimeConstraints := shared_mtv.MtvRoomPhysicalAndTimeConstraints{
-		PhysicalConstraintPosition: shared_mtv.MtvRoomCoords{
-			Lat: 42,
-			Lng: 42,
-		},
-		PhysicalConstraintRadius:   5000,
-		PhysicalConstraintEndsAt:   start,
-		PhysicalConstraintStartsAt: end,
-	}
-	params.HasPhysicalAndTimeConstraints = true
-	params.PhysicalAndTimeConstraints = &physicalAndTimeConstraints
-	params.CreatorUserRelatedInformation.UserFitsPositionConstraint = &shared_mtv.TrueValue
+	tracksIDs := []string{faker.UUIDHyphenated()}
+	params, _ := getWorkflowInitParams(tracksIDs, 1)
 
 	resetMock, _ := s.initTestEnv()
 
 	defer resetMock()
 
+	s.env.OnActivity(
+		activities.FetchTracksInformationWithPolicyActivity,
+		mock.Anything,
+		fetchTracksInformationArgs(tracksIDs),
+	).Return(activities.FetchedTracksInformationWithInitiator{}, temporal.NewApplicationError("invalid key", activities.InvalidAPIKeyErrorType)).Once()
+	s.env.OnActivity(
+		a.CreationAcknowledgementActivity,
+		mock.Anything,
+		mock.MatchedBy(func(args activities_mtv.CreationAcknowledgementArgs) bool {
+			return args.MtvRoomExposedState.CurrentTrack == nil &&
+				len(args.RejectedTracks) == 1 &&
+				args.RejectedTracks[0] == shared.RejectedTrack{
+					ID:     tracksIDs[0],
+					Reason: shared.RejectedTrackReasonFetchFailed,
+				}
+		}),
+	).Return(nil).Once()
+
 	s.env.ExecuteWorkflow(MtvRoomWorkflow, params)
 
 	s.True(s.env.IsWorkflowCompleted())
 	err := s.env.GetWorkflowError()
-	s.Error(err)
-	var applicationErr *temporal.ApplicationError
-	s.True(errors.As(err, &applicationErr))
-	s.Equal("start is after end", applicationErr.Error())
+	s.ErrorIs(err, workflow.ErrDeadlineExceeded, "The workflow ran on an infinite loop")
 }
 
-func (s *UnitTestSuite) Test_MtvRoomWithConstraintFailStartEqualEnd() {
+func (s *UnitTestSuite) Test_SuggestTracksFetchingFailureRejectsSuggestedTracks() {
+	var a *activities_mtv.Activities
 
 	tracks := []shared.TrackMetadata{
 		{
@@ -4759,41 +7299,74 @@ func (s *UnitTestSuite) Test_MtvRoomWithConstraintFailStartEqualEnd() {
 			Duration:   random.GenerateRandomDuration(),
 		},
 	}
-	defaultDuration := 1 * time.Millisecond
 	tracksIDs := []string{tracks[0].ID}
-	params, _ := getWorkflowInitParams(tracksIDs, 2)
-	start := time.Now().Add(defaultDuration * 5000)
+	params, creatorDeviceID := getWorkflowInitParams(tracksIDs, 1)
 
-	end := start.Add(defaultDuration * 500)
+	trackToSuggestID := faker.UUIDHyphenated()
 
-	physicalAndTimeConstraints := shared_mtv.MtvRoomPhysicalAndTimeConstraints{
-		PhysicalConstraintPosition: shared_mtv.MtvRoomCoords{
-			Lat: 42,
-			Lng: 42,
+	defaultDuration := 1 * time.Millisecond
+	resetMock, registerDelayedCallbackWrapper := s.initTestEnv()
+
+	defer resetMock()
+
+	s.env.OnActivity(
+		activities.FetchTracksInformationWithPolicyActivity,
+		mock.Anything,
+		fetchTracksInformationArgs(tracksIDs),
+	).Return(activities.FetchedTracksInformationWithInitiator{Metadata: tracks}, nil).Once()
+	s.env.OnActivity(
+		a.CreationAcknowledgementActivity,
+		mock.Anything,
+		mock.Anything,
+	).Return(nil).Once()
+	s.env.OnActivity(
+		activities.FetchTracksInformationWithPolicyActivity,
+		mock.Anything,
+		fetchTracksInformationArgsForInitiator([]string{trackToSuggestID}, params.RoomCreatorUserID, creatorDeviceID),
+	).Return(
+		activities.FetchedTracksInformationWithInitiator{},
+		temporal.NewApplicationError("quota exceeded", activities.QuotaExceededErrorType, time.Now().Add(time.Hour)),
+	).Once()
+	s.env.OnActivity(
+		a.AcknowledgeTracksSuggestionFail,
+		mock.Anything,
+		activities_mtv.AcknowledgeTracksSuggestionFailArgs{
+			DeviceID: creatorDeviceID,
+			Reason:   shared_mtv.MtvRoomTracksSuggestionFailReasonTracksRejected,
+			RejectedTracks: []shared.RejectedTrack{
+				{
+					ID:     trackToSuggestID,
+					Reason: shared.RejectedTrackReasonQuotaExceeded,
+				},
+			},
 		},
-		PhysicalConstraintRadius:   5000,
-		PhysicalConstraintEndsAt:   end,
-		PhysicalConstraintStartsAt: end,
-	}
-	params.HasPhysicalAndTimeConstraints = true
-	params.PhysicalAndTimeConstraints = &physicalAndTimeConstraints
-	params.CreatorUserRelatedInformation.UserFitsPositionConstraint = &shared_mtv.TrueValue
+	).Return(nil).Once()
 
-	resetMock, _ := s.initTestEnv()
+	suggestTracks := defaultDuration
+	registerDelayedCallbackWrapper(func() {
+		s.emitSuggestTrackSignal(shared_mtv.SuggestTracksSignalArgs{
+			TracksToSuggest: []string{trackToSuggestID},
+			UserID:          params.RoomCreatorUserID,
+			DeviceID:        creatorDeviceID,
+		})
+	}, suggestTracks)
 
-	defer resetMock()
+	checkNoTrackWasSuggested := defaultDuration
+	registerDelayedCallbackWrapper(func() {
+		mtvState := s.getMtvState(params.RoomCreatorUserID)
+
+		s.Empty(mtvState.Tracks)
+	}, checkNoTrackWasSuggested)
 
 	s.env.ExecuteWorkflow(MtvRoomWorkflow, params)
 
 	s.True(s.env.IsWorkflowCompleted())
 	err := s.env.GetWorkflowError()
-	s.Error(err)
-	var applicationErr *temporal.ApplicationError
-	s.True(errors.As(err, &applicationErr))
-	s.Equal("start equal end", applicationErr.Error())
+	s.ErrorIs(err, workflow.ErrDeadlineExceeded, "The workflow ran on an infinite loop")
 }
 
-func (s *UnitTestSuite) Test_MtvRoomWithConstraintFailEndIsBeforeNow() {
+func (s *UnitTestSuite) Test_SuggestTracksByQuery() {
+	var a *activities_mtv.Activities
 
 	tracks := []shared.TrackMetadata{
 		{
@@ -4804,83 +7377,101 @@ func (s *UnitTestSuite) Test_MtvRoomWithConstraintFailEndIsBeforeNow() {
 		},
 	}
 	tracksIDs := []string{tracks[0].ID}
-	params, _ := getWorkflowInitParams(tracksIDs, 2)
-	now := time.Now()
-	start := now.Add(time.Duration(-2) * time.Minute)
+	params, creatorDeviceID := getWorkflowInitParams(tracksIDs, 1)
 
-	end := now.Add(time.Duration(-1) * time.Minute)
-	physicalAndTimeConstraints := shared_mtv.MtvRoomPhysicalAndTimeConstraints{
-		PhysicalConstraintPosition: shared_mtv.MtvRoomCoords{
-			Lat: 42,
-			Lng: 42,
-		},
-		PhysicalConstraintRadius:   5000,
-		PhysicalConstraintEndsAt:   end,
-		PhysicalConstraintStartsAt: start,
+	trackToSuggest := shared.TrackMetadata{
+		ID:         faker.UUIDHyphenated(),
+		Title:      faker.Word(),
+		ArtistName: faker.Name(),
+		Duration:   random.GenerateRandomDuration(),
 	}
-	params.HasPhysicalAndTimeConstraints = true
-	params.PhysicalAndTimeConstraints = &physicalAndTimeConstraints
-	params.CreatorUserRelatedInformation.UserFitsPositionConstraint = &shared_mtv.TrueValue
+	query := trackToSuggest.ArtistName + " " + trackToSuggest.Title
 
-	resetMock, _ := s.initTestEnv()
+	defaultDuration := 1 * time.Millisecond
+	resetMock, registerDelayedCallbackWrapper := s.initTestEnv()
 
 	defer resetMock()
 
-	s.env.ExecuteWorkflow(MtvRoomWorkflow, params)
+	s.env.OnActivity(
+		activities.FetchTracksInformationWithPolicyActivity,
+		mock.Anything,
+		fetchTracksInformationArgs(tracksIDs),
+	).Return(activities.FetchedTracksInformationWithInitiator{Metadata: tracks}, nil).Once()
+	s.env.OnActivity(
+		a.CreationAcknowledgementActivity,
+		mock.Anything,
+		mock.Anything,
+	).Return(nil).Once()
+	s.env.OnActivity(
+		activities.SearchTopTrackActivityAndForwardInitiator,
+		mock.Anything,
+		query,
+		params.RoomCreatorUserID,
+		creatorDeviceID,
+		mock.Anything,
+	).Return(activities.FetchedTracksInformationWithInitiator{
+		Metadata:       []shared.TrackMetadata{trackToSuggest},
+		RejectedTracks: []shared.RejectedTrack{},
+		UserID:         params.RoomCreatorUserID,
+		DeviceID:       creatorDeviceID,
+	}, nil).Twice()
+	s.env.OnActivity(
+		a.AcknowledgeTracksSuggestion,
+		mock.Anything,
+		mock.Anything,
+	).Return(nil).Once()
+	// The second search finds the track the user already suggested
+	s.env.OnActivity(
+		a.AcknowledgeTracksSuggestionFail,
+		mock.Anything,
+		activities_mtv.AcknowledgeTracksSuggestionFailArgs{
+			DeviceID: creatorDeviceID,
+		},
+	).Return(nil).Once()
 
-	s.True(s.env.IsWorkflowCompleted())
-	err := s.env.GetWorkflowError()
-	s.Error(err)
-	var applicationErr *temporal.ApplicationError
-	s.True(errors.As(err, &applicationErr))
-	s.Equal("end is before now", applicationErr.Error())
-}
+	suggestTracks := defaultDuration
+	registerDelayedCallbackWrapper(func() {
+		s.emitSuggestTrackSignal(shared_mtv.SuggestTracksSignalArgs{
+			Query:    query,
+			UserID:   params.RoomCreatorUserID,
+			DeviceID: creatorDeviceID,
+		})
+	}, suggestTracks)
 
-func (s *UnitTestSuite) Test_MtvRoomWithConstraintFailEndEqualNow() {
+	checkTopHitWasSuggested := defaultDuration
+	registerDelayedCallbackWrapper(func() {
+		mtvState := s.getMtvState(params.RoomCreatorUserID)
 
-	tracks := []shared.TrackMetadata{
-		{
-			ID:         faker.UUIDHyphenated(),
-			Title:      faker.Word(),
-			ArtistName: faker.Name(),
-			Duration:   random.GenerateRandomDuration(),
-		},
-	}
-	tracksIDs := []string{tracks[0].ID}
-	params, _ := getWorkflowInitParams(tracksIDs, 2)
-	//mocking now
-	resetMock, _ := s.initTestEnv()
-	///
+		s.Len(mtvState.Tracks, 1)
+		s.Equal(trackToSuggest.ID, mtvState.Tracks[0].ID)
+	}, checkTopHitWasSuggested)
 
-	now := TimeWrapper()
-	start := now.Add(time.Duration(-2) * time.Minute)
-	physicalAndTimeConstraints := shared_mtv.MtvRoomPhysicalAndTimeConstraints{
-		PhysicalConstraintPosition: shared_mtv.MtvRoomCoords{
-			Lat: 42,
-			Lng: 42,
-		},
-		PhysicalConstraintRadius:   5000,
-		PhysicalConstraintEndsAt:   now,
-		PhysicalConstraintStartsAt: start,
-	}
-	params.HasPhysicalAndTimeConstraints = true
-	params.PhysicalAndTimeConstraints = &physicalAndTimeConstraints
-	params.CreatorUserRelatedInformation.UserFitsPositionConstraint = &shared_mtv.TrueValue
+	suggestSameQuery := defaultDuration
+	registerDelayedCallbackWrapper(func() {
+		s.emitSuggestTrackSignal(shared_mtv.SuggestTracksSignalArgs{
+			Query:    query,
+			UserID:   params.RoomCreatorUserID,
+			DeviceID: creatorDeviceID,
+		})
+	}, suggestSameQuery)
 
-	defer resetMock()
+	checkTrackWasNotAddedTwice := defaultDuration
+	registerDelayedCallbackWrapper(func() {
+		mtvState := s.getMtvState(params.RoomCreatorUserID)
+
+		s.Len(mtvState.Tracks, 1)
+	}, checkTrackWasNotAddedTwice)
 
 	s.env.ExecuteWorkflow(MtvRoomWorkflow, params)
 
 	s.True(s.env.IsWorkflowCompleted())
 	err := s.env.GetWorkflowError()
-	s.Error(err)
-	var applicationErr *temporal.ApplicationError
-	s.True(errors.As(err, &applicationErr))
-	s.Equal("end equal now", applicationErr.Error())
-
+	s.ErrorIs(err, workflow.ErrDeadlineExceeded, "The workflow ran on an infinite loop")
+	s.env.AssertExpectations(s.T())
 }
 
-func (s *UnitTestSuite) Test_MtvRoomFailPlayingModeIsInvalid() {
+func (s *UnitTestSuite) Test_SuggestOnlyUnplayableTracksFails() {
+	var a *activities_mtv.Activities
 
 	tracks := []shared.TrackMetadata{
 		{
@@ -4891,56 +7482,164 @@ func (s *UnitTestSuite) Test_MtvRoomFailPlayingModeIsInvalid() {
 		},
 	}
 	tracksIDs := []string{tracks[0].ID}
-	params, _ := getWorkflowInitParams(tracksIDs, 2)
-	//mocking now
-	resetMock, _ := s.initTestEnv()
-	///
-	params.PlayingMode = "UNKNOWN_PLAYING_MODE"
+	params, creatorDeviceID := getWorkflowInitParams(tracksIDs, 1)
+	params.PlayabilityPolicy = shared.TrackPlayabilityPolicy{
+		AllowNotEmbeddable: true,
+		Region:             "FR",
+	}
+
+	rejectedTracks := []shared.RejectedTrack{
+		{
+			ID:     faker.UUIDHyphenated(),
+			Reason: shared.RejectedTrackReasonLiveBroadcast,
+		},
+	}
+
+	defaultDuration := 1 * time.Millisecond
+	resetMock, registerDelayedCallbackWrapper := s.initTestEnv()
 
 	defer resetMock()
 
+	s.env.OnActivity(
+		activities.FetchTracksInformationWithPolicyActivity,
+		mock.Anything,
+		fetchTracksInformationArgs(tracksIDs),
+	).Return(activities.FetchedTracksInformationWithInitiator{Metadata: tracks}, nil).Once()
+	s.env.OnActivity(
+		a.CreationAcknowledgementActivity,
+		mock.Anything,
+		mock.Anything,
+	).Return(nil).Once()
+	s.env.OnActivity(
+		activities.FetchTracksInformationWithPolicyActivity,
+		mock.Anything,
+		activities.FetchTracksInformationArgs{
+			TracksIDs: []string{rejectedTracks[0].ID},
+			UserID:    params.RoomCreatorUserID,
+			DeviceID:  creatorDeviceID,
+			Policy:    params.PlayabilityPolicy,
+		},
+	).Return(activities.FetchedTracksInformationWithInitiator{
+		Metadata:       []shared.TrackMetadata{},
+		RejectedTracks: rejectedTracks,
+		UserID:         params.RoomCreatorUserID,
+		DeviceID:       creatorDeviceID,
+	}, nil).Once()
+	s.env.OnActivity(
+		a.AcknowledgeTracksSuggestionFail,
+		mock.Anything,
+		activities_mtv.AcknowledgeTracksSuggestionFailArgs{
+			DeviceID:       creatorDeviceID,
+			Reason:         shared_mtv.MtvRoomTracksSuggestionFailReasonTracksRejected,
+			RejectedTracks: rejectedTracks,
+		},
+	).Return(nil).Once()
+
+	suggestTracks := defaultDuration
+	registerDelayedCallbackWrapper(func() {
+		s.emitSuggestTrackSignal(shared_mtv.SuggestTracksSignalArgs{
+			TracksToSuggest: []string{rejectedTracks[0].ID},
+			UserID:          params.RoomCreatorUserID,
+			DeviceID:        creatorDeviceID,
+		})
+	}, suggestTracks)
+
+	checkNoTrackWasSuggested := defaultDuration
+	registerDelayedCallbackWrapper(func() {
+		mtvState := s.getMtvState(params.RoomCreatorUserID)
+
+		s.Empty(mtvState.Tracks)
+	}, checkNoTrackWasSuggested)
+
 	s.env.ExecuteWorkflow(MtvRoomWorkflow, params)
 
 	s.True(s.env.IsWorkflowCompleted())
 	err := s.env.GetWorkflowError()
-	s.Error(err)
-	var applicationErr *temporal.ApplicationError
-	s.True(errors.As(err, &applicationErr))
-	s.Equal("PlayingMode is invalid", applicationErr.Error())
+	s.ErrorIs(err, workflow.ErrDeadlineExceeded, "The workflow ran on an infinite loop")
 }
 
-func (s *UnitTestSuite) Test_MtvRoomFailIsOpenOnlyInvitedUsersCanVoteTrueButIsOpenFalse() {
+func (s *UnitTestSuite) Test_UnplayableInitialTracksAreRejectedAtCreation() {
+	var a *activities_mtv.Activities
 
-	tracks := []shared.TrackMetadata{
+	playableTrack := shared.TrackMetadata{
+		ID:         faker.UUIDHyphenated(),
+		Title:      faker.Word(),
+		ArtistName: faker.Name(),
+		Duration:   random.GenerateRandomDuration(),
+	}
+	rejectedTracks := []shared.RejectedTrack{
 		{
-			ID:         faker.UUIDHyphenated(),
-			Title:      faker.Word(),
-			ArtistName: faker.Name(),
-			Duration:   random.GenerateRandomDuration(),
+			ID:     faker.UUIDHyphenated(),
+			Reason: shared.RejectedTrackReasonZeroDuration,
 		},
 	}
-	tracksIDs := []string{tracks[0].ID}
-	params, _ := getWorkflowInitParams(tracksIDs, 2)
-	//mocking now
-	resetMock, _ := s.initTestEnv()
-	///
-	params.IsOpen = false
-	params.IsOpenOnlyInvitedUsersCanVote = true
+	tracksIDs := []string{rejectedTracks[0].ID, playableTrack.ID}
+	params, _ := getWorkflowInitParams(tracksIDs, 1)
+	params.PlayabilityPolicy = shared.TrackPlayabilityPolicy{
+		Region: "FR",
+	}
+
+	defaultDuration := 1 * time.Millisecond
+	resetMock, registerDelayedCallbackWrapper := s.initTestEnv()
 
 	defer resetMock()
 
+	s.env.OnActivity(
+		activities.FetchTracksInformationWithPolicyActivity,
+		mock.Anything,
+		activities.FetchTracksInformationArgs{
+			TracksIDs: tracksIDs,
+			UserID:    params.RoomCreatorUserID,
+			Policy:    params.PlayabilityPolicy,
+		},
+	).Return(activities.FetchedTracksInformationWithInitiator{
+		Metadata:       []shared.TrackMetadata{playableTrack},
+		RejectedTracks: rejectedTracks,
+		UserID:         params.RoomCreatorUserID,
+	}, nil).Once()
+	s.env.OnActivity(
+		a.CreationAcknowledgementActivity,
+		mock.Anything,
+		mock.MatchedBy(func(args activities_mtv.CreationAcknowledgementArgs) bool {
+			return args.RoomID == params.RoomID &&
+				args.CurrentTrack != nil &&
+				args.CurrentTrack.ID == playableTrack.ID &&
+				len(args.RejectedTracks) == 1 &&
+				args.RejectedTracks[0] == rejectedTracks[0]
+		}),
+	).Return(nil).Once()
+	s.env.OnActivity(
+		a.PauseActivity,
+		mock.Anything,
+		mock.Anything,
+	).Return(nil).Once()
+
+	checkOnlyPlayableTrackIsKept := defaultDuration
+	registerDelayedCallbackWrapper(func() {
+		mtvState := s.getMtvState(params.RoomCreatorUserID)
+
+		s.False(mtvState.Playing)
+		s.Equal(playableTrack.ID, mtvState.CurrentTrack.ID)
+		s.Empty(mtvState.Tracks)
+	}, checkOnlyPlayableTrackIsKept)
+
 	s.env.ExecuteWorkflow(MtvRoomWorkflow, params)
 
 	s.True(s.env.IsWorkflowCompleted())
 	err := s.env.GetWorkflowError()
-	s.Error(err)
-	var applicationErr *temporal.ApplicationError
-	s.True(errors.As(err, &applicationErr))
-	s.Equal("IsOpenOnlyInvitedUsersCanVote true but IsOpen false", applicationErr.Error())
+	s.ErrorIs(err, workflow.ErrDeadlineExceeded, "The workflow ran on an infinite loop")
 }
 
-func (s *UnitTestSuite) Test_UserOutsideRoomAreaSuggestingTrackMustTriggerTracksListUpdate() {
-	var a *activities_mtv.Activities
+func (s *UnitTestSuite) Test_MtvRoomExpiresOnceEmptyForTooLong() {
+	var (
+		a *activities_mtv.Activities
+
+		joiningUserID   = faker.UUIDHyphenated()
+		joiningDeviceID = faker.UUIDHyphenated()
+
+		defaultDuration  = 1 * time.Millisecond
+		emptyRoomTimeout = 5 * time.Minute
+	)
 
 	tracks := []shared.TrackMetadata{
 		{
@@ -4951,160 +7650,140 @@ func (s *UnitTestSuite) Test_UserOutsideRoomAreaSuggestingTrackMustTriggerTracks
 		},
 	}
 	tracksIDs := []string{tracks[0].ID}
-	tracksIDsToSuggest := []string{faker.UUIDHyphenated()}
-	tracksToSuggestMetadata := []shared.TrackMetadata{
-		{
-			ID:         tracksIDsToSuggest[0],
-			Title:      faker.Word(),
-			ArtistName: faker.Name(),
-			Duration:   random.GenerateRandomDuration(),
-		},
-	}
-
-	params, creatorDeviceID := getWorkflowInitParams(tracksIDs, 1)
-	defaultDuration := 1 * time.Millisecond
-
-	start := time.Now()
-
-	end := start.Add(defaultDuration * 5000)
-
-	physicalAndTimeConstraints := shared_mtv.MtvRoomPhysicalAndTimeConstraints{
-		PhysicalConstraintPosition: shared_mtv.MtvRoomCoords{
-			Lat: 42,
-			Lng: 42,
-		},
-		PhysicalConstraintRadius:   5000,
-		PhysicalConstraintEndsAt:   end,
-		PhysicalConstraintStartsAt: start,
+	params, _ := getWorkflowInitParams(tracksIDs, 1)
+	params.IdlePolicy = shared.RoomIdlePolicy{
+		EmptyRoomTimeout: emptyRoomTimeout,
 	}
 
-	params.HasPhysicalAndTimeConstraints = true
-	params.PhysicalAndTimeConstraints = &physicalAndTimeConstraints
-	params.CreatorUserRelatedInformation.UserFitsPositionConstraint = &shared_mtv.FalseValue
-	creatorUserID := params.RoomCreatorUserID
-
 	resetMock, registerDelayedCallbackWrapper := s.initTestEnv()
 
 	defer resetMock()
 
 	s.env.OnActivity(
-		activities.FetchTracksInformationActivity,
+		activities.FetchTracksInformationWithPolicyActivity,
 		mock.Anything,
-		tracksIDs,
-	).Return(tracks, nil).Once()
-
+		fetchTracksInformationArgs(tracksIDs),
+	).Return(activities.FetchedTracksInformationWithInitiator{Metadata: tracks}, nil).Once()
 	s.env.OnActivity(
-		activities.FetchTracksInformationActivityAndForwardInitiator,
+		a.CreationAcknowledgementActivity,
 		mock.Anything,
-		tracksIDsToSuggest,
-		creatorUserID,
-		creatorDeviceID,
-	).Return(activities.FetchedTracksInformationWithInitiator{
-		Metadata: tracksToSuggestMetadata,
-		UserID:   creatorUserID,
-		DeviceID: creatorDeviceID,
-	}, nil).Once()
-
+		mock.Anything,
+	).Return(nil).Once()
 	s.env.OnActivity(
-		a.CreationAcknowledgementActivity,
+		a.JoinActivity,
 		mock.Anything,
 		mock.Anything,
 	).Return(nil).Once()
-
-	// This activity must be called when a user outside of the
-	// room area suggests a song.
 	s.env.OnActivity(
-		a.NotifySuggestOrVoteUpdateActivity,
+		a.LeaveActivity,
+		mock.Anything,
+		mock.Anything,
+	).Return(nil).Twice()
+	s.env.OnActivity(
+		a.UserLengthUpdateActivity,
+		mock.Anything,
 		mock.Anything,
+	).Return(nil).Times(3)
+	s.env.OnActivity(
+		a.RoomExpiredActivity,
 		mock.Anything,
+		activities_mtv.RoomExpiredActivityArgs{
+			RoomID: params.RoomID,
+			Reason: shared.RoomExpirationReasonEmpty,
+		},
 	).Return(nil).Once()
 
-	init := defaultDuration
+	creatorLeavesRoom := defaultDuration
 	registerDelayedCallbackWrapper(func() {
-		expectedCreator := &shared_mtv.InternalStateUser{
-			UserID:                            params.RoomCreatorUserID,
-			DeviceID:                          creatorDeviceID,
-			TracksVotedFor:                    []string{},
-			UserFitsPositionConstraint:        &shared_mtv.FalseValue,
-			HasControlAndDelegationPermission: true,
-		}
-		expectedTracks := []shared_mtv.TrackMetadataWithScoreWithDuration{
-			{
-				TrackMetadataWithScore: shared_mtv.TrackMetadataWithScore{
-					TrackMetadata: shared.TrackMetadata{
-						ID:         tracks[0].ID,
-						Title:      tracks[0].Title,
-						ArtistName: tracks[0].ArtistName,
-						Duration:   0,
-					},
-					Score: 0,
-				},
-				Duration: tracks[0].Duration.Milliseconds(),
-			},
-		}
-
-		mtvState := s.getMtvState(params.RoomCreatorUserID)
-
-		s.False(mtvState.Playing)
-		s.Equal(1, mtvState.MinimumScoreToBePlayed)
-		s.Equal(expectedCreator, mtvState.UserRelatedInformation)
-		s.Equal(expectedTracks, mtvState.Tracks)
-		s.True(mtvState.RoomHasTimeAndPositionConstraints)
-	}, init)
+		s.emitLeaveSignal(params.RoomCreatorUserID)
+	}, creatorLeavesRoom)
 
-	creatorSuggestsATrack := defaultDuration
+	// Joining the room before the timeout cancels the expiration.
+	userJoinsRoom := emptyRoomTimeout - time.Minute
 	registerDelayedCallbackWrapper(func() {
-		s.emitSuggestTrackSignal(shared_mtv.SuggestTracksSignalArgs{
-			TracksToSuggest: tracksIDsToSuggest,
-			UserID:          creatorUserID,
-			DeviceID:        creatorDeviceID,
+		s.emitJoinSignal(shared_mtv.NewJoinSignalArgs{
+			UserID:             joiningUserID,
+			DeviceID:           joiningDeviceID,
+			UserHasBeenInvited: false,
 		})
-	}, creatorSuggestsATrack)
+	}, userJoinsRoom)
 
-	checkSongHasBeenSuggested := defaultDuration
+	checkRoomHasNotExpired := 2 * time.Minute
 	registerDelayedCallbackWrapper(func() {
-		expectedTracks := []shared_mtv.TrackMetadataWithScoreWithDuration{
-			{
-				TrackMetadataWithScore: shared_mtv.TrackMetadataWithScore{
-					TrackMetadata: shared.TrackMetadata{
-						ID:         tracks[0].ID,
-						Title:      tracks[0].Title,
-						ArtistName: tracks[0].ArtistName,
-						Duration:   0,
-					},
-					Score: 0,
-				},
-				Duration: tracks[0].Duration.Milliseconds(),
-			},
+		s.False(s.env.IsWorkflowCompleted())
 
-			// Song suggested by the creator
-			{
-				TrackMetadataWithScore: shared_mtv.TrackMetadataWithScore{
-					TrackMetadata: shared.TrackMetadata{
-						ID:         tracksToSuggestMetadata[0].ID,
-						Title:      tracksToSuggestMetadata[0].Title,
-						ArtistName: tracksToSuggestMetadata[0].ArtistName,
-						Duration:   0,
-					},
-					Score: 0,
-				},
-				Duration: tracksToSuggestMetadata[0].Duration.Milliseconds(),
-			},
-		}
+		mtvState := s.getMtvState(shared_mtv.NoRelatedUserID)
+		s.Equal(1, mtvState.UsersLength)
+	}, checkRoomHasNotExpired)
+
+	userLeavesRoom := defaultDuration
+	registerDelayedCallbackWrapper(func() {
+		s.emitLeaveSignal(joiningUserID)
+	}, userLeavesRoom)
+
+	s.env.ExecuteWorkflow(MtvRoomWorkflow, params)
+
+	s.True(s.env.IsWorkflowCompleted())
+	s.NoError(s.env.GetWorkflowError())
+}
+
+func (s *UnitTestSuite) Test_MtvRoomRestoredFromSnapshotExpiresAfterRemainingInactivityTimeout() {
+	var (
+		a *activities_mtv.Activities
+
+		inactivityTimeout           = 30 * time.Minute
+		inactiveBeforeContinueAsNew = 20 * time.Minute
+	)
+
+	currentTrack := shared_mtv.TrackMetadataWithScore{
+		TrackMetadata: shared.TrackMetadata{
+			ID:         faker.UUIDHyphenated(),
+			Title:      faker.Word(),
+			ArtistName: faker.Name(),
+			Duration:   random.GenerateRandomDuration(),
+		},
+	}
+	params, _ := getWorkflowInitParams([]string{currentTrack.ID}, 1)
+	params.IdlePolicy = shared.RoomIdlePolicy{
+		InactivityTimeout: inactivityTimeout,
+	}
 
-		mtvState := s.getMtvState(params.RoomCreatorUserID)
+	startTime := time.Now()
+	s.env.SetStartTime(startTime)
+	params.Snapshot = &shared_mtv.MtvRoomStateSnapshot{
+		Users: map[string]*shared_mtv.InternalStateUser{
+			params.RoomCreatorUserID: params.CreatorUserRelatedInformation,
+		},
+		Tracks: []shared_mtv.TrackMetadataWithScore{},
+		CurrentTrack: shared_mtv.CurrentTrackSnapshot{
+			TrackMetadataWithScore: currentTrack,
+		},
+		IdleClocks: shared.RoomIdleClocks{
+			LastActivityAt: startTime.Add(-inactiveBeforeContinueAsNew),
+		},
+	}
 
-		s.Equal(expectedTracks, mtvState.Tracks)
-	}, checkSongHasBeenSuggested)
+	resetMock, _ := s.initTestEnv()
+
+	defer resetMock()
+
+	s.env.OnActivity(
+		a.RoomExpiredActivity,
+		mock.Anything,
+		activities_mtv.RoomExpiredActivityArgs{
+			RoomID: params.RoomID,
+			Reason: shared.RoomExpirationReasonInactive,
+		},
+	).Return(nil).Once()
 
 	s.env.ExecuteWorkflow(MtvRoomWorkflow, params)
 
 	s.True(s.env.IsWorkflowCompleted())
-	err := s.env.GetWorkflowError()
-	s.ErrorIs(err, workflow.ErrDeadlineExceeded, "The workflow ran on an infinite loop")
+	s.NoError(s.env.GetWorkflowError())
+	s.Equal(inactivityTimeout-inactiveBeforeContinueAsNew, s.env.Now().Sub(startTime))
 }
 
-func (s *UnitTestSuite) Test_GetMtvRoomConstraintsDetails() {
+func (s *UnitTestSuite) Test_AutoDJQueuesSystemTracksWhenTracksListRunsDry() {
 	var a *activities_mtv.Activities
 
 	tracks := []shared.TrackMetadata{
@@ -5116,68 +7795,105 @@ func (s *UnitTestSuite) Test_GetMtvRoomConstraintsDetails() {
 		},
 	}
 	tracksIDs := []string{tracks[0].ID}
-
 	params, _ := getWorkflowInitParams(tracksIDs, 1)
-	defaultDuration := 1 * time.Millisecond
-
-	start := time.Now()
-
-	end := start.Add(defaultDuration * 5000)
-
-	physicalAndTimeConstraints := shared_mtv.MtvRoomPhysicalAndTimeConstraints{
-		PhysicalConstraintPosition: shared_mtv.MtvRoomCoords{
-			Lat: 42,
-			Lng: 42,
-		},
-		PhysicalConstraintRadius:   5000,
-		PhysicalConstraintEndsAt:   end,
-		PhysicalConstraintStartsAt: start,
+	params.AutoDJ = &shared_mtv.MtvRoomAutoDJOptions{
+		Source:            shared_mtv.MtvRoomAutoDJSourceFallbackTracks,
+		FallbackTracksIDs: []string{faker.UUIDHyphenated()},
+		BatchSize:         1,
 	}
 
-	params.HasPhysicalAndTimeConstraints = true
-	params.PhysicalAndTimeConstraints = &physicalAndTimeConstraints
-	params.CreatorUserRelatedInformation.UserFitsPositionConstraint = &shared_mtv.FalseValue
+	systemTrack := shared.TrackMetadata{
+		ID:         params.AutoDJ.FallbackTracksIDs[0],
+		Title:      faker.Word(),
+		ArtistName: faker.Name(),
+		Duration:   random.GenerateRandomDuration(),
+	}
 
+	defaultDuration := 1 * time.Millisecond
 	resetMock, registerDelayedCallbackWrapper := s.initTestEnv()
 
 	defer resetMock()
 
 	s.env.OnActivity(
-		activities.FetchTracksInformationActivity,
+		activities.FetchTracksInformationWithPolicyActivity,
 		mock.Anything,
-		tracksIDs,
-	).Return(tracks, nil).Once()
+		fetchTracksInformationArgs(tracksIDs),
+	).Return(activities.FetchedTracksInformationWithInitiator{Metadata: tracks}, nil).Once()
 	s.env.OnActivity(
 		a.CreationAcknowledgementActivity,
 		mock.Anything,
 		mock.Anything,
 	).Return(nil).Once()
+	// The first track became the current track, the tracks list is empty
+	s.env.OnActivity(
+		activities.FetchAutoDJTracksActivity,
+		mock.Anything,
+		activities.FetchAutoDJTracksArgs{
+			Options:         *params.AutoDJ,
+			CurrentTrack:    tracks[0],
+			QueuedTracksIDs: []string{},
+			PlayedTracksIDs: []string{},
+			Policy:          params.PlayabilityPolicy,
+			CreatorUserID:   params.RoomCreatorUserID,
+		},
+	).Return([]shared.TrackMetadata{systemTrack}, nil).Once()
+	// Once the system track is played the source has nothing new to give
+	s.env.OnActivity(
+		activities.FetchAutoDJTracksActivity,
+		mock.Anything,
+		mock.Anything,
+	).Return([]shared.TrackMetadata{}, nil)
+	s.env.OnActivity(
+		a.PlayActivity,
+		mock.Anything,
+		mock.Anything,
+	).Return(nil).Times(2)
+	s.env.OnActivity(
+		a.PauseActivity,
+		mock.Anything,
+		mock.Anything,
+	).Return(nil).Times(2)
 
-	init := defaultDuration
+	checkSystemTrackWasQueued := defaultDuration
 	registerDelayedCallbackWrapper(func() {
-		expectedDetails := shared_mtv.MtvRoomConstraintsDetails{
-			PhysicalConstraintEndsAt:   end.Format(time.RFC3339),
-			PhysicalConstraintStartsAt: start.Format(time.RFC3339),
-			PhysicalConstraintRadius:   5000,
-			RoomID:                     params.RoomID,
-			PhysicalConstraintPosition: shared_mtv.MtvRoomCoords{
-				Lat: 42,
-				Lng: 42,
-			},
-		}
+		mtvState := s.getMtvState(params.RoomCreatorUserID)
 
-		details := s.getMtvRoomConstraintsDetails()
-		s.Equal(expectedDetails, details)
-	}, init)
+		s.False(mtvState.Playing)
+		s.Len(mtvState.Tracks, 1)
+		s.Equal(systemTrack.ID, mtvState.Tracks[0].ID)
+		s.True(mtvState.Tracks[0].SuggestedBySystem)
+		s.Equal(0, mtvState.Tracks[0].Score)
+
+		s.emitPlaySignal(shared_mtv.NewPlaySignalArgs{
+			UserID: params.RoomCreatorUserID,
+		})
+	}, checkSystemTrackWasQueued)
+
+	// The system track is played although it has not reached MinimumScoreToBePlayed
+	checkSystemTrackIsPlayed := tracks[0].Duration + defaultDuration
+	registerDelayedCallbackWrapper(func() {
+		mtvState := s.getMtvState(params.RoomCreatorUserID)
+
+		s.True(mtvState.Playing)
+		s.Empty(mtvState.Tracks)
+		s.NotNil(mtvState.CurrentTrack)
+		s.Equal(systemTrack.ID, mtvState.CurrentTrack.ID)
+		s.True(mtvState.CurrentTrack.SuggestedBySystem)
+
+		playedTracksPage := s.getPlayedTracks(1)
+		s.Equal(1, playedTracksPage.TotalEntries)
+		s.Equal(tracks[0].ID, playedTracksPage.Data[0].ID)
+	}, checkSystemTrackIsPlayed)
 
 	s.env.ExecuteWorkflow(MtvRoomWorkflow, params)
 
 	s.True(s.env.IsWorkflowCompleted())
 	err := s.env.GetWorkflowError()
 	s.ErrorIs(err, workflow.ErrDeadlineExceeded, "The workflow ran on an infinite loop")
+	s.env.AssertExpectations(s.T())
 }
 
-func (s *UnitTestSuite) Test_GetMtvRoomConstraintsDetailsFailRoomDoesntHaveConstraints() {
+func (s *UnitTestSuite) Test_MtvRoomExitsAfterTerminateSignal() {
 	var a *activities_mtv.Activities
 
 	tracks := []shared.TrackMetadata{
@@ -5188,19 +7904,19 @@ func (s *UnitTestSuite) Test_GetMtvRoomConstraintsDetailsFailRoomDoesntHaveConst
 			Duration:   random.GenerateRandomDuration(),
 		},
 	}
-	tracksIDs := []string{tracks[0].ID}
+	initialTracksIDs := []string{tracks[0].ID}
 
-	params, _ := getWorkflowInitParams(tracksIDs, 1)
-	defaultDuration := 1 * time.Millisecond
+	params, _ := getWorkflowInitParams(initialTracksIDs, 1)
+	defaultDuration := 200 * time.Millisecond
 	resetMock, registerDelayedCallbackWrapper := s.initTestEnv()
 
 	defer resetMock()
 
 	s.env.OnActivity(
-		activities.FetchTracksInformationActivity,
+		activities.FetchTracksInformationWithPolicyActivity,
 		mock.Anything,
-		tracksIDs,
-	).Return(tracks, nil).Once()
+		fetchTracksInformationArgs(initialTracksIDs),
+	).Return(activities.FetchedTracksInformationWithInitiator{Metadata: tracks}, nil).Once()
 	s.env.OnActivity(
 		a.CreationAcknowledgementActivity,
 		mock.Anything,
@@ -5209,21 +7925,31 @@ func (s *UnitTestSuite) Test_GetMtvRoomConstraintsDetailsFailRoomDoesntHaveConst
 
 	init := defaultDuration
 	registerDelayedCallbackWrapper(func() {
-		_, err := s.env.QueryWorkflow(shared_mtv.MtvGetRoomConstraintsDetails)
-
-		s.Error(err)
-		s.ErrorIs(err, ErrRoomDoesNotHaveConstraints)
+		s.emitTerminateWorkflowSignal()
 	}, init)
 
 	s.env.ExecuteWorkflow(MtvRoomWorkflow, params)
 
 	s.True(s.env.IsWorkflowCompleted())
 	err := s.env.GetWorkflowError()
-	s.ErrorIs(err, workflow.ErrDeadlineExceeded, "The workflow ran on an infinite loop")
+	s.Nil(err)
 }
 
-func (s *UnitTestSuite) Test_MtvRoomPanicAfterUnkownWorkflowSignal() {
-	var a *activities_mtv.Activities
+// Test_MtvRoomContinuesAsNewAfterEventsThreshold scenario:
+//
+// 1. The room is created with a low continue-as-new threshold.
+//
+// 2. The creator plays the room and another user joins it.
+//
+// 3. We expect the workflow to continue as new with a snapshot
+// containing the users, the tracks and the current track elapsed time.
+func (s *UnitTestSuite) Test_MtvRoomContinuesAsNewAfterEventsThreshold() {
+	var (
+		a *activities_mtv.Activities
+
+		fakeUserID   = faker.UUIDHyphenated()
+		fakeDeviceID = faker.UUIDHyphenated()
+	)
 
 	tracks := []shared.TrackMetadata{
 		{
@@ -5232,81 +7958,287 @@ func (s *UnitTestSuite) Test_MtvRoomPanicAfterUnkownWorkflowSignal() {
 			ArtistName: faker.Name(),
 			Duration:   random.GenerateRandomDuration(),
 		},
+		{
+			ID:         faker.UUIDHyphenated(),
+			Title:      faker.Word(),
+			ArtistName: faker.Name(),
+			Duration:   random.GenerateRandomDuration(),
+		},
 	}
-	tracksIDs := []string{tracks[0].ID}
-
+	tracksIDs := []string{tracks[0].ID, tracks[1].ID}
 	params, _ := getWorkflowInitParams(tracksIDs, 1)
-	defaultDuration := 200 * time.Millisecond
+	params.ContinueAsNewEventsThreshold = 3
+
+	defaultDuration := 1 * time.Millisecond
 	resetMock, registerDelayedCallbackWrapper := s.initTestEnv()
 
 	defer resetMock()
 
 	s.env.OnActivity(
-		activities.FetchTracksInformationActivity,
+		activities.FetchTracksInformationWithPolicyActivity,
 		mock.Anything,
-		tracksIDs,
-	).Return(tracks, nil).Once()
+		fetchTracksInformationArgs(tracksIDs),
+	).Return(activities.FetchedTracksInformationWithInitiator{Metadata: tracks}, nil).Once()
 	s.env.OnActivity(
 		a.CreationAcknowledgementActivity,
 		mock.Anything,
 		mock.Anything,
 	).Return(nil).Once()
+	s.env.OnActivity(
+		a.PlayActivity,
+		mock.Anything,
+		mock.Anything,
+	).Return(nil).Once()
+	s.env.OnActivity(
+		a.JoinActivity,
+		mock.Anything,
+		mock.Anything,
+	).Return(nil).Once()
+	s.env.OnActivity(
+		a.UserLengthUpdateActivity,
+		mock.Anything,
+		mock.Anything,
+	).Return(nil).Once()
 
-	init := defaultDuration
+	emitPlay := defaultDuration
 	registerDelayedCallbackWrapper(func() {
-		s.emitUnkownSignal()
-	}, init)
+		s.emitPlaySignal(shared_mtv.NewPlaySignalArgs{
+			UserID: params.RoomCreatorUserID,
+		})
+	}, emitPlay)
+
+	userJoins := defaultDuration
+	registerDelayedCallbackWrapper(func() {
+		s.emitJoinSignal(shared_mtv.NewJoinSignalArgs{
+			UserID:             fakeUserID,
+			DeviceID:           fakeDeviceID,
+			UserHasBeenInvited: false,
+		})
+	}, userJoins)
 
 	s.env.ExecuteWorkflow(MtvRoomWorkflow, params)
 
 	s.True(s.env.IsWorkflowCompleted())
 	err := s.env.GetWorkflowError()
-	s.Error(err)
-	var panicError *temporal.PanicError
-	s.True(errors.As(err, &panicError))
-	s.Contains(panicError.Error(), ErrUnknownWorflowSignal.Error())
+	s.True(workflow.IsContinueAsNewError(err))
+
+	var continueAsNewError *workflow.ContinueAsNewError
+	s.True(errors.As(err, &continueAsNewError))
+
+	var nextRunParams shared_mtv.MtvRoomParameters
+	err = converter.GetDefaultDataConverter().FromPayloads(continueAsNewError.Input, &nextRunParams)
+	s.NoError(err)
+
+	snapshot := nextRunParams.Snapshot
+	s.NotNil(snapshot)
+	s.Equal(params.RoomID, nextRunParams.RoomID)
+	s.True(snapshot.Playing)
+	s.Len(snapshot.Users, 2)
+	s.Contains(snapshot.Users, fakeUserID)
+	s.Equal(tracks[0].ID, snapshot.CurrentTrack.ID)
+	s.Len(snapshot.Tracks, 1)
+	s.Equal(tracks[1].ID, snapshot.Tracks[0].ID)
+	s.True(snapshot.CurrentTrack.AlreadyElapsed > 0)
+	s.True(snapshot.CurrentTrack.AlreadyElapsed < tracks[0].Duration)
 }
 
-func (s *UnitTestSuite) Test_MtvRoomExitsAfterTerminateSignal() {
-	var a *activities_mtv.Activities
+// Test_MtvRoomRestoredFromSnapshot scenario:
+//
+// 1. The room is started from a snapshot of a playing room.
+//
+// 2. We expect the initial tracks not to be fetched again and
+// the room to be playing the current track from where it was.
+//
+// 3. We expect the room to go to the next track once the remaining
+// duration of the current track has elapsed.
+func (s *UnitTestSuite) Test_MtvRoomRestoredFromSnapshot() {
+	var (
+		a *activities_mtv.Activities
 
-	tracks := []shared.TrackMetadata{
+		fakeUserID   = faker.UUIDHyphenated()
+		fakeDeviceID = faker.UUIDHyphenated()
+	)
+
+	tracks := []shared_mtv.TrackMetadataWithScore{
+		{
+			TrackMetadata: shared.TrackMetadata{
+				ID:         faker.UUIDHyphenated(),
+				Title:      faker.Word(),
+				ArtistName: faker.Name(),
+				Duration:   random.GenerateRandomDuration(),
+			},
+			Score: 1,
+		},
 		{
+			TrackMetadata: shared.TrackMetadata{
+				ID:         faker.UUIDHyphenated(),
+				Title:      faker.Word(),
+				ArtistName: faker.Name(),
+				Duration:   random.GenerateRandomDuration(),
+			},
+			Score: 1,
+		},
+	}
+	params, _ := getWorkflowInitParams([]string{tracks[0].ID, tracks[1].ID}, 1)
+	alreadyElapsed := tracks[0].Duration / 2
+
+	params.Snapshot = &shared_mtv.MtvRoomStateSnapshot{
+		Users: map[string]*shared_mtv.InternalStateUser{
+			params.RoomCreatorUserID: params.CreatorUserRelatedInformation,
+			fakeUserID: {
+				UserID:         fakeUserID,
+				DeviceID:       fakeDeviceID,
+				TracksVotedFor: make([]string, 0),
+			},
+		},
+		Tracks: []shared_mtv.TrackMetadataWithScore{tracks[1]},
+		CurrentTrack: shared_mtv.CurrentTrackSnapshot{
+			TrackMetadataWithScore: tracks[0],
+			AlreadyElapsed:         alreadyElapsed,
+		},
+		Playing: true,
+	}
+
+	defaultDuration := 1 * time.Millisecond
+	resetMock, registerDelayedCallbackWrapper := s.initTestEnv()
+
+	defer resetMock()
+
+	s.env.OnActivity(
+		a.PlayActivity,
+		mock.Anything,
+		mock.Anything,
+	).Return(nil).Once()
+	s.env.OnActivity(
+		a.PauseActivity,
+		mock.Anything,
+		mock.Anything,
+	).Return(nil).Once()
+
+	checkRestoredState := defaultDuration
+	registerDelayedCallbackWrapper(func() {
+		mtvState := s.getMtvState(fakeUserID)
+
+		s.True(mtvState.Playing)
+		s.Equal(2, mtvState.UsersLength)
+		s.NotNil(mtvState.UserRelatedInformation)
+		s.Equal(fakeDeviceID, mtvState.UserRelatedInformation.DeviceID)
+		s.Equal(tracks[0].ID, mtvState.CurrentTrack.ID)
+		s.Equal((alreadyElapsed + defaultDuration).Milliseconds(), mtvState.CurrentTrack.Elapsed)
+		s.Len(mtvState.Tracks, 1)
+	}, checkRestoredState)
+
+	checkNextTrackIsPlaying := tracks[0].Duration - alreadyElapsed
+	registerDelayedCallbackWrapper(func() {
+		mtvState := s.getMtvState(shared_mtv.NoRelatedUserID)
+
+		s.True(mtvState.Playing)
+		s.Equal(tracks[1].ID, mtvState.CurrentTrack.ID)
+		s.Empty(mtvState.Tracks)
+	}, checkNextTrackIsPlaying)
+
+	s.env.ExecuteWorkflow(MtvRoomWorkflow, params)
+
+	s.True(s.env.IsWorkflowCompleted())
+	err := s.env.GetWorkflowError()
+	s.ErrorIs(err, workflow.ErrDeadlineExceeded, "The workflow ran on an infinite loop")
+}
+
+// Test_MtvRoomSnapshotDropsOutdatedHistory scenario:
+//
+// 1. The room is started from a snapshot holding more played tracks
+// than kept in the history and suggestions timestamps out of the rate limit window.
+//
+// 2. We expect the next snapshot to only hold the most recently played tracks
+// and the suggestions timestamps that are still in the rate limit window.
+func (s *UnitTestSuite) Test_MtvRoomSnapshotDropsOutdatedHistory() {
+	var a *activities_mtv.Activities
+
+	currentTrack := shared_mtv.TrackMetadataWithScore{
+		TrackMetadata: shared.TrackMetadata{
 			ID:         faker.UUIDHyphenated(),
 			Title:      faker.Word(),
 			ArtistName: faker.Name(),
-			Duration:   random.GenerateRandomDuration(),
+			Duration:   time.Hour,
 		},
 	}
-	initialTracksIDs := []string{tracks[0].ID}
+	params, _ := getWorkflowInitParams([]string{currentTrack.ID}, 1)
+	params.ContinueAsNewEventsThreshold = 1
+	params.MaximumSuggestionsPerUserPerMinute = 5
 
-	params, _ := getWorkflowInitParams(initialTracksIDs, 1)
-	defaultDuration := 200 * time.Millisecond
+	playedTracks := make([]shared_mtv.MtvRoomPlayedTrack, 0, shared_mtv.MtvPlayedTracksHistoryMaximumLength+5)
+	for index := 0; index < cap(playedTracks); index++ {
+		playedTracks = append(playedTracks, shared_mtv.MtvRoomPlayedTrack{
+			TrackMetadataWithScore: shared_mtv.TrackMetadataWithScore{
+				TrackMetadata: shared.TrackMetadata{
+					ID:         faker.UUIDHyphenated(),
+					Title:      faker.Word(),
+					ArtistName: faker.Name(),
+					Duration:   random.GenerateRandomDuration(),
+				},
+			},
+		})
+	}
+
+	now := time.Now()
+	outdatedSuggestionTimestamp := now.Add(-2 * shared_mtv.SuggestionsRateLimitWindow)
+	recentSuggestionTimestamp := now.Add(-shared_mtv.SuggestionsRateLimitWindow / 2)
+	otherUserID := faker.UUIDHyphenated()
+
+	params.Snapshot = &shared_mtv.MtvRoomStateSnapshot{
+		Users: map[string]*shared_mtv.InternalStateUser{
+			params.RoomCreatorUserID: params.CreatorUserRelatedInformation,
+		},
+		Tracks: []shared_mtv.TrackMetadataWithScore{},
+		CurrentTrack: shared_mtv.CurrentTrackSnapshot{
+			TrackMetadataWithScore: currentTrack,
+		},
+		PlayedTracks: playedTracks,
+		UsersSuggestionsTimestamps: map[string][]time.Time{
+			params.RoomCreatorUserID: {outdatedSuggestionTimestamp, recentSuggestionTimestamp},
+			otherUserID:              {outdatedSuggestionTimestamp},
+		},
+	}
+
+	defaultDuration := 1 * time.Millisecond
 	resetMock, registerDelayedCallbackWrapper := s.initTestEnv()
 
 	defer resetMock()
 
 	s.env.OnActivity(
-		activities.FetchTracksInformationActivity,
-		mock.Anything,
-		initialTracksIDs,
-	).Return(tracks, nil).Once()
-	s.env.OnActivity(
-		a.CreationAcknowledgementActivity,
+		a.PlayActivity,
 		mock.Anything,
 		mock.Anything,
 	).Return(nil).Once()
 
-	init := defaultDuration
+	emitPlay := defaultDuration
 	registerDelayedCallbackWrapper(func() {
-		s.emitTerminateWorkflowSignal()
-	}, init)
+		s.emitPlaySignal(shared_mtv.NewPlaySignalArgs{
+			UserID: params.RoomCreatorUserID,
+		})
+	}, emitPlay)
 
 	s.env.ExecuteWorkflow(MtvRoomWorkflow, params)
 
 	s.True(s.env.IsWorkflowCompleted())
 	err := s.env.GetWorkflowError()
-	s.Nil(err)
+	s.True(workflow.IsContinueAsNewError(err))
+
+	var continueAsNewError *workflow.ContinueAsNewError
+	s.True(errors.As(err, &continueAsNewError))
+
+	var nextRunParams shared_mtv.MtvRoomParameters
+	err = converter.GetDefaultDataConverter().FromPayloads(continueAsNewError.Input, &nextRunParams)
+	s.NoError(err)
+
+	snapshot := nextRunParams.Snapshot
+	s.NotNil(snapshot)
+	s.Len(snapshot.PlayedTracks, shared_mtv.MtvPlayedTracksHistoryMaximumLength)
+	s.Equal(playedTracks[5].ID, snapshot.PlayedTracks[0].ID)
+	s.Equal(playedTracks[len(playedTracks)-1].ID, snapshot.PlayedTracks[len(snapshot.PlayedTracks)-1].ID)
+	s.Len(snapshot.UsersSuggestionsTimestamps, 1)
+	s.Len(snapshot.UsersSuggestionsTimestamps[params.RoomCreatorUserID], 1)
+	s.True(recentSuggestionTimestamp.Equal(snapshot.UsersSuggestionsTimestamps[params.RoomCreatorUserID][0]))
 }
 
 func TestUnitTestSuite(t *testing.T) {
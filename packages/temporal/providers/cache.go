@@ -0,0 +1,380 @@
+package providers
+
+import (
+	"container/list"
+	"context"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"log"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/AdonisEnProvence/MusicRoom/shared"
+)
+
+type FetchTracksFunc func(ctx context.Context, tracksIDs []string) ([]shared.TrackMetadata, error)
+
+type MetadataCacheEntry struct {
+	Metadata  shared.TrackMetadata `json:"metadata"`
+	ExpiresAt time.Time            `json:"expiresAt"`
+}
+
+// MetadataCacheStore persists the cache entries so that a restarted worker
+// does not have to fetch again every track.
+type MetadataCacheStore interface {
+	Load() ([]MetadataCacheEntry, error)
+	Save(entries []MetadataCacheEntry) error
+}
+
+type MetadataCacheStats struct {
+	Hits    uint64
+	Misses  uint64
+	Entries int
+}
+
+const DefaultMetadataCachePersistInterval = time.Minute
+
+type MetadataCacheOptions struct {
+	TTL time.Duration
+	// Least recently used tracks are evicted above this number of entries
+	MaxEntries int
+	// Optional
+	Store MetadataCacheStore
+	// Entries are saved to Store at this interval when they changed, and when the cache is closed.
+	// DefaultMetadataCachePersistInterval is used when zero.
+	PersistInterval time.Duration
+	// Defaults to time.Now
+	Now func() time.Time
+}
+
+// MetadataCache keeps fetched tracks metadata in memory for TTL.
+// Tracks that were not found are never cached.
+type MetadataCache struct {
+	ttl        time.Duration
+	maxEntries int
+	store      MetadataCacheStore
+
+	mu      sync.Mutex
+	entries map[string]*list.Element
+	// From most to least recently used
+	recentlyUsed *list.List
+	// Entries changed since they were last saved to the store
+	dirty bool
+
+	hits   uint64
+	misses uint64
+
+	now func() time.Time
+
+	// Orders the saves to the store, mu is only held while the entries are copied
+	// so that the cache is not blocked while the store writes.
+	persistMu sync.Mutex
+	stop      chan struct{}
+	stopped   chan struct{}
+	closeOnce sync.Once
+}
+
+func NewMetadataCache(options MetadataCacheOptions) (*MetadataCache, error) {
+	if options.TTL <= 0 {
+		return nil, errors.New("metadata cache TTL must be positive")
+	}
+	if options.MaxEntries <= 0 {
+		return nil, errors.New("metadata cache max entries must be positive")
+	}
+
+	cache := &MetadataCache{
+		ttl:          options.TTL,
+		maxEntries:   options.MaxEntries,
+		store:        options.Store,
+		entries:      make(map[string]*list.Element),
+		recentlyUsed: list.New(),
+		now:          options.Now,
+	}
+	if cache.now == nil {
+		cache.now = time.Now
+	}
+
+	if cache.store != nil {
+		// An unreadable store must not prevent the worker from starting,
+		// the cache starts empty and the next persist overwrites the store.
+		entries, err := cache.store.Load()
+		if err != nil {
+			log.Printf("tracks metadata cache could not be restored: %v\n", err)
+			entries = nil
+			cache.dirty = true
+		}
+
+		now := cache.now()
+		for _, entry := range entries {
+			if !entry.ExpiresAt.After(now) {
+				continue
+			}
+
+			cache.insert(entry)
+		}
+
+		persistInterval := options.PersistInterval
+		if persistInterval <= 0 {
+			persistInterval = DefaultMetadataCachePersistInterval
+		}
+
+		cache.stop = make(chan struct{})
+		cache.stopped = make(chan struct{})
+		go cache.persistPeriodically(persistInterval)
+	}
+
+	return cache, nil
+}
+
+// FetchTracks returns cached tracks and calls fetch with the missing ones only.
+// Like fetch, it returns found tracks once and in the order of given tracks IDs.
+// Tracks fetched along with a *PartialFetchError are cached and the error is returned as is.
+func (c *MetadataCache) FetchTracks(ctx context.Context, tracksIDs []string, fetch FetchTracksFunc) ([]shared.TrackMetadata, error) {
+	cachedTracks := make(map[string]shared.TrackMetadata, len(tracksIDs))
+	missingTracksIDs := make([]string, 0)
+
+	c.mu.Lock()
+	now := c.now()
+	for _, trackID := range tracksIDs {
+		if _, alreadyChecked := cachedTracks[trackID]; alreadyChecked {
+			continue
+		}
+
+		trackMetadata, hit := c.get(trackID, now)
+		if !hit {
+			c.misses++
+			missingTracksIDs = append(missingTracksIDs, trackID)
+			continue
+		}
+
+		c.hits++
+		cachedTracks[trackID] = trackMetadata
+	}
+	c.mu.Unlock()
+
+	if len(missingTracksIDs) == 0 {
+		return orderTracksMetadata(tracksIDs, cachedTracks), nil
+	}
+
+	fetchedTracks, err := fetch(ctx, missingTracksIDs)
+	var partialFetchError *PartialFetchError
+	if err != nil && !errors.As(err, &partialFetchError) {
+		return nil, err
+	}
+
+	c.Set(fetchedTracks)
+
+	for _, trackMetadata := range fetchedTracks {
+		cachedTracks[trackMetadata.ID] = trackMetadata
+	}
+
+	return orderTracksMetadata(tracksIDs, cachedTracks), err
+}
+
+func (c *MetadataCache) Set(tracks []shared.TrackMetadata) {
+	if len(tracks) == 0 {
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	expiresAt := c.now().Add(c.ttl)
+	for _, trackMetadata := range tracks {
+		c.insert(MetadataCacheEntry{
+			Metadata:  trackMetadata,
+			ExpiresAt: expiresAt,
+		})
+	}
+
+	c.dirty = true
+}
+
+// Persist saves the entries to the store when they changed since the last save.
+// The cache keeps working from memory when the store fails, the entries are saved again next time.
+func (c *MetadataCache) Persist() error {
+	if c.store == nil {
+		return nil
+	}
+
+	c.persistMu.Lock()
+	defer c.persistMu.Unlock()
+
+	c.mu.Lock()
+	if !c.dirty {
+		c.mu.Unlock()
+		return nil
+	}
+	entries := make([]MetadataCacheEntry, 0, len(c.entries))
+	// From least to most recently used so that loading them back
+	// restores the same eviction order
+	for element := c.recentlyUsed.Back(); element != nil; element = element.Prev() {
+		entries = append(entries, element.Value.(MetadataCacheEntry))
+	}
+	c.dirty = false
+	c.mu.Unlock()
+
+	if err := c.store.Save(entries); err != nil {
+		c.mu.Lock()
+		c.dirty = true
+		c.mu.Unlock()
+
+		return err
+	}
+
+	return nil
+}
+
+// Close stops the periodic saves and saves the entries a last time.
+func (c *MetadataCache) Close() error {
+	if c.store == nil {
+		return nil
+	}
+
+	c.closeOnce.Do(func() {
+		close(c.stop)
+		<-c.stopped
+	})
+
+	return c.Persist()
+}
+
+func (c *MetadataCache) persistPeriodically(interval time.Duration) {
+	defer close(c.stopped)
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			if err := c.Persist(); err != nil {
+				log.Printf("tracks metadata cache could not be persisted: %v\n", err)
+			}
+		case <-c.stop:
+			return
+		}
+	}
+}
+
+func (c *MetadataCache) Stats() MetadataCacheStats {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return MetadataCacheStats{
+		Hits:    c.hits,
+		Misses:  c.misses,
+		Entries: len(c.entries),
+	}
+}
+
+// Must be called with the lock held.
+func (c *MetadataCache) get(trackID string, now time.Time) (shared.TrackMetadata, bool) {
+	element, exists := c.entries[trackID]
+	if !exists {
+		return shared.TrackMetadata{}, false
+	}
+
+	entry := element.Value.(MetadataCacheEntry)
+	if !entry.ExpiresAt.After(now) {
+		c.recentlyUsed.Remove(element)
+		delete(c.entries, trackID)
+
+		return shared.TrackMetadata{}, false
+	}
+
+	c.recentlyUsed.MoveToFront(element)
+
+	return entry.Metadata, true
+}
+
+// Must be called with the lock held.
+func (c *MetadataCache) insert(entry MetadataCacheEntry) {
+	if element, exists := c.entries[entry.Metadata.ID]; exists {
+		element.Value = entry
+		c.recentlyUsed.MoveToFront(element)
+		return
+	}
+
+	c.entries[entry.Metadata.ID] = c.recentlyUsed.PushFront(entry)
+
+	for len(c.entries) > c.maxEntries {
+		leastRecentlyUsed := c.recentlyUsed.Back()
+
+		c.recentlyUsed.Remove(leastRecentlyUsed)
+		delete(c.entries, leastRecentlyUsed.Value.(MetadataCacheEntry).Metadata.ID)
+	}
+}
+
+func orderTracksMetadata(tracksIDs []string, tracks map[string]shared.TrackMetadata) []shared.TrackMetadata {
+	metadata := make([]shared.TrackMetadata, 0, len(tracks))
+	for _, trackID := range tracksIDs {
+		trackMetadata, exists := tracks[trackID]
+		if !exists {
+			continue
+		}
+
+		metadata = append(metadata, trackMetadata)
+		delete(tracks, trackID)
+	}
+
+	return metadata
+}
+
+// FileMetadataCacheStore saves the cache entries as a JSON file.
+type FileMetadataCacheStore struct {
+	Path string
+}
+
+func NewFileMetadataCacheStore(path string) *FileMetadataCacheStore {
+	return &FileMetadataCacheStore{
+		Path: path,
+	}
+}
+
+// Load returns no entries when the file does not exist yet.
+func (s *FileMetadataCacheStore) Load() ([]MetadataCacheEntry, error) {
+	content, err := ioutil.ReadFile(s.Path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	var entries []MetadataCacheEntry
+	if err := json.Unmarshal(content, &entries); err != nil {
+		return nil, err
+	}
+
+	return entries, nil
+}
+
+// Save writes to a temporary file first so that a crash can not leave a truncated file.
+func (s *FileMetadataCacheStore) Save(entries []MetadataCacheEntry) error {
+	content, err := json.Marshal(entries)
+	if err != nil {
+		return err
+	}
+
+	file, err := ioutil.TempFile(filepath.Dir(s.Path), filepath.Base(s.Path)+".*.tmp")
+	if err != nil {
+		return err
+	}
+
+	if _, err := file.Write(content); err != nil {
+		file.Close()
+		os.Remove(file.Name())
+		return err
+	}
+	if err := file.Close(); err != nil {
+		os.Remove(file.Name())
+		return err
+	}
+
+	return os.Rename(file.Name(), s.Path)
+}
@@ -0,0 +1,204 @@
+package providers_test
+
+import (
+	"context"
+	"errors"
+	"io/ioutil"
+	"path/filepath"
+	"time"
+
+	"github.com/AdonisEnProvence/MusicRoom/providers"
+	"github.com/AdonisEnProvence/MusicRoom/shared"
+)
+
+type fetchCallsRecorder struct {
+	provider *providers.FixtureProvider
+
+	calls [][]string
+}
+
+func (r *fetchCallsRecorder) FetchTracks(ctx context.Context, tracksIDs []string) ([]shared.TrackMetadata, error) {
+	r.calls = append(r.calls, tracksIDs)
+
+	return r.provider.FetchTracks(ctx, tracksIDs)
+}
+
+func generateCacheTracks() []shared.TrackMetadata {
+	return []shared.TrackMetadata{
+		{ID: "a", Title: "A", ArtistName: "Artist", Duration: time.Minute},
+		{ID: "b", Title: "B", ArtistName: "Artist", Duration: time.Minute},
+		{ID: "c", Title: "C", ArtistName: "Artist", Duration: time.Minute},
+	}
+}
+
+func (s *UnitTestSuite) Test_MetadataCacheOnlyFetchesMissingTracks() {
+	now := time.Now()
+	fetcher := &fetchCallsRecorder{
+		provider: providers.NewFixtureProvider(generateCacheTracks()),
+	}
+	cache, err := providers.NewMetadataCache(providers.MetadataCacheOptions{
+		TTL:        time.Hour,
+		MaxEntries: 10,
+		Now: func() time.Time {
+			return now
+		},
+	})
+	s.NoError(err)
+
+	metadata, err := cache.FetchTracks(context.Background(), []string{"a", "unknown"}, fetcher.FetchTracks)
+	s.NoError(err)
+	s.Len(metadata, 1)
+
+	metadata, err = cache.FetchTracks(context.Background(), []string{"b", "a", "unknown", "a"}, fetcher.FetchTracks)
+	s.NoError(err)
+	s.Equal([]string{"b", "a"}, []string{metadata[0].ID, metadata[1].ID})
+	s.Len(metadata, 2)
+
+	// Not found tracks are not cached
+	s.Equal([][]string{{"a", "unknown"}, {"b", "unknown"}}, fetcher.calls)
+	s.Equal(providers.MetadataCacheStats{
+		Hits:    1,
+		Misses:  4,
+		Entries: 2,
+	}, cache.Stats())
+
+	now = now.Add(time.Hour)
+
+	_, err = cache.FetchTracks(context.Background(), []string{"a"}, fetcher.FetchTracks)
+	s.NoError(err)
+	s.Equal([]string{"a"}, fetcher.calls[2])
+}
+
+func (s *UnitTestSuite) Test_MetadataCacheEvictsLeastRecentlyUsedTracks() {
+	fetcher := &fetchCallsRecorder{
+		provider: providers.NewFixtureProvider(generateCacheTracks()),
+	}
+	cache, err := providers.NewMetadataCache(providers.MetadataCacheOptions{
+		TTL:        time.Hour,
+		MaxEntries: 2,
+	})
+	s.NoError(err)
+
+	_, err = cache.FetchTracks(context.Background(), []string{"a", "b"}, fetcher.FetchTracks)
+	s.NoError(err)
+	_, err = cache.FetchTracks(context.Background(), []string{"a"}, fetcher.FetchTracks)
+	s.NoError(err)
+	_, err = cache.FetchTracks(context.Background(), []string{"c"}, fetcher.FetchTracks)
+	s.NoError(err)
+	_, err = cache.FetchTracks(context.Background(), []string{"a", "b"}, fetcher.FetchTracks)
+	s.NoError(err)
+
+	s.Equal([][]string{{"a", "b"}, {"c"}, {"b"}}, fetcher.calls)
+	s.Equal(2, cache.Stats().Entries)
+}
+
+func (s *UnitTestSuite) Test_MetadataCacheIsRestoredFromFile() {
+	store := providers.NewFileMetadataCacheStore(filepath.Join(s.T().TempDir(), "cache.json"))
+	fetcher := &fetchCallsRecorder{
+		provider: providers.NewFixtureProvider(generateCacheTracks()),
+	}
+	options := providers.MetadataCacheOptions{
+		TTL:        time.Hour,
+		MaxEntries: 10,
+		Store:      store,
+	}
+
+	cache, err := providers.NewMetadataCache(options)
+	s.NoError(err)
+	_, err = cache.FetchTracks(context.Background(), []string{"a", "b"}, fetcher.FetchTracks)
+	s.NoError(err)
+	s.NoError(cache.Close())
+
+	restoredCache, err := providers.NewMetadataCache(options)
+	s.NoError(err)
+	metadata, err := restoredCache.FetchTracks(context.Background(), []string{"a", "b"}, fetcher.FetchTracks)
+	s.NoError(err)
+	s.Equal(generateCacheTracks()[:2], metadata)
+	s.Len(fetcher.calls, 1)
+
+	// Expired entries are not restored
+	options.Now = func() time.Time {
+		return time.Now().Add(time.Hour)
+	}
+	expiredCache, err := providers.NewMetadataCache(options)
+	s.NoError(err)
+	s.Equal(0, expiredCache.Stats().Entries)
+}
+
+func (s *UnitTestSuite) Test_MetadataCacheStartsEmptyWhenFileIsCorrupted() {
+	cachePath := filepath.Join(s.T().TempDir(), "cache.json")
+	s.NoError(ioutil.WriteFile(cachePath, []byte("{not json"), 0644))
+
+	options := providers.MetadataCacheOptions{
+		TTL:        time.Hour,
+		MaxEntries: 10,
+		Store:      providers.NewFileMetadataCacheStore(cachePath),
+	}
+
+	cache, err := providers.NewMetadataCache(options)
+	s.NoError(err)
+	s.Equal(0, cache.Stats().Entries)
+	s.NoError(cache.Close())
+
+	// The corrupted file has been overwritten
+	_, err = options.Store.Load()
+	s.NoError(err)
+}
+
+type blockingMetadataCacheStore struct {
+	saving  chan []providers.MetadataCacheEntry
+	release chan error
+}
+
+func (s *blockingMetadataCacheStore) Load() ([]providers.MetadataCacheEntry, error) {
+	return nil, nil
+}
+
+func (s *blockingMetadataCacheStore) Save(entries []providers.MetadataCacheEntry) error {
+	s.saving <- entries
+
+	return <-s.release
+}
+
+func (s *UnitTestSuite) Test_MetadataCachePersistsPeriodicallyWithoutBlockingReads() {
+	store := &blockingMetadataCacheStore{
+		saving:  make(chan []providers.MetadataCacheEntry),
+		release: make(chan error),
+	}
+	cache, err := providers.NewMetadataCache(providers.MetadataCacheOptions{
+		TTL:             time.Hour,
+		MaxEntries:      10,
+		Store:           store,
+		PersistInterval: time.Millisecond,
+	})
+	s.NoError(err)
+
+	cache.Set(generateCacheTracks()[:1])
+
+	var savedEntries []providers.MetadataCacheEntry
+	select {
+	case savedEntries = <-store.saving:
+	case <-time.After(time.Second):
+		s.FailNow("entries were not persisted")
+	}
+	s.Len(savedEntries, 1)
+
+	// The store is still writing, the cache keeps serving
+	cache.Set(generateCacheTracks()[1:2])
+	metadata, err := cache.FetchTracks(context.Background(), []string{"a", "b"}, providers.NewFixtureProvider(nil).FetchTracks)
+	s.NoError(err)
+	s.Len(metadata, 2)
+
+	// Failed saves are retried with the entries changed since
+	store.release <- errors.New("disk is full")
+	select {
+	case savedEntries = <-store.saving:
+	case <-time.After(time.Second):
+		s.FailNow("entries were not persisted again")
+	}
+	s.Len(savedEntries, 2)
+	store.release <- nil
+
+	// Nothing changed, closing does not save again
+	s.NoError(cache.Close())
+}
@@ -0,0 +1,161 @@
+package providers
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/AdonisEnProvence/MusicRoom/shared"
+)
+
+const FixtureProviderName = "local"
+
+// FixtureProvider serves tracks from memory, it is meant for development and tests
+// to not depend on an external API.
+type FixtureProvider struct {
+	tracks map[string]shared.TrackMetadata
+}
+
+func NewFixtureProvider(tracks []shared.TrackMetadata) *FixtureProvider {
+	provider := &FixtureProvider{
+		tracks: make(map[string]shared.TrackMetadata, len(tracks)),
+	}
+
+	for _, track := range tracks {
+		provider.tracks[track.ID] = track
+	}
+
+	return provider
+}
+
+type fixtureTrack struct {
+	ID         string `json:"id" validate:"required"`
+	Title      string `json:"title" validate:"required"`
+	ArtistName string `json:"artistName" validate:"required"`
+	// In milliseconds
+	Duration int64 `json:"duration" validate:"required,min=1"`
+}
+
+// NewFixtureProviderFromFile loads the tracks from a JSON file containing an array of
+// {"id", "title", "artistName", "duration"} objects, duration being in milliseconds.
+func NewFixtureProviderFromFile(path string) (*FixtureProvider, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	var fixtureTracks []fixtureTrack
+	if err := json.NewDecoder(file).Decode(&fixtureTracks); err != nil {
+		return nil, err
+	}
+
+	tracks := make([]shared.TrackMetadata, 0, len(fixtureTracks))
+	for _, fixture := range fixtureTracks {
+		if err := validate.Struct(fixture); err != nil {
+			return nil, err
+		}
+
+		tracks = append(tracks, shared.TrackMetadata{
+			ID:         fixture.ID,
+			Title:      fixture.Title,
+			ArtistName: fixture.ArtistName,
+			Duration:   time.Duration(fixture.Duration) * time.Millisecond,
+		})
+	}
+
+	return NewFixtureProvider(tracks), nil
+}
+
+func (p *FixtureProvider) Name() string {
+	return FixtureProviderName
+}
+
+func (p *FixtureProvider) FetchTracks(ctx context.Context, tracksIDs []string) ([]shared.TrackMetadata, error) {
+	metadata := make([]shared.TrackMetadata, 0, len(tracksIDs))
+
+	for _, trackID := range tracksIDs {
+		track, exists := p.tracks[trackID]
+		if !exists {
+			continue
+		}
+
+		metadata = append(metadata, track)
+	}
+
+	return metadata, nil
+}
+
+// SearchTracks ranks the tracks by the number of query words found in their title or artist name.
+// Tracks matching none of them are left out.
+func (p *FixtureProvider) SearchTracks(ctx context.Context, query string, limit int) ([]shared.TrackMetadata, error) {
+	queryWords := strings.Fields(strings.ToLower(query))
+
+	type rankedTrack struct {
+		Track shared.TrackMetadata
+		Score int
+	}
+	rankedTracks := make([]rankedTrack, 0)
+	for _, track := range p.tracks {
+		searchedText := strings.ToLower(track.Title + " " + track.ArtistName)
+
+		score := 0
+		for _, word := range queryWords {
+			if strings.Contains(searchedText, word) {
+				score++
+			}
+		}
+		if score == 0 {
+			continue
+		}
+
+		rankedTracks = append(rankedTracks, rankedTrack{
+			Track: track,
+			Score: score,
+		})
+	}
+
+	sort.Slice(rankedTracks, func(i, j int) bool {
+		if rankedTracks[i].Score != rankedTracks[j].Score {
+			return rankedTracks[i].Score > rankedTracks[j].Score
+		}
+
+		return rankedTracks[i].Track.ID < rankedTracks[j].Track.ID
+	})
+	if len(rankedTracks) > limit {
+		rankedTracks = rankedTracks[:limit]
+	}
+
+	metadata := make([]shared.TrackMetadata, 0, len(rankedTracks))
+	for _, ranked := range rankedTracks {
+		metadata = append(metadata, ranked.Track)
+	}
+
+	return metadata, nil
+}
+
+// FindRelatedTracks returns the other tracks of the same artist.
+func (p *FixtureProvider) FindRelatedTracks(ctx context.Context, track shared.TrackMetadata, limit int) ([]shared.TrackMetadata, error) {
+	metadata := make([]shared.TrackMetadata, 0)
+	for _, candidate := range p.tracks {
+		isSameTrack := candidate.ID == track.ID
+		isSameArtist := strings.EqualFold(candidate.ArtistName, track.ArtistName)
+		if isSameTrack || !isSameArtist {
+			continue
+		}
+
+		metadata = append(metadata, candidate)
+	}
+
+	sort.Slice(metadata, func(i, j int) bool {
+		return metadata[i].ID < metadata[j].ID
+	})
+	if len(metadata) > limit {
+		metadata = metadata[:limit]
+	}
+
+	return metadata, nil
+}
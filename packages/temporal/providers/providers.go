@@ -0,0 +1,282 @@
+package providers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/AdonisEnProvence/MusicRoom/shared"
+)
+
+// Separates the provider name from the provider own track ID, as in local:my-track.
+const TrackIDSeparator = ":"
+
+// TrackProvider fetches tracks metadata from a source of tracks.
+type TrackProvider interface {
+	// Name is the prefix of the tracks IDs handled by the provider.
+	Name() string
+	// FetchTracks receives and returns provider own tracks IDs, without any prefix.
+	// Unknown tracks are omitted from the returned metadata.
+	FetchTracks(ctx context.Context, tracksIDs []string) ([]shared.TrackMetadata, error)
+}
+
+// TrackSearcher is implemented by the providers able to find tracks from a text query.
+type TrackSearcher interface {
+	// SearchTracks returns at most limit tracks, most relevant first.
+	// Returned tracks IDs are provider own tracks IDs, without any prefix.
+	SearchTracks(ctx context.Context, query string, limit int) ([]shared.TrackMetadata, error)
+}
+
+// RelatedTracksFinder is implemented by the providers able to find tracks close to a given one.
+type RelatedTracksFinder interface {
+	// FindRelatedTracks returns at most limit tracks, the given track excluded.
+	// Given and returned tracks IDs are provider own tracks IDs, without any prefix.
+	FindRelatedTracks(ctx context.Context, track shared.TrackMetadata, limit int) ([]shared.TrackMetadata, error)
+}
+
+// PartialFetchError is returned along with the metadata of the tracks that could be fetched.
+type PartialFetchError struct {
+	FailedTracksIDs []string
+	Err             error
+}
+
+func (e *PartialFetchError) Error() string {
+	return fmt.Sprintf("fetching tracks %s failed: %v", strings.Join(e.FailedTracksIDs, ","), e.Err)
+}
+
+func (e *PartialFetchError) Unwrap() error {
+	return e.Err
+}
+
+func JoinTrackID(providerName string, providerTrackID string) string {
+	return providerName + TrackIDSeparator + providerTrackID
+}
+
+// SplitTrackID returns an empty provider name for tracks IDs without prefix.
+func SplitTrackID(trackID string) (providerName string, providerTrackID string) {
+	separatorIndex := strings.Index(trackID, TrackIDSeparator)
+	if separatorIndex == -1 {
+		return "", trackID
+	}
+
+	return trackID[:separatorIndex], trackID[separatorIndex+len(TrackIDSeparator):]
+}
+
+// Registry dispatches tracks IDs to the provider matching their prefix.
+// Tracks IDs without prefix are handled by the default provider, which keeps
+// IDs sent before providers were introduced working.
+type Registry struct {
+	defaultProvider TrackProvider
+	providers       map[string]TrackProvider
+	// In registration order, the default provider first
+	providersNames []string
+}
+
+func NewRegistry(defaultProvider TrackProvider, otherProviders ...TrackProvider) *Registry {
+	registry := &Registry{
+		defaultProvider: defaultProvider,
+		providers:       make(map[string]TrackProvider),
+	}
+
+	registry.Register(defaultProvider)
+	for _, provider := range otherProviders {
+		registry.Register(provider)
+	}
+
+	return registry
+}
+
+func (r *Registry) Register(provider TrackProvider) {
+	if _, exists := r.providers[provider.Name()]; !exists {
+		r.providersNames = append(r.providersNames, provider.Name())
+	}
+
+	r.providers[provider.Name()] = provider
+}
+
+func (r *Registry) Get(providerName string) (TrackProvider, bool) {
+	if providerName == "" {
+		return r.defaultProvider, true
+	}
+
+	provider, exists := r.providers[providerName]
+	return provider, exists
+}
+
+// FetchTracks returns the metadata of the found tracks in the order of given tracks IDs.
+// Returned tracks IDs are the ones that were given, prefix included.
+// Tracks IDs whose prefix matches no provider are unknown tracks, they are omitted.
+// Failures of the providers, partial or not, are merged into one *PartialFetchError
+// so that the tracks fetched by the other providers are kept.
+// The error of the first provider is returned as is when every provider failed.
+func (r *Registry) FetchTracks(ctx context.Context, tracksIDs []string) ([]shared.TrackMetadata, error) {
+	providersNames := make([]string, 0)
+	providersTracksIDs := make(map[string][]string)
+	for _, trackID := range tracksIDs {
+		providerName, providerTrackID := SplitTrackID(trackID)
+
+		if _, exists := providersTracksIDs[providerName]; !exists {
+			providersNames = append(providersNames, providerName)
+		}
+		providersTracksIDs[providerName] = append(providersTracksIDs[providerName], providerTrackID)
+	}
+
+	fetchedTracks := make(map[string]shared.TrackMetadata, len(tracksIDs))
+	var (
+		partialFetchError      *PartialFetchError
+		firstProviderError     error
+		fetchingProvidersCount int
+		failedProvidersCount   int
+	)
+	for _, providerName := range providersNames {
+		provider, exists := r.Get(providerName)
+		if !exists {
+			continue
+		}
+		fetchingProvidersCount++
+
+		metadata, err := provider.FetchTracks(ctx, providersTracksIDs[providerName])
+		failedTracksIDs := providersTracksIDs[providerName]
+		var providerPartialFetchError *PartialFetchError
+		if errors.As(err, &providerPartialFetchError) {
+			failedTracksIDs = providerPartialFetchError.FailedTracksIDs
+			err = providerPartialFetchError.Err
+		} else if err != nil {
+			failedProvidersCount++
+			if firstProviderError == nil {
+				firstProviderError = err
+			}
+		}
+
+		if err != nil {
+			if partialFetchError == nil {
+				partialFetchError = &PartialFetchError{
+					Err: err,
+				}
+			}
+
+			for _, failedTrackID := range failedTracksIDs {
+				if providerName != "" {
+					failedTrackID = JoinTrackID(providerName, failedTrackID)
+				}
+
+				partialFetchError.FailedTracksIDs = append(partialFetchError.FailedTracksIDs, failedTrackID)
+			}
+		}
+
+		for _, trackMetadata := range metadata {
+			if providerName != "" {
+				trackMetadata.ID = JoinTrackID(providerName, trackMetadata.ID)
+			}
+
+			fetchedTracks[trackMetadata.ID] = trackMetadata
+		}
+	}
+
+	everyProviderFailed := failedProvidersCount > 0 && failedProvidersCount == fetchingProvidersCount
+	if everyProviderFailed {
+		return nil, firstProviderError
+	}
+
+	metadata := make([]shared.TrackMetadata, 0, len(fetchedTracks))
+	for _, trackID := range tracksIDs {
+		trackMetadata, exists := fetchedTracks[trackID]
+		if !exists {
+			continue
+		}
+
+		metadata = append(metadata, trackMetadata)
+		// Duplicated tracks IDs are only returned once
+		delete(fetchedTracks, trackID)
+	}
+
+	if partialFetchError != nil {
+		return metadata, partialFetchError
+	}
+
+	return metadata, nil
+}
+
+// SearchTracks asks every provider implementing TrackSearcher in registration order,
+// the results of the default provider come first.
+// Returned tracks IDs are prefixed as FetchTracks expects them.
+func (r *Registry) SearchTracks(ctx context.Context, query string, limit int) ([]shared.TrackMetadata, error) {
+	if limit <= 0 {
+		return make([]shared.TrackMetadata, 0), nil
+	}
+
+	metadata := make([]shared.TrackMetadata, 0, limit)
+
+	for _, providerName := range r.providersNames {
+		if len(metadata) >= limit {
+			break
+		}
+
+		searcher, isSearcher := r.providers[providerName].(TrackSearcher)
+		if !isSearcher {
+			continue
+		}
+
+		results, err := searcher.SearchTracks(ctx, query, limit-len(metadata))
+		if err != nil {
+			return nil, err
+		}
+
+		isDefaultProvider := r.providers[providerName] == r.defaultProvider
+		for _, trackMetadata := range results {
+			if !isDefaultProvider {
+				trackMetadata.ID = JoinTrackID(providerName, trackMetadata.ID)
+			}
+
+			metadata = append(metadata, trackMetadata)
+		}
+	}
+
+	if len(metadata) > limit {
+		metadata = metadata[:limit]
+	}
+
+	return metadata, nil
+}
+
+// FindRelatedTracks asks the provider of the track for related tracks.
+// No track is returned when the provider does not implement RelatedTracksFinder.
+// Returned tracks IDs are prefixed as the given one is.
+func (r *Registry) FindRelatedTracks(ctx context.Context, track shared.TrackMetadata, limit int) ([]shared.TrackMetadata, error) {
+	if limit <= 0 {
+		return make([]shared.TrackMetadata, 0), nil
+	}
+
+	providerName, providerTrackID := SplitTrackID(track.ID)
+	provider, exists := r.Get(providerName)
+	if !exists {
+		return nil, fmt.Errorf("unknown track provider %q", providerName)
+	}
+
+	finder, isFinder := provider.(RelatedTracksFinder)
+	if !isFinder {
+		return make([]shared.TrackMetadata, 0), nil
+	}
+
+	track.ID = providerTrackID
+	results, err := finder.FindRelatedTracks(ctx, track, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	metadata := make([]shared.TrackMetadata, 0, len(results))
+	for _, trackMetadata := range results {
+		if providerName != "" {
+			trackMetadata.ID = JoinTrackID(providerName, trackMetadata.ID)
+		}
+
+		metadata = append(metadata, trackMetadata)
+	}
+
+	if len(metadata) > limit {
+		metadata = metadata[:limit]
+	}
+
+	return metadata, nil
+}
@@ -0,0 +1,229 @@
+package providers_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/AdonisEnProvence/MusicRoom/providers"
+	"github.com/AdonisEnProvence/MusicRoom/shared"
+	"github.com/stretchr/testify/suite"
+)
+
+type UnitTestSuite struct {
+	suite.Suite
+}
+
+type defaultProviderStub struct {
+	*providers.FixtureProvider
+
+	receivedTracksIDs []string
+}
+
+func (p *defaultProviderStub) Name() string {
+	return "default"
+}
+
+func (p *defaultProviderStub) FetchTracks(ctx context.Context, tracksIDs []string) ([]shared.TrackMetadata, error) {
+	p.receivedTracksIDs = append(p.receivedTracksIDs, tracksIDs...)
+
+	return p.FixtureProvider.FetchTracks(ctx, tracksIDs)
+}
+
+func (s *UnitTestSuite) Test_FixtureProviderLoadsTracksFromFile() {
+	provider, err := providers.NewFixtureProviderFromFile("testdata/tracks.json")
+	s.NoError(err)
+
+	metadata, err := provider.FetchTracks(context.Background(), []string{"second-track", "unknown-track"})
+	s.NoError(err)
+	s.Equal([]shared.TrackMetadata{
+		{
+			ID:         "second-track",
+			Title:      "Second track",
+			ArtistName: "Local artist",
+			Duration:   240 * time.Second,
+		},
+	}, metadata)
+}
+
+func (s *UnitTestSuite) Test_RegistryMixesProvidersInGivenOrder() {
+	fixtureProvider, err := providers.NewFixtureProviderFromFile("testdata/tracks.json")
+	s.NoError(err)
+
+	defaultTrack := shared.TrackMetadata{
+		ID:         "dQw4w9WgXcQ",
+		Title:      "Default track",
+		ArtistName: "Default artist",
+		Duration:   time.Minute,
+	}
+	defaultProvider := &defaultProviderStub{
+		FixtureProvider: providers.NewFixtureProvider([]shared.TrackMetadata{defaultTrack}),
+	}
+
+	registry := providers.NewRegistry(defaultProvider, fixtureProvider)
+
+	metadata, err := registry.FetchTracks(context.Background(), []string{
+		"local:second-track",
+		defaultTrack.ID,
+		"local:unknown-track",
+		"default:" + defaultTrack.ID,
+		"local:first-track",
+	})
+	s.NoError(err)
+
+	// Tracks IDs without prefix are fetched from the default provider
+	s.Equal([]string{defaultTrack.ID, defaultTrack.ID}, defaultProvider.receivedTracksIDs)
+
+	metadataIDs := make([]string, 0, len(metadata))
+	for _, trackMetadata := range metadata {
+		metadataIDs = append(metadataIDs, trackMetadata.ID)
+	}
+	s.Equal([]string{
+		"local:second-track",
+		defaultTrack.ID,
+		"default:" + defaultTrack.ID,
+		"local:first-track",
+	}, metadataIDs)
+}
+
+func (s *UnitTestSuite) Test_RegistryOmitsTracksOfUnknownProviders() {
+	fixtureProvider, err := providers.NewFixtureProviderFromFile("testdata/tracks.json")
+	s.NoError(err)
+
+	registry := providers.NewRegistry(providers.NewFixtureProvider(nil), fixtureProvider)
+
+	metadata, err := registry.FetchTracks(context.Background(), []string{"unknown:track", "local:first-track"})
+	s.NoError(err)
+	s.Len(metadata, 1)
+	s.Equal("local:first-track", metadata[0].ID)
+
+	metadata, err = registry.FetchTracks(context.Background(), []string{"unknown:track"})
+	s.NoError(err)
+	s.Empty(metadata)
+}
+
+type partiallyFailingProviderStub struct {
+	*providers.FixtureProvider
+}
+
+func (p *partiallyFailingProviderStub) FetchTracks(ctx context.Context, tracksIDs []string) ([]shared.TrackMetadata, error) {
+	metadata, _ := p.FixtureProvider.FetchTracks(ctx, tracksIDs[:1])
+
+	return metadata, &providers.PartialFetchError{
+		FailedTracksIDs: tracksIDs[1:],
+		Err:             errors.New("batch failed"),
+	}
+}
+
+func (s *UnitTestSuite) Test_RegistryReturnsPartiallyFetchedTracks() {
+	fixtureProvider, err := providers.NewFixtureProviderFromFile("testdata/tracks.json")
+	s.NoError(err)
+
+	registry := providers.NewRegistry(providers.NewFixtureProvider(nil), &partiallyFailingProviderStub{
+		FixtureProvider: fixtureProvider,
+	})
+
+	metadata, err := registry.FetchTracks(context.Background(), []string{"local:first-track", "local:second-track"})
+
+	var partialFetchError *providers.PartialFetchError
+	s.True(errors.As(err, &partialFetchError))
+	s.Equal([]string{"local:second-track"}, partialFetchError.FailedTracksIDs)
+	s.Len(metadata, 1)
+	s.Equal("local:first-track", metadata[0].ID)
+}
+
+type failingProviderStub struct {
+	err error
+}
+
+func (p *failingProviderStub) Name() string {
+	return "failing"
+}
+
+func (p *failingProviderStub) FetchTracks(ctx context.Context, tracksIDs []string) ([]shared.TrackMetadata, error) {
+	return nil, p.err
+}
+
+func (s *UnitTestSuite) Test_RegistryKeepsTracksOfOtherProvidersWhenOneFails() {
+	fixtureProvider, err := providers.NewFixtureProviderFromFile("testdata/tracks.json")
+	s.NoError(err)
+
+	providerErr := errors.New("provider is down")
+	registry := providers.NewRegistry(providers.NewFixtureProvider(nil), fixtureProvider, &failingProviderStub{
+		err: providerErr,
+	})
+
+	metadata, err := registry.FetchTracks(context.Background(), []string{"failing:track", "local:first-track"})
+
+	var partialFetchError *providers.PartialFetchError
+	s.True(errors.As(err, &partialFetchError))
+	s.ErrorIs(err, providerErr)
+	s.Equal([]string{"failing:track"}, partialFetchError.FailedTracksIDs)
+	s.Len(metadata, 1)
+	s.Equal("local:first-track", metadata[0].ID)
+
+	// Nothing could be fetched, the error of the provider is returned as is
+	metadata, err = registry.FetchTracks(context.Background(), []string{"failing:track"})
+	s.Nil(metadata)
+	s.Equal(providerErr, err)
+}
+
+func (s *UnitTestSuite) Test_RegistrySearchesProvidersInRegistrationOrder() {
+	fixtureProvider, err := providers.NewFixtureProviderFromFile("testdata/tracks.json")
+	s.NoError(err)
+
+	defaultProvider := providers.NewFixtureProvider([]shared.TrackMetadata{
+		{ID: "default-track", Title: "Second default track", ArtistName: "Default artist", Duration: time.Minute},
+		{ID: "other-track", Title: "Other", ArtistName: "Other artist", Duration: time.Minute},
+	})
+	registry := providers.NewRegistry(&defaultProviderStub{
+		FixtureProvider: defaultProvider,
+	}, fixtureProvider)
+
+	metadata, err := registry.SearchTracks(context.Background(), "second track", 10)
+	s.NoError(err)
+
+	metadataIDs := make([]string, 0, len(metadata))
+	for _, trackMetadata := range metadata {
+		metadataIDs = append(metadataIDs, trackMetadata.ID)
+	}
+	// Tracks matching more words come first within each provider
+	s.Equal([]string{
+		"default-track",
+		"local:second-track",
+		"local:first-track",
+	}, metadataIDs)
+
+	metadata, err = registry.SearchTracks(context.Background(), "second track", 2)
+	s.NoError(err)
+	s.Len(metadata, 2)
+}
+
+func (s *UnitTestSuite) Test_RegistryFindsRelatedTracksWithTheProviderOfTheTrack() {
+	fixtureProvider, err := providers.NewFixtureProviderFromFile("testdata/tracks.json")
+	s.NoError(err)
+
+	registry := providers.NewRegistry(&defaultProviderStub{
+		FixtureProvider: providers.NewFixtureProvider(nil),
+	}, fixtureProvider)
+
+	metadata, err := registry.FindRelatedTracks(context.Background(), shared.TrackMetadata{
+		ID:         "local:first-track",
+		ArtistName: "Local artist",
+	}, 10)
+	s.NoError(err)
+	s.Len(metadata, 1)
+	s.Equal("local:second-track", metadata[0].ID)
+
+	metadata, err = registry.FindRelatedTracks(context.Background(), shared.TrackMetadata{
+		ID:         "default-track",
+		ArtistName: "Local artist",
+	}, 10)
+	s.NoError(err)
+	s.Empty(metadata)
+}
+
+func TestUnitTestSuite(t *testing.T) {
+	suite.Run(t, new(UnitTestSuite))
+}
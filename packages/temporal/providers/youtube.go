@@ -0,0 +1,186 @@
+package providers
+
+import (
+	"context"
+	"errors"
+	"log"
+	"os"
+	"time"
+
+	"github.com/AdonisEnProvence/MusicRoom/shared"
+	"github.com/AdonisEnProvence/MusicRoom/youtube"
+	"github.com/senseyeio/duration"
+)
+
+const YouTubeProviderName = "youtube"
+
+// Returned as well when the key is rejected by YouTube.
+var ErrInvalidGoogleAPIKey = youtube.ErrInvalidAPIKey
+
+var ErrQuotaExceeded = youtube.ErrQuotaExceeded
+
+// NextQuotaReset returns when the quota of the YouTube provider is reset after being exceeded.
+var NextQuotaReset = youtube.NextQuotaReset
+
+type YouTubeProvider struct {
+	apiKey string
+	client *youtube.Client
+}
+
+func NewYouTubeProvider(options youtube.ClientOptions) *YouTubeProvider {
+	return &YouTubeProvider{
+		apiKey: options.APIKey,
+		client: youtube.NewClient(options),
+	}
+}
+
+// NewYouTubeProviderFromEnv reads GOOGLE_API_KEY, and optionally YOUTUBE_API_BASE_URL
+// and YOUTUBE_API_TIMEOUT as a duration like 10s.
+func NewYouTubeProviderFromEnv() *YouTubeProvider {
+	options := youtube.ClientOptions{
+		APIKey:  os.Getenv("GOOGLE_API_KEY"),
+		BaseURL: os.Getenv("YOUTUBE_API_BASE_URL"),
+	}
+
+	if timeout := os.Getenv("YOUTUBE_API_TIMEOUT"); timeout != "" {
+		parsedTimeout, err := time.ParseDuration(timeout)
+		if err != nil {
+			log.Println("invalid YOUTUBE_API_TIMEOUT, default timeout is used", err)
+		}
+
+		options.Timeout = parsedTimeout
+	}
+
+	return NewYouTubeProvider(options)
+}
+
+func (p *YouTubeProvider) Name() string {
+	return YouTubeProviderName
+}
+
+func (p *YouTubeProvider) FetchTracks(ctx context.Context, tracksIDs []string) ([]shared.TrackMetadata, error) {
+	metadata := make([]shared.TrackMetadata, 0, len(tracksIDs))
+
+	if len(tracksIDs) == 0 {
+		return metadata, nil
+	}
+
+	if p.apiKey == "" {
+		return nil, ErrInvalidGoogleAPIKey
+	}
+
+	youtubeResponse, err := p.client.FetchVideos(ctx, tracksIDs)
+	var youtubePartialFetchError *youtube.PartialFetchError
+	if errors.As(err, &youtubePartialFetchError) {
+		err = &PartialFetchError{
+			FailedTracksIDs: youtubePartialFetchError.FailedVideosIDs(),
+			Err:             youtubePartialFetchError,
+		}
+	} else if err != nil {
+		return nil, err
+	}
+
+	metadata = append(metadata, toTracksMetadata(youtubeResponse.Items)...)
+
+	return metadata, err
+}
+
+// SearchTracks returns the videos in the order of relevance YouTube gives.
+func (p *YouTubeProvider) SearchTracks(ctx context.Context, query string, limit int) ([]shared.TrackMetadata, error) {
+	if p.apiKey == "" {
+		return nil, ErrInvalidGoogleAPIKey
+	}
+
+	youtubeResponse, err := p.client.SearchVideos(ctx, query, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	return toTracksMetadata(youtubeResponse.Items), nil
+}
+
+// FindRelatedTracks searches the videos of the channel of the track as YouTube
+// does not list related videos anymore.
+func (p *YouTubeProvider) FindRelatedTracks(ctx context.Context, track shared.TrackMetadata, limit int) ([]shared.TrackMetadata, error) {
+	if track.ArtistName == "" {
+		return make([]shared.TrackMetadata, 0), nil
+	}
+
+	searchLimit := limit + 1
+	if searchLimit > youtube.MaxSearchResults {
+		searchLimit = youtube.MaxSearchResults
+	}
+
+	searchResults, err := p.SearchTracks(ctx, track.ArtistName, searchLimit)
+	if err != nil {
+		return nil, err
+	}
+
+	metadata := make([]shared.TrackMetadata, 0, len(searchResults))
+	for _, trackMetadata := range searchResults {
+		if trackMetadata.ID == track.ID {
+			continue
+		}
+
+		metadata = append(metadata, trackMetadata)
+	}
+	if len(metadata) > limit {
+		metadata = metadata[:limit]
+	}
+
+	return metadata, nil
+}
+
+func toTracksMetadata(videos []youtube.YoutubeVideo) []shared.TrackMetadata {
+	metadata := make([]shared.TrackMetadata, 0, len(videos))
+
+	for _, entry := range videos {
+		parsedDuration, _ := duration.ParseISO8601(entry.ContentDetails.Duration)
+
+		publishedAt, _ := time.Parse(time.RFC3339, entry.Snippet.PublishedAt)
+
+		trackMetadata := shared.TrackMetadata{
+			ID:         entry.ID,
+			Title:      entry.Snippet.Title,
+			ArtistName: entry.Snippet.ChannelTitle,
+			Duration:   isoDurationToDuration(parsedDuration),
+			Thumbnails: shared.TrackThumbnails{
+				Default: toTrackThumbnail(&entry.Snippet.Thumbnails.Default),
+				Medium:  toTrackThumbnail(entry.Snippet.Thumbnails.Medium),
+				High:    toTrackThumbnail(entry.Snippet.Thumbnails.High),
+			},
+			CategoryID:  entry.Snippet.CategoryID,
+			PublishedAt: publishedAt,
+			Restrictions: shared.TrackRestrictions{
+				EmbeddingDisabled: !entry.Status.Embeddable,
+				AgeRestricted:     entry.ContentDetails.ContentRating.YtRating == youtube.YtRatingAgeRestricted,
+				LiveBroadcast:     entry.Snippet.LiveBroadcastContent != "" && entry.Snippet.LiveBroadcastContent != youtube.LiveBroadcastContentNone,
+				AllowedRegions:    entry.ContentDetails.RegionRestriction.Allowed,
+				BlockedRegions:    entry.ContentDetails.RegionRestriction.Blocked,
+			},
+		}
+
+		metadata = append(metadata, trackMetadata)
+	}
+
+	return metadata
+}
+
+func toTrackThumbnail(thumbnail *youtube.YoutubeVideoThumbnail) *shared.TrackThumbnail {
+	if thumbnail == nil || thumbnail.URL == "" {
+		return nil
+	}
+
+	return &shared.TrackThumbnail{
+		URL:    thumbnail.URL,
+		Width:  thumbnail.Width,
+		Height: thumbnail.Height,
+	}
+}
+
+func isoDurationToDuration(d duration.Duration) time.Duration {
+	now := time.Now()
+	appliedDuration := d.Shift(now)
+
+	return appliedDuration.Sub(now)
+}
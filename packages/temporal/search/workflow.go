@@ -0,0 +1,50 @@
+package search
+
+import (
+	"time"
+
+	"github.com/AdonisEnProvence/MusicRoom/activities"
+	"github.com/AdonisEnProvence/MusicRoom/shared"
+	"github.com/go-playground/validator/v10"
+	"go.temporal.io/sdk/workflow"
+)
+
+// The api waits for the workflow to complete before responding.
+const WorkflowExecutionTimeout = 30 * time.Second
+
+var validate = validator.New()
+
+type SearchTracksWorkflowParams struct {
+	Query string `validate:"required,max=200"`
+	// DefaultSearchTracksLimit is used when zero
+	Limit int `validate:"min=0,max=50"`
+	// Policy of the room the tracks are searched for, see api SearchTracksRequestBody
+	PlayabilityPolicy shared.TrackPlayabilityPolicy
+}
+
+// SearchTracksWorkflow runs the search on the worker, where the tracks providers are configured.
+// It only lives for the time of one search, the api executes one per request.
+func SearchTracksWorkflow(ctx workflow.Context, params SearchTracksWorkflowParams) ([]shared.TrackMetadata, error) {
+	if err := validate.Struct(params); err != nil {
+		return nil, err
+	}
+
+	options := workflow.ActivityOptions{
+		StartToCloseTimeout: 20 * time.Second,
+		RetryPolicy:         activities.FetchTracksRetryPolicy,
+	}
+	ctx = workflow.WithActivityOptions(ctx, options)
+
+	var metadata []shared.TrackMetadata
+	if err := workflow.ExecuteActivity(
+		ctx,
+		activities.SearchTracksActivity,
+		params.Query,
+		params.Limit,
+		params.PlayabilityPolicy,
+	).Get(ctx, &metadata); err != nil {
+		return nil, err
+	}
+
+	return metadata, nil
+}
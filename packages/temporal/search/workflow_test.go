@@ -0,0 +1,56 @@
+package search
+
+import (
+	"testing"
+	"time"
+
+	"github.com/AdonisEnProvence/MusicRoom/activities"
+	"github.com/AdonisEnProvence/MusicRoom/shared"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	"go.temporal.io/sdk/testsuite"
+)
+
+type UnitTestSuite struct {
+	suite.Suite
+	testsuite.WorkflowTestSuite
+}
+
+func (s *UnitTestSuite) Test_SearchTracksWorkflowReturnsActivityResults() {
+	env := s.NewTestWorkflowEnvironment()
+
+	tracks := []shared.TrackMetadata{
+		{ID: "dQw4w9WgXcQ", Title: "Never Gonna Give You Up", ArtistName: "Rick Astley", Duration: 213 * time.Second},
+	}
+	policy := shared.TrackPlayabilityPolicy{
+		AllowLiveBroadcasts: true,
+	}
+	env.OnActivity(activities.SearchTracksActivity, mock.Anything, "rick astley", 5, policy).Return(tracks, nil).Once()
+
+	env.ExecuteWorkflow(SearchTracksWorkflow, SearchTracksWorkflowParams{
+		Query:             "rick astley",
+		Limit:             5,
+		PlayabilityPolicy: policy,
+	})
+
+	s.True(env.IsWorkflowCompleted())
+	s.NoError(env.GetWorkflowError())
+
+	var result []shared.TrackMetadata
+	s.NoError(env.GetWorkflowResult(&result))
+	s.Equal(tracks, result)
+	env.AssertExpectations(s.T())
+}
+
+func (s *UnitTestSuite) Test_SearchTracksWorkflowRejectsEmptyQuery() {
+	env := s.NewTestWorkflowEnvironment()
+
+	env.ExecuteWorkflow(SearchTracksWorkflow, SearchTracksWorkflowParams{})
+
+	s.True(env.IsWorkflowCompleted())
+	s.Error(env.GetWorkflowError())
+}
+
+func TestUnitTestSuite(t *testing.T) {
+	suite.Run(t, new(UnitTestSuite))
+}
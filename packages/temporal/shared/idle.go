@@ -0,0 +1,172 @@
+package shared
+
+import (
+	"errors"
+	"time"
+
+	"go.temporal.io/sdk/temporal"
+	"go.temporal.io/sdk/workflow"
+)
+
+type RoomExpirationReason string
+
+const (
+	RoomExpirationReasonEmpty    RoomExpirationReason = "EMPTY"
+	RoomExpirationReasonInactive RoomExpirationReason = "INACTIVE"
+)
+
+// RoomIdlePolicy defines when a room workflow considers it has been abandoned
+// and terminates by itself. Rooms never expire with its zero value.
+// Durations are encoded in nanoseconds, as tracks durations are.
+type RoomIdlePolicy struct {
+	// Delay after which a room without any user expires.
+	// Disabled when zero.
+	EmptyRoomTimeout time.Duration `json:"emptyRoomTimeout" validate:"min=0"`
+	// Delay after which a room that did not receive any signal expires.
+	// Disabled when zero.
+	InactivityTimeout time.Duration `json:"inactivityTimeout" validate:"min=0"`
+}
+
+func (p RoomIdlePolicy) CheckValidity() error {
+	if p.EmptyRoomTimeout < 0 || p.InactivityTimeout < 0 {
+		return errors.New("idle policy timeouts can not be negative")
+	}
+
+	return nil
+}
+
+// RoomIdleClocks are the times the idle timers are computed from.
+// They are carried over by a continue-as-new so that the new run does not reset the timers.
+type RoomIdleClocks struct {
+	LastActivityAt time.Time
+	// Zero when the room is not empty.
+	EmptySince time.Time
+}
+
+// RoomIdleTimers holds the durable timers making a room expire according to its RoomIdlePolicy.
+// Timers are not carried over by a continue-as-new, the new run starts them again
+// for the remaining delays computed from the RoomIdleClocks of the previous run.
+type RoomIdleTimers struct {
+	policy RoomIdlePolicy
+
+	emptyRoomTimer       workflow.Future
+	cancelEmptyRoomTimer workflow.CancelFunc
+	emptySince           time.Time
+	// Signals do not restart the inactivity timer, which would add events to the history for each of them.
+	// The timer is started again for the remaining delay when it fires after an activity.
+	inactivityTimer workflow.Future
+	lastActivityAt  time.Time
+}
+
+func NewRoomIdleTimers(policy RoomIdlePolicy) *RoomIdleTimers {
+	return &RoomIdleTimers{
+		policy: policy,
+	}
+}
+
+// RestoreRoomIdleTimers starts again the timers of a previous run for their remaining delays.
+// The room is considered as active at the time it is restored if the previous run did not record any activity.
+func RestoreRoomIdleTimers(ctx workflow.Context, policy RoomIdlePolicy, clocks RoomIdleClocks) *RoomIdleTimers {
+	t := NewRoomIdleTimers(policy)
+	now := workflow.Now(ctx)
+
+	if !clocks.EmptySince.IsZero() && policy.EmptyRoomTimeout > 0 {
+		t.emptySince = clocks.EmptySince
+		t.startEmptyRoomTimer(ctx, policy.EmptyRoomTimeout-now.Sub(clocks.EmptySince))
+	}
+
+	if clocks.LastActivityAt.IsZero() {
+		t.RecordActivity(ctx)
+		return t
+	}
+
+	if policy.InactivityTimeout > 0 {
+		t.lastActivityAt = clocks.LastActivityAt
+		t.inactivityTimer = workflow.NewTimer(ctx, policy.InactivityTimeout-now.Sub(clocks.LastActivityAt))
+	}
+
+	return t
+}
+
+// Clocks returns what has to be carried over by a continue-as-new to restore the timers.
+func (t *RoomIdleTimers) Clocks() RoomIdleClocks {
+	return RoomIdleClocks{
+		LastActivityAt: t.lastActivityAt,
+		EmptySince:     t.emptySince,
+	}
+}
+
+// UpdateRoomIsEmpty starts the empty room timer when the room becomes empty
+// and cancels it as soon as a user joins the room again.
+func (t *RoomIdleTimers) UpdateRoomIsEmpty(ctx workflow.Context, roomIsEmpty bool) {
+	emptyRoomTimerIsRunning := t.emptyRoomTimer != nil
+
+	if !roomIsEmpty {
+		if emptyRoomTimerIsRunning {
+			t.cancelEmptyRoomTimer()
+			t.emptyRoomTimer = nil
+		}
+		t.emptySince = time.Time{}
+
+		return
+	}
+
+	if emptyRoomTimerIsRunning || t.policy.EmptyRoomTimeout <= 0 {
+		return
+	}
+
+	t.emptySince = workflow.Now(ctx)
+	t.startEmptyRoomTimer(ctx, t.policy.EmptyRoomTimeout)
+}
+
+func (t *RoomIdleTimers) startEmptyRoomTimer(ctx workflow.Context, delay time.Duration) {
+	timerCtx, cancel := workflow.WithCancel(ctx)
+	t.emptyRoomTimer = workflow.NewTimer(timerCtx, delay)
+	t.cancelEmptyRoomTimer = cancel
+}
+
+// RecordActivity is meant to be called each time the room receives a signal.
+func (t *RoomIdleTimers) RecordActivity(ctx workflow.Context) {
+	if t.policy.InactivityTimeout <= 0 {
+		return
+	}
+
+	t.lastActivityAt = workflow.Now(ctx)
+	if t.inactivityTimer == nil {
+		t.inactivityTimer = workflow.NewTimer(ctx, t.policy.InactivityTimeout)
+	}
+}
+
+// AddToSelector makes the selector call onExpiration with the matching reason
+// when one of the running timers fires.
+func (t *RoomIdleTimers) AddToSelector(ctx workflow.Context, selector workflow.Selector, onExpiration func(reason RoomExpirationReason)) {
+	if t.emptyRoomTimer != nil {
+		selector.AddFuture(t.emptyRoomTimer, func(f workflow.Future) {
+			t.emptyRoomTimer = nil
+
+			if err := f.Get(ctx, nil); temporal.IsCanceledError(err) {
+				return
+			}
+
+			onExpiration(RoomExpirationReasonEmpty)
+		})
+	}
+
+	if t.inactivityTimer != nil {
+		selector.AddFuture(t.inactivityTimer, func(f workflow.Future) {
+			t.inactivityTimer = nil
+
+			if err := f.Get(ctx, nil); temporal.IsCanceledError(err) {
+				return
+			}
+
+			inactiveFor := workflow.Now(ctx).Sub(t.lastActivityAt)
+			if inactiveFor < t.policy.InactivityTimeout {
+				t.inactivityTimer = workflow.NewTimer(ctx, t.policy.InactivityTimeout-inactiveFor)
+				return
+			}
+
+			onExpiration(RoomExpirationReasonInactive)
+		})
+	}
+}
@@ -0,0 +1,84 @@
+package shared
+
+import "strings"
+
+const (
+	RejectedTrackReasonLiveBroadcast RejectedTrackReason = "LIVE_BROADCAST"
+	RejectedTrackReasonZeroDuration  RejectedTrackReason = "ZERO_DURATION"
+	RejectedTrackReasonNotEmbeddable RejectedTrackReason = "NOT_EMBEDDABLE"
+	RejectedTrackReasonRegionBlocked RejectedTrackReason = "REGION_BLOCKED"
+)
+
+// TrackPlayabilityPolicy defines which fetched tracks a room accepts.
+// Its zero value rejects every track that can not be played on devices.
+// Tracks without duration are always rejected as the room would skip them instantly.
+type TrackPlayabilityPolicy struct {
+	// Live and upcoming broadcasts
+	AllowLiveBroadcasts bool `json:"allowLiveBroadcasts"`
+	AllowNotEmbeddable  bool `json:"allowNotEmbeddable"`
+	// ISO 3166-1 alpha-2 country code where the room is listened to.
+	// Region restrictions are not checked when empty.
+	Region string `json:"region,omitempty" validate:"omitempty,len=2"`
+}
+
+// Check returns the reason why the track is rejected, if it is.
+func (p TrackPlayabilityPolicy) Check(track TrackMetadata) (RejectedTrackReason, bool) {
+	if track.Duration <= 0 {
+		return RejectedTrackReasonZeroDuration, false
+	}
+
+	if track.Restrictions.LiveBroadcast && !p.AllowLiveBroadcasts {
+		return RejectedTrackReasonLiveBroadcast, false
+	}
+
+	if track.Restrictions.EmbeddingDisabled && !p.AllowNotEmbeddable {
+		return RejectedTrackReasonNotEmbeddable, false
+	}
+
+	if p.Region != "" && !track.Restrictions.IsPlayableIn(p.Region) {
+		return RejectedTrackReasonRegionBlocked, false
+	}
+
+	return "", true
+}
+
+// Filter splits the tracks into the accepted and rejected ones, keeping their order.
+func (p TrackPlayabilityPolicy) Filter(tracks []TrackMetadata) ([]TrackMetadata, []RejectedTrack) {
+	acceptedTracks := make([]TrackMetadata, 0, len(tracks))
+	rejectedTracks := make([]RejectedTrack, 0)
+
+	for _, track := range tracks {
+		reason, ok := p.Check(track)
+		if !ok {
+			rejectedTracks = append(rejectedTracks, RejectedTrack{
+				ID:     track.ID,
+				Reason: reason,
+			})
+			continue
+		}
+
+		acceptedTracks = append(acceptedTracks, track)
+	}
+
+	return acceptedTracks, rejectedTracks
+}
+
+func (r TrackRestrictions) IsPlayableIn(region string) bool {
+	for _, blockedRegion := range r.BlockedRegions {
+		if strings.EqualFold(blockedRegion, region) {
+			return false
+		}
+	}
+
+	if len(r.AllowedRegions) == 0 {
+		return true
+	}
+
+	for _, allowedRegion := range r.AllowedRegions {
+		if strings.EqualFold(allowedRegion, region) {
+			return true
+		}
+	}
+
+	return false
+}
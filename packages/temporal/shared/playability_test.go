@@ -0,0 +1,85 @@
+package shared_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/AdonisEnProvence/MusicRoom/shared"
+	"github.com/stretchr/testify/suite"
+)
+
+type UnitTestSuite struct {
+	suite.Suite
+}
+
+func (s *UnitTestSuite) Test_TrackPlayabilityPolicyRejectsUnplayableTracks() {
+	tracks := []shared.TrackMetadata{
+		{
+			ID:       "playable",
+			Duration: time.Minute,
+			Restrictions: shared.TrackRestrictions{
+				BlockedRegions: []string{"DE"},
+			},
+		},
+		{
+			ID: "zero-duration",
+		},
+		{
+			ID:       "live",
+			Duration: time.Minute,
+			Restrictions: shared.TrackRestrictions{
+				LiveBroadcast: true,
+			},
+		},
+		{
+			ID:       "not-embeddable",
+			Duration: time.Minute,
+			Restrictions: shared.TrackRestrictions{
+				EmbeddingDisabled: true,
+			},
+		},
+		{
+			ID:       "blocked-in-france",
+			Duration: time.Minute,
+			Restrictions: shared.TrackRestrictions{
+				BlockedRegions: []string{"FR"},
+			},
+		},
+		{
+			ID:       "only-allowed-in-germany",
+			Duration: time.Minute,
+			Restrictions: shared.TrackRestrictions{
+				AllowedRegions: []string{"DE"},
+			},
+		},
+	}
+
+	policy := shared.TrackPlayabilityPolicy{
+		Region: "fr",
+	}
+	acceptedTracks, rejectedTracks := policy.Filter(tracks)
+
+	s.Equal(tracks[:1], acceptedTracks)
+	s.Equal([]shared.RejectedTrack{
+		{ID: "zero-duration", Reason: shared.RejectedTrackReasonZeroDuration},
+		{ID: "live", Reason: shared.RejectedTrackReasonLiveBroadcast},
+		{ID: "not-embeddable", Reason: shared.RejectedTrackReasonNotEmbeddable},
+		{ID: "blocked-in-france", Reason: shared.RejectedTrackReasonRegionBlocked},
+		{ID: "only-allowed-in-germany", Reason: shared.RejectedTrackReasonRegionBlocked},
+	}, rejectedTracks)
+
+	permissivePolicy := shared.TrackPlayabilityPolicy{
+		AllowLiveBroadcasts: true,
+		AllowNotEmbeddable:  true,
+	}
+	acceptedTracks, rejectedTracks = permissivePolicy.Filter(tracks)
+
+	s.Len(acceptedTracks, len(tracks)-1)
+	s.Equal([]shared.RejectedTrack{
+		{ID: "zero-duration", Reason: shared.RejectedTrackReasonZeroDuration},
+	}, rejectedTracks)
+}
+
+func TestUnitTestSuite(t *testing.T) {
+	suite.Run(t, new(UnitTestSuite))
+}
@@ -12,6 +12,103 @@ type TrackMetadata struct {
 	Title      string        `json:"title"`
 	ArtistName string        `json:"artistName"`
 	Duration   time.Duration `json:"duration"`
+
+	Thumbnails   TrackThumbnails   `json:"thumbnails"`
+	CategoryID   string            `json:"categoryId,omitempty"`
+	PublishedAt  time.Time         `json:"publishedAt"`
+	Restrictions TrackRestrictions `json:"restrictions"`
+}
+
+type TrackThumbnail struct {
+	URL    string `json:"url"`
+	Width  int    `json:"width"`
+	Height int    `json:"height"`
+}
+
+// Sizes the provider does not give are nil.
+type TrackThumbnails struct {
+	Default *TrackThumbnail `json:"default,omitempty"`
+	Medium  *TrackThumbnail `json:"medium,omitempty"`
+	High    *TrackThumbnail `json:"high,omitempty"`
+}
+
+// TrackRestrictions zero value is a track that can be played by anyone anywhere,
+// as tracks fetched before restrictions were known have to be considered.
+type TrackRestrictions struct {
+	EmbeddingDisabled bool `json:"embeddingDisabled"`
+	AgeRestricted     bool `json:"ageRestricted"`
+	// Live or upcoming broadcast
+	LiveBroadcast bool `json:"liveBroadcast"`
+	// ISO 3166-1 alpha-2 country codes, the track is only playable in AllowedRegions when not empty
+	AllowedRegions []string `json:"allowedRegions,omitempty"`
+	BlockedRegions []string `json:"blockedRegions,omitempty"`
+}
+
+// Equal compares the tracks by value, TrackMetadata can not be compared with ==.
+func (t TrackMetadata) Equal(other TrackMetadata) bool {
+	return t.ID == other.ID &&
+		t.Title == other.Title &&
+		t.ArtistName == other.ArtistName &&
+		t.Duration == other.Duration &&
+		t.Thumbnails.Equal(other.Thumbnails) &&
+		t.CategoryID == other.CategoryID &&
+		t.PublishedAt.Equal(other.PublishedAt) &&
+		t.Restrictions.Equal(other.Restrictions)
+}
+
+func (t TrackThumbnails) Equal(other TrackThumbnails) bool {
+	return thumbnailsAreEqual(t.Default, other.Default) &&
+		thumbnailsAreEqual(t.Medium, other.Medium) &&
+		thumbnailsAreEqual(t.High, other.High)
+}
+
+func thumbnailsAreEqual(a *TrackThumbnail, b *TrackThumbnail) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+
+	return *a == *b
+}
+
+func (r TrackRestrictions) Equal(other TrackRestrictions) bool {
+	return r.EmbeddingDisabled == other.EmbeddingDisabled &&
+		r.AgeRestricted == other.AgeRestricted &&
+		r.LiveBroadcast == other.LiveBroadcast &&
+		stringSlicesAreEqual(r.AllowedRegions, other.AllowedRegions) &&
+		stringSlicesAreEqual(r.BlockedRegions, other.BlockedRegions)
+}
+
+func stringSlicesAreEqual(a []string, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	for index := range a {
+		if a[index] != b[index] {
+			return false
+		}
+	}
+
+	return true
+}
+
+type RejectedTrackReason string
+
+const (
+	// The provider did not return the track, it has been deleted,
+	// made private or its ID is wrong.
+	RejectedTrackReasonNotFound RejectedTrackReason = "NOT_FOUND"
+	// The track could not be fetched, retrying later may work.
+	RejectedTrackReasonFetchFailed RejectedTrackReason = "FETCH_FAILED"
+	// Nothing matched the searched query, the ID of the rejected track is the query.
+	RejectedTrackReasonNoSearchResult RejectedTrackReason = "NO_SEARCH_RESULT"
+	// The provider quota is exceeded until it is reset, the track can be suggested again afterwards.
+	RejectedTrackReasonQuotaExceeded RejectedTrackReason = "QUOTA_EXCEEDED"
+)
+
+type RejectedTrack struct {
+	ID     string              `json:"id"`
+	Reason RejectedTrackReason `json:"reason"`
 }
 
 //Custom config for mapstructure time.Time
@@ -2,6 +2,9 @@ package main
 
 import (
 	"log"
+	"os"
+	"strconv"
+	"time"
 
 	activities_mpe "github.com/AdonisEnProvence/MusicRoom/mpe/activities"
 	activities_mtv "github.com/AdonisEnProvence/MusicRoom/mtv/activities"
@@ -13,9 +16,15 @@ import (
 	mpe "github.com/AdonisEnProvence/MusicRoom/mpe/workflows"
 	shared_mtv "github.com/AdonisEnProvence/MusicRoom/mtv/shared"
 	mtv "github.com/AdonisEnProvence/MusicRoom/mtv/workflows"
+	"github.com/AdonisEnProvence/MusicRoom/providers"
+	"github.com/AdonisEnProvence/MusicRoom/search"
 )
 
 func main() {
+	if err := activities.AdonisCallbacks.ConfigurationErr(); err != nil {
+		log.Fatalln("unable to configure Adonis callbacks", err)
+	}
+
 	// Create the client object just once per process
 	c, err := client.NewClient(client.Options{})
 	if err != nil {
@@ -25,9 +34,47 @@ func main() {
 	// This worker hosts both Worker and Activity functions
 	w := worker.New(c, shared_mtv.ControlTaskQueue, worker.Options{})
 
+	// Tracks providers, tracks IDs without prefix are YouTube videos
+	trackProviders := providers.NewRegistry(providers.NewYouTubeProviderFromEnv())
+	if fixturesPath := os.Getenv("LOCAL_TRACKS_FIXTURES_PATH"); fixturesPath != "" {
+		fixtureProvider, err := providers.NewFixtureProviderFromFile(fixturesPath)
+		if err != nil {
+			log.Fatalln("unable to load local tracks fixtures", err)
+		}
+
+		trackProviders.Register(fixtureProvider)
+	}
+	activities.TrackProviders = trackProviders
+
+	// Tracks metadata cache, disabled when no TTL is given
+	if cacheTTL := os.Getenv("TRACKS_METADATA_CACHE_TTL"); cacheTTL != "" {
+		tracksMetadataCache, err := newTracksMetadataCacheFromEnv(cacheTTL)
+		if err != nil {
+			log.Fatalln("unable to create tracks metadata cache", err)
+		}
+
+		activities.TracksMetadataCache = tracksMetadataCache
+		go logTracksMetadataCacheStats(tracksMetadataCache)
+		defer func() {
+			if err := tracksMetadataCache.Close(); err != nil {
+				log.Println("unable to persist tracks metadata cache", err)
+			}
+		}()
+	}
+
+	// The auto-DJ reads the playlist of MPE rooms
+	activities.TemporalClient = c
+
 	// Common activities
 	w.RegisterActivity(activities.FetchTracksInformationActivity)
 	w.RegisterActivity(activities.FetchTracksInformationActivityAndForwardInitiator)
+	w.RegisterActivity(activities.FetchTracksInformationWithPolicyActivity)
+	w.RegisterActivity(activities.SearchTracksActivity)
+	w.RegisterActivity(activities.SearchTopTrackActivityAndForwardInitiator)
+	w.RegisterActivity(activities.FetchAutoDJTracksActivity)
+
+	// Tracks search workflow, executed by the api for each search
+	w.RegisterWorkflow(search.SearchTracksWorkflow)
 
 	// Mtv workflows
 	w.RegisterWorkflow(mtv.MtvRoomWorkflow)
@@ -49,3 +96,47 @@ func main() {
 		log.Fatalln("unable to start Worker", err)
 	}
 }
+
+const defaultTracksMetadataCacheMaxEntries = 10000
+
+func newTracksMetadataCacheFromEnv(cacheTTL string) (*providers.MetadataCache, error) {
+	ttl, err := time.ParseDuration(cacheTTL)
+	if err != nil {
+		return nil, err
+	}
+
+	options := providers.MetadataCacheOptions{
+		TTL:        ttl,
+		MaxEntries: defaultTracksMetadataCacheMaxEntries,
+	}
+
+	if maxEntries := os.Getenv("TRACKS_METADATA_CACHE_MAX_ENTRIES"); maxEntries != "" {
+		options.MaxEntries, err = strconv.Atoi(maxEntries)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if cachePath := os.Getenv("TRACKS_METADATA_CACHE_PATH"); cachePath != "" {
+		options.Store = providers.NewFileMetadataCacheStore(cachePath)
+	}
+
+	if persistInterval := os.Getenv("TRACKS_METADATA_CACHE_PERSIST_INTERVAL"); persistInterval != "" {
+		options.PersistInterval, err = time.ParseDuration(persistInterval)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return providers.NewMetadataCache(options)
+}
+
+const tracksMetadataCacheStatsInterval = 10 * time.Minute
+
+func logTracksMetadataCacheStats(cache *providers.MetadataCache) {
+	for range time.Tick(tracksMetadataCacheStatsInterval) {
+		stats := cache.Stats()
+
+		log.Printf("tracks metadata cache: %d hits, %d misses, %d entries\n", stats.Hits, stats.Misses, stats.Entries)
+	}
+}
@@ -0,0 +1,206 @@
+package youtube
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	DefaultBaseURL = "https://youtube.googleapis.com/youtube/v3"
+	DefaultTimeout = 10 * time.Second
+)
+
+type ClientOptions struct {
+	APIKey string
+	// DefaultBaseURL is used when empty
+	BaseURL string
+	// Timeout of each request, DefaultTimeout is used when zero
+	Timeout time.Duration
+	// Defaults to time.Now
+	Now func() time.Time
+}
+
+// Client calls the YouTube Data API.
+// Once the quota has been exceeded, it fails without calling the API until the quota is reset.
+type Client struct {
+	apiKey     string
+	baseURL    string
+	httpClient *http.Client
+	now        func() time.Time
+
+	mu            sync.Mutex
+	quotaResetsAt time.Time
+}
+
+func NewClient(options ClientOptions) *Client {
+	client := &Client{
+		apiKey:  options.APIKey,
+		baseURL: strings.TrimSuffix(options.BaseURL, "/"),
+		httpClient: &http.Client{
+			Timeout: options.Timeout,
+		},
+		now: options.Now,
+	}
+
+	if client.baseURL == "" {
+		client.baseURL = DefaultBaseURL
+	}
+	if client.httpClient.Timeout == 0 {
+		client.httpClient.Timeout = DefaultTimeout
+	}
+	if client.now == nil {
+		client.now = time.Now
+	}
+
+	return client
+}
+
+// FetchVideos splits videosIDs into batches of MaxVideosIDsPerRequest
+// fetched concurrently. Videos are returned in the order of videosIDs.
+// When only some batches fail, the videos of the others are returned with a *PartialFetchError.
+// When every batch fails, the error of the first one is returned.
+func (c *Client) FetchVideos(ctx context.Context, videosIDs []string) (YoutubeVideosListAPIResponse, error) {
+	return fetchVideosInBatches(ctx, videosIDs, c.fetchVideosBatch)
+}
+
+// QuotaResetsAt returns the zero time when the quota has not been exceeded.
+func (c *Client) QuotaResetsAt() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if !c.now().Before(c.quotaResetsAt) {
+		return time.Time{}
+	}
+
+	return c.quotaResetsAt
+}
+
+func (c *Client) computeVideosEndpointURL(videosIDs []string) string {
+	var PartsToGet = []string{
+		"snippet",
+		"contentDetails",
+		"status",
+	}
+
+	joinedPartsToGet := strings.Join(PartsToGet, ",")
+	params := url.Values{
+		"part": {joinedPartsToGet},
+		"id":   videosIDs,
+		"key":  {c.apiKey},
+	}
+
+	// As https://youtube.googleapis.com/youtube/v3/videos?part=snippet%2CcontentDetails%2Cstatus&id=Ks-_Mh1QhMc&id=9Tfciw7QM3c&key=[API_KEY]
+	return c.baseURL + "/videos?" + params.Encode()
+}
+
+// SearchVideos returns the videos matching query, most relevant first.
+// Search results only contain snippets, the videos are then fetched like FetchVideos does.
+func (c *Client) SearchVideos(ctx context.Context, query string, maxResults int) (YoutubeVideosListAPIResponse, error) {
+	if maxResults <= 0 || maxResults > MaxSearchResults {
+		maxResults = MaxSearchResults
+	}
+
+	var searchResponse YoutubeSearchListAPIResponse
+	if err := c.get(ctx, c.computeSearchEndpointURL(query, maxResults), &searchResponse); err != nil {
+		return YoutubeVideosListAPIResponse{}, err
+	}
+
+	videosIDs := make([]string, 0, len(searchResponse.Items))
+	for _, result := range searchResponse.Items {
+		if result.ID.VideoID == "" {
+			continue
+		}
+
+		videosIDs = append(videosIDs, result.ID.VideoID)
+	}
+	if len(videosIDs) == 0 {
+		return YoutubeVideosListAPIResponse{
+			Kind:  "youtube#videoListResponse",
+			Items: make([]YoutubeVideo, 0),
+		}, nil
+	}
+
+	return c.FetchVideos(ctx, videosIDs)
+}
+
+func (c *Client) computeSearchEndpointURL(query string, maxResults int) string {
+	params := url.Values{
+		"part":       {"snippet"},
+		"type":       {"video"},
+		"q":          {query},
+		"maxResults": {strconv.Itoa(maxResults)},
+		"key":        {c.apiKey},
+	}
+
+	return c.baseURL + "/search?" + params.Encode()
+}
+
+func (c *Client) fetchVideosBatch(ctx context.Context, videosIDs []string) (YoutubeVideosListAPIResponse, error) {
+	var youtubeResponse YoutubeVideosListAPIResponse
+	if err := c.get(ctx, c.computeVideosEndpointURL(videosIDs), &youtubeResponse); err != nil {
+		return YoutubeVideosListAPIResponse{}, err
+	}
+
+	return youtubeResponse, nil
+}
+
+// get decodes the response into a struct validated afterwards.
+func (c *Client) get(ctx context.Context, endpointURL string, response interface{}) error {
+	if quotaResetsAt := c.QuotaResetsAt(); !quotaResetsAt.IsZero() {
+		return fmt.Errorf("%w until %s", ErrQuotaExceeded, quotaResetsAt.Format(time.RFC3339))
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, endpointURL, nil)
+	if err != nil {
+		return err
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		apiError := newAPIErrorFromResponse(resp)
+		if apiError.kind == ErrQuotaExceeded {
+			c.mu.Lock()
+			c.quotaResetsAt = NextQuotaReset(c.now())
+			c.mu.Unlock()
+		}
+
+		return apiError
+	}
+
+	if err := json.NewDecoder(resp.Body).Decode(response); err != nil {
+		return err
+	}
+
+	return validate.Struct(response)
+}
+
+var quotaResetLocation = loadQuotaResetLocation()
+
+func loadQuotaResetLocation() *time.Location {
+	location, err := time.LoadLocation("America/Los_Angeles")
+	if err != nil {
+		return time.FixedZone("PST", -8*60*60)
+	}
+
+	return location
+}
+
+// NextQuotaReset returns the next midnight Pacific Time, when YouTube quotas are reset.
+func NextQuotaReset(now time.Time) time.Time {
+	pacificNow := now.In(quotaResetLocation)
+	year, month, day := pacificNow.Date()
+
+	return time.Date(year, month, day+1, 0, 0, 0, 0, quotaResetLocation)
+}
@@ -0,0 +1,218 @@
+package youtube
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"time"
+)
+
+func writeYouTubeError(w http.ResponseWriter, statusCode int, reason string, message string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(statusCode)
+
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"error": map[string]interface{}{
+			"code":    statusCode,
+			"message": message,
+			"errors": []map[string]interface{}{
+				{
+					"message": message,
+					"domain":  "youtube.quota",
+					"reason":  reason,
+				},
+			},
+		},
+	})
+}
+
+func (s *UnitTestSuite) Test_ClientFetchesVideos() {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		s.Equal("/videos", r.URL.Path)
+		s.Equal("api-key", r.URL.Query().Get("key"))
+		s.Equal("snippet,contentDetails,status", r.URL.Query().Get("part"))
+
+		response := YoutubeVideosListAPIResponse{
+			Kind: "youtube#videoListResponse",
+		}
+		for _, videoID := range r.URL.Query()["id"] {
+			response.Items = append(response.Items, YoutubeVideo{
+				Kind: "youtube#video",
+				ID:   videoID,
+			})
+		}
+		response.PageInfo.TotalResults = len(response.Items)
+		response.PageInfo.ResultsPerPage = len(response.Items)
+
+		json.NewEncoder(w).Encode(response)
+	}))
+	defer server.Close()
+
+	client := NewClient(ClientOptions{
+		APIKey:  "api-key",
+		BaseURL: server.URL + "/",
+	})
+
+	response, err := client.FetchVideos(context.Background(), []string{"first", "second"})
+	s.NoError(err)
+	s.Len(response.Items, 2)
+	s.Equal("first", response.Items[0].ID)
+}
+
+func (s *UnitTestSuite) Test_ClientSearchesVideosThenFetchesThem() {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/search":
+			s.Equal("never gonna", r.URL.Query().Get("q"))
+			s.Equal("video", r.URL.Query().Get("type"))
+			s.Equal("2", r.URL.Query().Get("maxResults"))
+
+			response := YoutubeSearchListAPIResponse{
+				Kind: "youtube#searchListResponse",
+			}
+			for _, videoID := range []string{"second", "first"} {
+				var result YoutubeSearchResult
+				result.Kind = "youtube#searchResult"
+				result.ID.Kind = "youtube#video"
+				result.ID.VideoID = videoID
+
+				response.Items = append(response.Items, result)
+			}
+
+			json.NewEncoder(w).Encode(response)
+		case "/videos":
+			s.Equal([]string{"second", "first"}, r.URL.Query()["id"])
+
+			response := YoutubeVideosListAPIResponse{
+				Kind: "youtube#videoListResponse",
+			}
+			for _, videoID := range r.URL.Query()["id"] {
+				response.Items = append(response.Items, YoutubeVideo{
+					Kind: "youtube#video",
+					ID:   videoID,
+				})
+			}
+			response.PageInfo.TotalResults = len(response.Items)
+			response.PageInfo.ResultsPerPage = len(response.Items)
+
+			json.NewEncoder(w).Encode(response)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	client := NewClient(ClientOptions{
+		APIKey:  "api-key",
+		BaseURL: server.URL,
+	})
+
+	response, err := client.SearchVideos(context.Background(), "never gonna", 2)
+	s.NoError(err)
+	s.Len(response.Items, 2)
+	s.Equal("second", response.Items[0].ID)
+	s.Equal("first", response.Items[1].ID)
+}
+
+func (s *UnitTestSuite) Test_ClientReturnsTypedErrors() {
+	testCases := []struct {
+		statusCode  int
+		reason      string
+		message     string
+		expectedErr error
+	}{
+		{http.StatusForbidden, "quotaExceeded", "The request cannot be completed because you have exceeded your quota.", ErrQuotaExceeded},
+		{http.StatusBadRequest, "badRequest", "API key not valid. Please pass a valid API key.", ErrInvalidAPIKey},
+		{http.StatusNotFound, "videoNotFound", "The video identified by the id parameter could not be found.", ErrNotFound},
+	}
+
+	for _, testCase := range testCases {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			writeYouTubeError(w, testCase.statusCode, testCase.reason, testCase.message)
+		}))
+
+		client := NewClient(ClientOptions{
+			APIKey:  "api-key",
+			BaseURL: server.URL,
+		})
+
+		_, err := client.FetchVideos(context.Background(), []string{"video"})
+		server.Close()
+
+		s.ErrorIs(err, testCase.expectedErr)
+
+		var apiError *APIError
+		s.True(errors.As(err, &apiError))
+		s.Equal(testCase.statusCode, apiError.StatusCode)
+		s.Equal(testCase.reason, apiError.Reason)
+		s.Equal(testCase.message, apiError.Message)
+	}
+}
+
+func (s *UnitTestSuite) Test_ClientDoesNotCallAPIUntilQuotaIsReset() {
+	var calls int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&calls, 1)
+
+		writeYouTubeError(w, http.StatusForbidden, "quotaExceeded", "quota exceeded")
+	}))
+	defer server.Close()
+
+	now := time.Date(2021, time.October, 10, 15, 0, 0, 0, time.UTC)
+	client := NewClient(ClientOptions{
+		APIKey:  "api-key",
+		BaseURL: server.URL,
+		Now: func() time.Time {
+			return now
+		},
+	})
+
+	_, err := client.FetchVideos(context.Background(), []string{"video"})
+	s.ErrorIs(err, ErrQuotaExceeded)
+	s.Equal(NextQuotaReset(now), client.QuotaResetsAt())
+
+	_, err = client.FetchVideos(context.Background(), []string{"video"})
+	s.ErrorIs(err, ErrQuotaExceeded)
+	s.EqualValues(1, atomic.LoadInt32(&calls))
+
+	now = client.QuotaResetsAt()
+
+	_, err = client.FetchVideos(context.Background(), []string{"video"})
+	s.ErrorIs(err, ErrQuotaExceeded)
+	s.EqualValues(2, atomic.LoadInt32(&calls))
+}
+
+func (s *UnitTestSuite) Test_ClientRequestsTimeOut() {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-r.Context().Done():
+		case <-time.After(time.Second):
+		}
+	}))
+	defer server.Close()
+
+	client := NewClient(ClientOptions{
+		APIKey:  "api-key",
+		BaseURL: server.URL,
+		Timeout: 10 * time.Millisecond,
+	})
+
+	_, err := client.FetchVideos(context.Background(), []string{"video"})
+	s.Error(err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err = NewClient(ClientOptions{BaseURL: server.URL}).FetchVideos(ctx, []string{"video"})
+	s.ErrorIs(err, context.Canceled)
+}
+
+func (s *UnitTestSuite) Test_NextQuotaResetIsPacificMidnight() {
+	// 2021-10-10 15:00 UTC is 08:00 in Los Angeles
+	now := time.Date(2021, time.October, 10, 15, 0, 0, 0, time.UTC)
+
+	s.True(strings.HasPrefix(NextQuotaReset(now).Format(time.RFC3339), "2021-10-11T00:00:00"))
+}
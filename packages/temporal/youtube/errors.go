@@ -0,0 +1,88 @@
+package youtube
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+)
+
+var (
+	ErrQuotaExceeded = errors.New("YouTube quota exceeded")
+	ErrInvalidAPIKey = errors.New("invalid YouTube API key")
+	ErrNotFound      = errors.New("YouTube resource not found")
+)
+
+// APIError is returned for every non 2xx response of the YouTube API.
+// It wraps ErrQuotaExceeded, ErrInvalidAPIKey or ErrNotFound when it matches one of them.
+type APIError struct {
+	StatusCode int
+	// First reason of the errors list, as quotaExceeded or keyInvalid
+	Reason  string
+	Message string
+
+	kind error
+}
+
+func (e *APIError) Error() string {
+	return fmt.Sprintf("YouTube API responded with status %d (%s): %s", e.StatusCode, e.Reason, e.Message)
+}
+
+func (e *APIError) Unwrap() error {
+	return e.kind
+}
+
+// As https://developers.google.com/youtube/v3/docs/errors
+type apiErrorResponse struct {
+	Error struct {
+		Code    int    `json:"code"`
+		Message string `json:"message"`
+		Errors  []struct {
+			Reason string `json:"reason"`
+		} `json:"errors"`
+		Details []struct {
+			Reason string `json:"reason"`
+		} `json:"details"`
+	} `json:"error"`
+}
+
+func newAPIErrorFromResponse(resp *http.Response) *APIError {
+	apiError := &APIError{
+		StatusCode: resp.StatusCode,
+		Message:    http.StatusText(resp.StatusCode),
+	}
+
+	var body apiErrorResponse
+	// Bodies that are not a YouTube error are classified by their status code only
+	if err := json.NewDecoder(io.LimitReader(resp.Body, 1<<20)).Decode(&body); err == nil {
+		if body.Error.Message != "" {
+			apiError.Message = body.Error.Message
+		}
+		if len(body.Error.Errors) > 0 {
+			apiError.Reason = body.Error.Errors[0].Reason
+		}
+	}
+
+	switch {
+	case apiError.Reason == "quotaExceeded" || apiError.Reason == "dailyLimitExceeded":
+		apiError.kind = ErrQuotaExceeded
+	case apiError.Reason == "keyInvalid" || hasDetailReason(body, "API_KEY_INVALID") || strings.Contains(apiError.Message, "API key not valid"):
+		apiError.kind = ErrInvalidAPIKey
+	case resp.StatusCode == http.StatusNotFound:
+		apiError.kind = ErrNotFound
+	}
+
+	return apiError
+}
+
+func hasDetailReason(body apiErrorResponse, reason string) bool {
+	for _, detail := range body.Error.Details {
+		if detail.Reason == reason {
+			return true
+		}
+	}
+
+	return false
+}
@@ -2,75 +2,198 @@ package youtube
 
 import (
 	"context"
-	"encoding/json"
-	"net/http"
-	"net/url"
+	"fmt"
 	"strings"
+	"sync"
 )
 
+type YoutubeVideoThumbnail struct {
+	URL    string `json:"url" validate:"required"`
+	Width  int    `json:"width" validate:"required"`
+	Height int    `json:"height" validate:"required"`
+}
+
+type YoutubeVideo struct {
+	Kind    string `json:"kind" validate:"required"`
+	ID      string `json:"id" validate:"required"`
+	Snippet struct {
+		PublishedAt string `json:"publishedAt"`
+		Title       string `json:"title" validate:"required"`
+		Description string `json:"description" validate:"required"`
+		Thumbnails  struct {
+			Default YoutubeVideoThumbnail  `json:"default" validate:"required"`
+			Medium  *YoutubeVideoThumbnail `json:"medium"`
+			High    *YoutubeVideoThumbnail `json:"high"`
+		} `json:"thumbnails" validate:"required"`
+		ChannelTitle string `json:"channelTitle" validate:"required"`
+		CategoryID   string `json:"categoryId"`
+		// One of none, live or upcoming
+		LiveBroadcastContent string `json:"liveBroadcastContent"`
+	} `json:"snippet" validate:"required"`
+	ContentDetails struct {
+		Duration          string `json:"duration" validate:"required"`
+		RegionRestriction struct {
+			Allowed []string `json:"allowed"`
+			Blocked []string `json:"blocked"`
+		} `json:"regionRestriction"`
+		ContentRating struct {
+			// Is ytAgeRestricted for age restricted videos
+			YtRating string `json:"ytRating"`
+		} `json:"contentRating"`
+	} `json:"contentDetails" validate:"required"`
+	Status struct {
+		Embeddable    bool   `json:"embeddable"`
+		PrivacyStatus string `json:"privacyStatus"`
+	} `json:"status"`
+}
+
+const YtRatingAgeRestricted = "ytAgeRestricted"
+
+const LiveBroadcastContentNone = "none"
+
 type YoutubeVideosListAPIResponse struct {
-	Kind  string `json:"kind" validate:"required"`
-	Items []struct {
-		Kind    string `json:"kind" validate:"required"`
-		ID      string `json:"id" validate:"required"`
-		Snippet struct {
-			Title       string `json:"title" validate:"required"`
-			Description string `json:"description" validate:"required"`
-			Thumbnails  struct {
-				Default struct {
-					URL    string `json:"url" validate:"required"`
-					Width  int    `json:"width" validate:"required"`
-					Height int    `json:"height" validate:"required"`
-				} `json:"default" validate:"required"`
-			} `json:"thumbnails" validate:"required"`
-			ChannelTitle string `json:"channelTitle" validate:"required"`
-			CategoryID   string `json:"categoryId"`
-		} `json:"snippet" validate:"required"`
-		ContentDetails struct {
-			Duration string `json:"duration" validate:"required"`
-		} `json:"contentDetails" validate:"required"`
-	} `json:"items" validate:"required"`
+	Kind     string         `json:"kind" validate:"required"`
+	Items    []YoutubeVideo `json:"items" validate:"required"`
+	// Both are zero when none of the requested videos exists
 	PageInfo struct {
-		TotalResults   int `json:"totalResults" validate:"required"`
-		ResultsPerPage int `json:"resultsPerPage" validate:"required"`
-	} `json:"pageInfo" validate:"required"`
+		TotalResults   int `json:"totalResults"`
+		ResultsPerPage int `json:"resultsPerPage"`
+	} `json:"pageInfo"`
+}
+
+// YouTube rejects videos.list requests asking for more videos.
+const MaxVideosIDsPerRequest = 50
+
+// YouTube rejects search.list requests asking for more results.
+const MaxSearchResults = 50
+
+type YoutubeSearchResult struct {
+	Kind string `json:"kind" validate:"required"`
+	ID   struct {
+		Kind    string `json:"kind" validate:"required"`
+		VideoID string `json:"videoId"`
+	} `json:"id" validate:"required"`
+}
+
+type YoutubeSearchListAPIResponse struct {
+	Kind  string                `json:"kind" validate:"required"`
+	Items []YoutubeSearchResult `json:"items" validate:"required"`
+}
+
+const maxConcurrentBatchesRequests = 4
+
+type BatchError struct {
+	VideosIDs []string
+	Err       error
 }
 
-func computeYouTubeVideosEndpointURL(apiKey string, videosIDs []string) string {
-	const BaseUrl = "https://youtube.googleapis.com/youtube/v3/videos"
-	var PartsToGet = []string{
-		"snippet",
-		"contentDetails",
+func (e BatchError) Error() string {
+	return fmt.Sprintf("fetching videos %s failed: %v", strings.Join(e.VideosIDs, ","), e.Err)
+}
+
+func (e BatchError) Unwrap() error {
+	return e.Err
+}
+
+// PartialFetchError is returned along with the videos of the batches that succeeded.
+type PartialFetchError struct {
+	FailedBatches []BatchError
+}
+
+func (e *PartialFetchError) Error() string {
+	messages := make([]string, 0, len(e.FailedBatches))
+	for _, batchError := range e.FailedBatches {
+		messages = append(messages, batchError.Error())
 	}
 
-	joinedPartsToGet := strings.Join(PartsToGet, ",")
-	params := url.Values{
-		"part": {joinedPartsToGet},
-		"id":   videosIDs,
-		"key":  {apiKey},
+	return fmt.Sprintf("%d batches failed: %s", len(e.FailedBatches), strings.Join(messages, "; "))
+}
+
+func (e *PartialFetchError) FailedVideosIDs() []string {
+	videosIDs := make([]string, 0)
+	for _, batchError := range e.FailedBatches {
+		videosIDs = append(videosIDs, batchError.VideosIDs...)
 	}
 
-	// As https://youtube.googleapis.com/youtube/v3/videos?part=snippet%2CcontentDetails&id=Ks-_Mh1QhMc&id=9Tfciw7QM3c&key=[API_KEY]
-	return BaseUrl + "?" + params.Encode()
+	return videosIDs
 }
 
-func FetchYouTubeVideosInformation(ctx context.Context, apiKey string, videosIDs []string) (YoutubeVideosListAPIResponse, error) {
-	url := computeYouTubeVideosEndpointURL(apiKey, videosIDs)
-	resp, err := http.Get(url)
-	if err != nil {
-		return YoutubeVideosListAPIResponse{}, err
+type fetchVideosBatchFunc func(ctx context.Context, videosIDs []string) (YoutubeVideosListAPIResponse, error)
+
+func fetchVideosInBatches(ctx context.Context, videosIDs []string, fetchBatch fetchVideosBatchFunc) (YoutubeVideosListAPIResponse, error) {
+	batches := splitVideosIDsInBatches(videosIDs, MaxVideosIDsPerRequest)
+	responses := make([]YoutubeVideosListAPIResponse, len(batches))
+	errs := make([]error, len(batches))
+
+	var wg sync.WaitGroup
+	semaphore := make(chan struct{}, maxConcurrentBatchesRequests)
+	for index, batch := range batches {
+		wg.Add(1)
+		semaphore <- struct{}{}
+
+		go func(index int, batch []string) {
+			defer wg.Done()
+			defer func() { <-semaphore }()
+
+			responses[index], errs[index] = fetchBatch(ctx, batch)
+		}(index, batch)
 	}
-	defer resp.Body.Close()
+	wg.Wait()
 
-	var youtubeResponse YoutubeVideosListAPIResponse
+	var mergedResponse YoutubeVideosListAPIResponse
+	fetchedVideos := make(map[string]YoutubeVideo, len(videosIDs))
+	failedBatches := make([]BatchError, 0)
+	for index, response := range responses {
+		if err := errs[index]; err != nil {
+			failedBatches = append(failedBatches, BatchError{
+				VideosIDs: batches[index],
+				Err:       err,
+			})
+			continue
+		}
 
-	if err := json.NewDecoder(resp.Body).Decode(&youtubeResponse); err != nil {
-		return YoutubeVideosListAPIResponse{}, err
+		mergedResponse.Kind = response.Kind
+		for _, video := range response.Items {
+			fetchedVideos[video.ID] = video
+		}
 	}
 
-	if err := validate.Struct(youtubeResponse); err != nil {
-		return YoutubeVideosListAPIResponse{}, err
+	if len(batches) > 0 && len(failedBatches) == len(batches) {
+		return YoutubeVideosListAPIResponse{}, failedBatches[0].Err
+	}
+
+	mergedResponse.Items = make([]YoutubeVideo, 0, len(fetchedVideos))
+	for _, videoID := range videosIDs {
+		video, exists := fetchedVideos[videoID]
+		if !exists {
+			continue
+		}
+
+		mergedResponse.Items = append(mergedResponse.Items, video)
+		delete(fetchedVideos, videoID)
+	}
+	mergedResponse.PageInfo.TotalResults = len(mergedResponse.Items)
+	mergedResponse.PageInfo.ResultsPerPage = len(mergedResponse.Items)
+
+	if len(failedBatches) > 0 {
+		return mergedResponse, &PartialFetchError{
+			FailedBatches: failedBatches,
+		}
+	}
+
+	return mergedResponse, nil
+}
+
+func splitVideosIDsInBatches(videosIDs []string, batchSize int) [][]string {
+	batches := make([][]string, 0, (len(videosIDs)+batchSize-1)/batchSize)
+	for start := 0; start < len(videosIDs); start += batchSize {
+		end := start + batchSize
+		if end > len(videosIDs) {
+			end = len(videosIDs)
+		}
+
+		batches = append(batches, videosIDs[start:end])
 	}
 
-	return youtubeResponse, nil
+	return batches
 }
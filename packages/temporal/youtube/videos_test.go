@@ -0,0 +1,113 @@
+package youtube
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type UnitTestSuite struct {
+	suite.Suite
+}
+
+func generateVideosIDs(count int) []string {
+	videosIDs := make([]string, 0, count)
+	for i := 0; i < count; i++ {
+		videosIDs = append(videosIDs, fmt.Sprintf("video-%d", i))
+	}
+
+	return videosIDs
+}
+
+func (s *UnitTestSuite) Test_FetchVideosInBatchesKeepsRequestOrder() {
+	videosIDs := generateVideosIDs(3*MaxVideosIDsPerRequest + 1)
+
+	var (
+		mu                    sync.Mutex
+		runningRequests       int
+		maxRunningRequests    int
+		requestedVideosCounts []int
+	)
+	response, err := fetchVideosInBatches(context.Background(), videosIDs, func(ctx context.Context, batch []string) (YoutubeVideosListAPIResponse, error) {
+		mu.Lock()
+		runningRequests++
+		if runningRequests > maxRunningRequests {
+			maxRunningRequests = runningRequests
+		}
+		requestedVideosCounts = append(requestedVideosCounts, len(batch))
+		mu.Unlock()
+
+		time.Sleep(10 * time.Millisecond)
+
+		mu.Lock()
+		runningRequests--
+		mu.Unlock()
+
+		// YouTube does not have to respect the order of the requested videos
+		response := YoutubeVideosListAPIResponse{
+			Kind: "youtube#videoListResponse",
+		}
+		for index := len(batch) - 1; index >= 0; index-- {
+			response.Items = append(response.Items, YoutubeVideo{
+				ID: batch[index],
+			})
+		}
+
+		return response, nil
+	})
+	s.NoError(err)
+
+	s.ElementsMatch([]int{MaxVideosIDsPerRequest, MaxVideosIDsPerRequest, MaxVideosIDsPerRequest, 1}, requestedVideosCounts)
+	s.LessOrEqual(maxRunningRequests, maxConcurrentBatchesRequests)
+
+	returnedVideosIDs := make([]string, 0, len(response.Items))
+	for _, video := range response.Items {
+		returnedVideosIDs = append(returnedVideosIDs, video.ID)
+	}
+	s.Equal(videosIDs, returnedVideosIDs)
+}
+
+func (s *UnitTestSuite) Test_FetchVideosInBatchesReportsFailedBatches() {
+	videosIDs := generateVideosIDs(2 * MaxVideosIDsPerRequest)
+	batchErr := errors.New("quota exceeded")
+
+	response, err := fetchVideosInBatches(context.Background(), videosIDs, func(ctx context.Context, batch []string) (YoutubeVideosListAPIResponse, error) {
+		if batch[0] == videosIDs[0] {
+			return YoutubeVideosListAPIResponse{}, batchErr
+		}
+
+		response := YoutubeVideosListAPIResponse{}
+		for _, videoID := range batch {
+			response.Items = append(response.Items, YoutubeVideo{
+				ID: videoID,
+			})
+		}
+
+		return response, nil
+	})
+
+	var partialFetchError *PartialFetchError
+	s.True(errors.As(err, &partialFetchError))
+	s.Equal(videosIDs[:MaxVideosIDsPerRequest], partialFetchError.FailedVideosIDs())
+	s.ErrorIs(partialFetchError.FailedBatches[0], batchErr)
+	s.Len(response.Items, MaxVideosIDsPerRequest)
+	s.Equal(videosIDs[MaxVideosIDsPerRequest], response.Items[0].ID)
+}
+
+func (s *UnitTestSuite) Test_FetchVideosInBatchesFailsWhenEveryBatchFails() {
+	batchErr := errors.New("invalid key")
+
+	_, err := fetchVideosInBatches(context.Background(), generateVideosIDs(MaxVideosIDsPerRequest+1), func(ctx context.Context, batch []string) (YoutubeVideosListAPIResponse, error) {
+		return YoutubeVideosListAPIResponse{}, batchErr
+	})
+	s.Equal(batchErr, err)
+}
+
+func TestUnitTestSuite(t *testing.T) {
+	suite.Run(t, new(UnitTestSuite))
+}
@@ -0,0 +1,290 @@
+// Package youtubetest serves videos.list and search.list responses of the YouTube Data API
+// from a fixtures directory, so that tracks can be fetched without network access.
+package youtubetest
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/AdonisEnProvence/MusicRoom/youtube"
+)
+
+type Options struct {
+	// Directory containing one <video ID>.json file per video,
+	// as an item of a videos.list response
+	FixturesDir string
+	// When not empty, requests with another key are rejected as YouTube does
+	APIKey string
+	// Number of requests answered before responding quotaExceeded, unlimited when zero
+	QuotaLimit int
+	// Delay before responding to each request
+	Latency time.Duration
+}
+
+// Server is an http.Handler, see NewTestServer to listen on a random port.
+// Videos without fixture are omitted from the responses, as YouTube does with unknown IDs.
+type Server struct {
+	apiKey string
+	videos map[string]youtube.YoutubeVideo
+
+	mu            sync.Mutex
+	quotaLimit    int
+	quotaExceeded bool
+	latency       time.Duration
+	requestsCount int
+}
+
+func NewServer(options Options) (*Server, error) {
+	server := &Server{
+		apiKey:     options.APIKey,
+		videos:     make(map[string]youtube.YoutubeVideo),
+		quotaLimit: options.QuotaLimit,
+		latency:    options.Latency,
+	}
+
+	if options.FixturesDir == "" {
+		return server, nil
+	}
+
+	fixturesPaths, err := filepath.Glob(filepath.Join(options.FixturesDir, "*.json"))
+	if err != nil {
+		return nil, err
+	}
+
+	for _, fixturePath := range fixturesPaths {
+		content, err := ioutil.ReadFile(fixturePath)
+		if err != nil {
+			return nil, err
+		}
+
+		var video youtube.YoutubeVideo
+		if err := json.Unmarshal(content, &video); err != nil {
+			return nil, fmt.Errorf("invalid fixture %s: %w", fixturePath, err)
+		}
+		if video.ID == "" {
+			video.ID = strings.TrimSuffix(filepath.Base(fixturePath), ".json")
+		}
+
+		server.videos[video.ID] = video
+	}
+
+	return server, nil
+}
+
+// NewTestServer starts the server on a random port, its URL is
+// meant to be given as youtube.ClientOptions.BaseURL.
+func NewTestServer(options Options) (*httptest.Server, *Server, error) {
+	server, err := NewServer(options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return httptest.NewServer(server), server, nil
+}
+
+// AddVideo adds or replaces a video served by the server.
+func (s *Server) AddVideo(video youtube.YoutubeVideo) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.videos[video.ID] = video
+}
+
+// SetQuotaExceeded makes every following request fail with a quotaExceeded error, until called with false.
+func (s *Server) SetQuotaExceeded(quotaExceeded bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.quotaExceeded = quotaExceeded
+}
+
+func (s *Server) SetLatency(latency time.Duration) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.latency = latency
+}
+
+// RequestsCount returns the number of requests received, including the failed ones.
+func (s *Server) RequestsCount() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.requestsCount
+}
+
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	// The base URL given to the client can have a path, as /youtube/v3
+	isVideosList := strings.HasSuffix(r.URL.Path, "/videos")
+	isSearchList := strings.HasSuffix(r.URL.Path, "/search")
+	if r.Method != http.MethodGet || !isVideosList && !isSearchList {
+		writeError(w, http.StatusNotFound, "notFound", "Only videos.list and search.list are served")
+		return
+	}
+
+	s.mu.Lock()
+	s.requestsCount++
+	latency := s.latency
+	quotaExceeded := s.quotaExceeded || s.quotaLimit > 0 && s.requestsCount > s.quotaLimit
+	s.mu.Unlock()
+
+	if latency > 0 {
+		select {
+		case <-time.After(latency):
+		case <-r.Context().Done():
+			return
+		}
+	}
+
+	query := r.URL.Query()
+	if s.apiKey != "" && query.Get("key") != s.apiKey {
+		writeError(w, http.StatusBadRequest, "keyInvalid", "API key not valid. Please pass a valid API key.")
+		return
+	}
+	if quotaExceeded {
+		writeError(w, http.StatusForbidden, "quotaExceeded", "The request cannot be completed because you have exceeded your <a href=\"/youtube/v3/getting-started#quota\">quota</a>.")
+		return
+	}
+	if query.Get("part") == "" {
+		writeError(w, http.StatusBadRequest, "required", "Required parameter: part")
+		return
+	}
+
+	if isSearchList {
+		s.serveSearch(w, query)
+		return
+	}
+
+	videosIDs := make([]string, 0)
+	for _, videosIDsList := range query["id"] {
+		for _, videoID := range strings.Split(videosIDsList, ",") {
+			if videoID != "" {
+				videosIDs = append(videosIDs, videoID)
+			}
+		}
+	}
+	if len(videosIDs) > youtube.MaxVideosIDsPerRequest {
+		writeError(w, http.StatusBadRequest, "invalidFilters", fmt.Sprintf("At most %d videos IDs can be requested", youtube.MaxVideosIDsPerRequest))
+		return
+	}
+
+	response := youtube.YoutubeVideosListAPIResponse{
+		Kind:  "youtube#videoListResponse",
+		Items: make([]youtube.YoutubeVideo, 0, len(videosIDs)),
+	}
+	s.mu.Lock()
+	for _, videoID := range videosIDs {
+		video, exists := s.videos[videoID]
+		if !exists {
+			continue
+		}
+
+		response.Items = append(response.Items, video)
+	}
+	s.mu.Unlock()
+	response.PageInfo.TotalResults = len(response.Items)
+	response.PageInfo.ResultsPerPage = len(response.Items)
+
+	w.Header().Set("Content-Type", "application/json; charset=UTF-8")
+	json.NewEncoder(w).Encode(response)
+}
+
+// serveSearch ranks the videos by the number of query words found in their title or channel title.
+// Videos matching none of them are left out.
+func (s *Server) serveSearch(w http.ResponseWriter, query url.Values) {
+	maxResults := 5
+	if rawMaxResults := query.Get("maxResults"); rawMaxResults != "" {
+		parsedMaxResults, err := strconv.Atoi(rawMaxResults)
+		if err != nil || parsedMaxResults < 0 || parsedMaxResults > youtube.MaxSearchResults {
+			writeError(w, http.StatusBadRequest, "invalidParameter", "Invalid value for parameter maxResults")
+			return
+		}
+
+		maxResults = parsedMaxResults
+	}
+
+	queryWords := strings.Fields(strings.ToLower(query.Get("q")))
+
+	type rankedVideo struct {
+		ID    string
+		Score int
+	}
+	rankedVideos := make([]rankedVideo, 0)
+	s.mu.Lock()
+	for videoID, video := range s.videos {
+		searchedText := strings.ToLower(video.Snippet.Title + " " + video.Snippet.ChannelTitle)
+
+		score := 0
+		for _, word := range queryWords {
+			if strings.Contains(searchedText, word) {
+				score++
+			}
+		}
+		if score == 0 {
+			continue
+		}
+
+		rankedVideos = append(rankedVideos, rankedVideo{
+			ID:    videoID,
+			Score: score,
+		})
+	}
+	s.mu.Unlock()
+
+	sort.Slice(rankedVideos, func(i, j int) bool {
+		if rankedVideos[i].Score != rankedVideos[j].Score {
+			return rankedVideos[i].Score > rankedVideos[j].Score
+		}
+
+		return rankedVideos[i].ID < rankedVideos[j].ID
+	})
+	if len(rankedVideos) > maxResults {
+		rankedVideos = rankedVideos[:maxResults]
+	}
+
+	response := youtube.YoutubeSearchListAPIResponse{
+		Kind:  "youtube#searchListResponse",
+		Items: make([]youtube.YoutubeSearchResult, 0, len(rankedVideos)),
+	}
+	for _, video := range rankedVideos {
+		var result youtube.YoutubeSearchResult
+		result.Kind = "youtube#searchResult"
+		result.ID.Kind = "youtube#video"
+		result.ID.VideoID = video.ID
+
+		response.Items = append(response.Items, result)
+	}
+
+	w.Header().Set("Content-Type", "application/json; charset=UTF-8")
+	json.NewEncoder(w).Encode(response)
+}
+
+// As https://developers.google.com/youtube/v3/docs/errors
+func writeError(w http.ResponseWriter, statusCode int, reason string, message string) {
+	w.Header().Set("Content-Type", "application/json; charset=UTF-8")
+	w.WriteHeader(statusCode)
+
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"error": map[string]interface{}{
+			"code":    statusCode,
+			"message": message,
+			"errors": []map[string]interface{}{
+				{
+					"message": message,
+					"domain":  "youtube",
+					"reason":  reason,
+				},
+			},
+		},
+	})
+}
@@ -0,0 +1,109 @@
+package youtubetest_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/AdonisEnProvence/MusicRoom/youtube"
+	"github.com/AdonisEnProvence/MusicRoom/youtube/youtubetest"
+	"github.com/stretchr/testify/suite"
+)
+
+type UnitTestSuite struct {
+	suite.Suite
+}
+
+func (s *UnitTestSuite) newClient(options youtubetest.Options, clientOptions youtube.ClientOptions) (*youtube.Client, *youtubetest.Server) {
+	httpServer, server, err := youtubetest.NewTestServer(options)
+	s.Require().NoError(err)
+	s.T().Cleanup(httpServer.Close)
+
+	clientOptions.BaseURL = httpServer.URL
+	return youtube.NewClient(clientOptions), server
+}
+
+func (s *UnitTestSuite) Test_ServesFixturesAndOmitsMissingVideos() {
+	client, _ := s.newClient(youtubetest.Options{
+		FixturesDir: "fixtures",
+	}, youtube.ClientOptions{})
+
+	response, err := client.FetchVideos(context.Background(), []string{"unknown", "dQw4w9WgXcQ", "9Tfciw7QM3c"})
+	s.NoError(err)
+	s.Len(response.Items, 2)
+	s.Equal("dQw4w9WgXcQ", response.Items[0].ID)
+	s.Equal("PT3M33S", response.Items[0].ContentDetails.Duration)
+	s.Equal("live", response.Items[1].Snippet.LiveBroadcastContent)
+}
+
+func (s *UnitTestSuite) Test_ServesEmptyResponseWhenEveryVideoIsMissing() {
+	client, _ := s.newClient(youtubetest.Options{
+		FixturesDir: "fixtures",
+	}, youtube.ClientOptions{})
+
+	response, err := client.FetchVideos(context.Background(), []string{"doesNotExist"})
+	s.NoError(err)
+	s.Empty(response.Items)
+}
+
+func (s *UnitTestSuite) Test_RejectsInvalidAPIKey() {
+	client, _ := s.newClient(youtubetest.Options{
+		FixturesDir: "fixtures",
+		APIKey:      "valid-key",
+	}, youtube.ClientOptions{
+		APIKey: "invalid-key",
+	})
+
+	_, err := client.FetchVideos(context.Background(), []string{"dQw4w9WgXcQ"})
+	s.ErrorIs(err, youtube.ErrInvalidAPIKey)
+}
+
+func (s *UnitTestSuite) Test_RespondsQuotaExceededAfterLimit() {
+	client, server := s.newClient(youtubetest.Options{
+		FixturesDir: "fixtures",
+		QuotaLimit:  1,
+	}, youtube.ClientOptions{})
+
+	_, err := client.FetchVideos(context.Background(), []string{"dQw4w9WgXcQ"})
+	s.NoError(err)
+
+	_, err = client.FetchVideos(context.Background(), []string{"dQw4w9WgXcQ"})
+	s.ErrorIs(err, youtube.ErrQuotaExceeded)
+	s.Equal(2, server.RequestsCount())
+}
+
+func (s *UnitTestSuite) Test_SlowResponsesTimeOut() {
+	client, server := s.newClient(youtubetest.Options{
+		FixturesDir: "fixtures",
+		Latency:     time.Second,
+	}, youtube.ClientOptions{
+		Timeout: 10 * time.Millisecond,
+	})
+
+	_, err := client.FetchVideos(context.Background(), []string{"dQw4w9WgXcQ"})
+	s.Error(err)
+
+	server.SetLatency(0)
+
+	_, err = client.FetchVideos(context.Background(), []string{"dQw4w9WgXcQ"})
+	s.NoError(err)
+}
+
+func TestUnitTestSuite(t *testing.T) {
+	suite.Run(t, new(UnitTestSuite))
+}
+
+func (s *UnitTestSuite) Test_SearchRanksVideosByMatchedWords() {
+	client, _ := s.newClient(youtubetest.Options{
+		FixturesDir: "fixtures",
+	}, youtube.ClientOptions{})
+
+	response, err := client.SearchVideos(context.Background(), "Rick Astley music", 10)
+	s.NoError(err)
+	s.Len(response.Items, 3)
+	s.Equal("dQw4w9WgXcQ", response.Items[0].ID)
+
+	response, err = client.SearchVideos(context.Background(), "nothing matches", 10)
+	s.NoError(err)
+	s.Empty(response.Items)
+}